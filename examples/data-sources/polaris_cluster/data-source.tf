@@ -0,0 +1,13 @@
+# Look up cluster by name.
+data "polaris_cluster" "cluster" {
+  name = "my-cluster"
+}
+
+# Look up cluster by ID.
+data "polaris_cluster" "cluster_by_id" {
+  id = "0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2"
+}
+
+output "available_capacity" {
+  value = data.polaris_cluster.cluster.available_capacity
+}
@@ -0,0 +1,21 @@
+# List all connected on-prem clusters.
+data "polaris_clusters" "on_prem" {
+  connection_states = ["Connected"]
+  types             = ["OnPrem"]
+}
+
+# Pick the healthy cluster with the most available storage capacity.
+locals {
+  healthy_clusters = [
+    for cluster in data.polaris_clusters.on_prem.clusters : cluster
+    if cluster.system_status == "OK"
+  ]
+  target_cluster = [
+    for cluster in local.healthy_clusters : cluster
+    if cluster.available_capacity == max(local.healthy_clusters[*].available_capacity...)
+  ][0]
+}
+
+output "target_cluster_id" {
+  value = local.target_cluster.id
+}
@@ -0,0 +1,18 @@
+# Look up the image bundle for an EKS cluster.
+data "polaris_exocompute_image_bundle" "eks" {
+  eks_version  = "1.29"
+  registry_url = "123456789012.dkr.ecr.us-east-2.amazonaws.com"
+}
+
+# Look up the image bundle for an AKS cluster.
+data "polaris_exocompute_image_bundle" "aks" {
+  registry_url = "myregistry.azurecr.io"
+}
+
+# Map from RSC image reference to private container registry image reference,
+# e.g. to drive an image mirroring pipeline.
+output "images" {
+  value = {
+    for image in data.polaris_exocompute_image_bundle.eks.images : image.source_uri => image.target_uri
+  }
+}
@@ -0,0 +1,15 @@
+# Look up replication target cluster by name.
+data "polaris_replication_target_cluster" "target" {
+  name = "my-dr-cluster"
+}
+
+# Look up replication target cluster by name, only considering the
+# replication pairs of a specific source cluster.
+data "polaris_replication_target_cluster" "target_for_source" {
+  name              = "my-dr-cluster"
+  source_cluster_id = "0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2"
+}
+
+output "target_cluster_id" {
+  value = data.polaris_replication_target_cluster.target.id
+}
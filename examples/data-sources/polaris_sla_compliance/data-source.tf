@@ -0,0 +1,16 @@
+data "polaris_sla_domain" "gold" {
+  name = "gold"
+}
+
+# Look up the compliance state of the objects protected by an SLA domain.
+data "polaris_sla_compliance" "gold" {
+  sla_domain_id = data.polaris_sla_domain.gold.id
+}
+
+# Fail the pipeline when protection is degraded.
+check "gold_compliance" {
+  assert {
+    condition     = data.polaris_sla_compliance.gold.out_of_compliance_count == 0
+    error_message = "Objects protected by the gold SLA domain are out of compliance."
+  }
+}
@@ -0,0 +1,53 @@
+data "polaris_sla_domain_template" "gold" {
+  name = "gold"
+}
+
+# Estimate the effect of extending the daily retention of the gold SLA domain.
+data "polaris_sla_domain_estimate" "gold_long_retention" {
+  configuration = merge(data.polaris_sla_domain_template.gold.template, {
+    daily_schedule = merge(data.polaris_sla_domain_template.gold.template.daily_schedule, {
+      retention = 90
+    })
+  })
+
+  object_ids = [
+    "2a3b1b6e-0a8d-4c1e-9b7f-5e6d4c3b2a10",
+    "8f9e7d6c-5b4a-4c3d-9e2f-1a0b9c8d7e6f",
+  ]
+}
+
+# Estimate a new SLA domain with instant archival.
+data "polaris_sla_domain_estimate" "archive" {
+  configuration = {
+    object_types = ["VSPHERE_OBJECT_TYPE"]
+
+    daily_schedule = {
+      frequency      = 1
+      retention      = 30
+      retention_unit = "DAYS"
+    }
+    monthly_schedule = {
+      day_of_month   = "LAST_DAY"
+      frequency      = 1
+      retention      = 7
+      retention_unit = "YEARS"
+    }
+    archival = [{
+      archival_location_id = "a4e1e2b4-4d5c-4f0b-9a4f-0a5c7b6d2e3f"
+      threshold            = 0
+      threshold_unit       = "DAYS"
+    }]
+    local_retention = {
+      retention      = 7
+      retention_unit = "DAYS"
+    }
+  }
+
+  object_ids = [
+    "2a3b1b6e-0a8d-4c1e-9b7f-5e6d4c3b2a10",
+  ]
+}
+
+output "archive_physical_bytes" {
+  value = data.polaris_sla_domain_estimate.archive.archival[0].physical_bytes
+}
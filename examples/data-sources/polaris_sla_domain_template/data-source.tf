@@ -0,0 +1,14 @@
+data "polaris_sla_domain_template" "gold" {
+  name = "gold"
+}
+
+# Variant of the gold SLA domain with a longer daily retention.
+resource "polaris_sla_domain" "gold_long_retention" {
+  name         = "gold-long-retention"
+  object_types = data.polaris_sla_domain_template.gold.template.object_types
+
+  daily_schedule = merge(data.polaris_sla_domain_template.gold.template.daily_schedule, {
+    retention = 90
+  })
+  weekly_schedule = data.polaris_sla_domain_template.gold.template.weekly_schedule
+}
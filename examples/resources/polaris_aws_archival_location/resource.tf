@@ -16,3 +16,16 @@ resource "polaris_aws_archival_location" "archival_location" {
   bucket_prefix = "f48wad7flz"
   region        = "us-east-2"
 }
+
+# Paused archival location, with the connection tested on create.
+resource "polaris_aws_archival_location" "archival_location" {
+  account_id          = data.polaris_aws_account.archival.id
+  name                = "my-archival-location"
+  bucket_prefix       = "k3p9sd0cmx"
+  archival_state      = "PAUSED"
+  validate_connection = true
+
+  timeouts {
+    create = "20m"
+  }
+}
@@ -1,3 +1,4 @@
+# Bootstrap a cluster with the nodes specified as a map.
 resource "polaris_cdm_bootstrap" "default" {
   admin_email            = "admin@example.org"
   admin_password         = "password"
@@ -11,6 +12,91 @@ resource "polaris_cdm_bootstrap" "default" {
   dns_name_servers       = ["10.1.150.100", "10.1.150.200"]
   management_gateway     = "10.1.100.1"
   management_subnet_mask = "255.255.255.0"
-  ntp_server1_name       = "10.1.200.100"
-  ntp_server2_name       = "10.1.200.200"
+
+  ntp_server {
+    name = "10.1.200.100"
+  }
+
+  ntp_server {
+    name = "10.1.200.200"
+  }
+}
+
+# Bootstrap a cluster with data and IPMI interfaces and a tagged VLAN on each
+# node.
+resource "polaris_cdm_bootstrap" "segmented" {
+  admin_email            = "admin@example.org"
+  admin_password         = "password"
+  cluster_name           = "my-segmented-cluster"
+  dns_search_domain      = ["example.org"]
+  dns_name_servers       = ["10.1.150.100", "10.1.150.200"]
+  management_gateway     = "10.1.100.1"
+  management_subnet_mask = "255.255.255.0"
+
+  node {
+    name          = "my-cluster-node-1"
+    management_ip = "10.1.100.100"
+    data_ip       = "10.1.110.100"
+    ipmi_ip       = "10.1.120.100"
+    vlan_ips = {
+      "200" = "10.200.0.100"
+    }
+  }
+
+  node {
+    name          = "my-cluster-node-2"
+    management_ip = "10.1.100.101"
+    data_ip       = "10.1.110.101"
+    ipmi_ip       = "10.1.120.101"
+    vlan_ips = {
+      "200" = "10.200.0.101"
+    }
+  }
+
+  data_network {
+    gateway     = "10.1.110.1"
+    subnet_mask = "255.255.255.0"
+  }
+
+  ipmi_network {
+    gateway     = "10.1.120.1"
+    subnet_mask = "255.255.255.0"
+  }
+
+  vlan {
+    vlan_id     = 200
+    subnet_mask = "255.255.255.0"
+    gateway     = "10.200.0.1"
+  }
+
+  ntp_server {
+    name = "ntp1.example.org"
+  }
+
+  ntp_server {
+    name     = "ntp2.example.org"
+    key      = "my-symmetric-key"
+    key_id   = 1
+    key_type = "SHA1"
+  }
+}
+
+# Bootstrap a cluster using IPv6 addressing.
+resource "polaris_cdm_bootstrap" "ipv6" {
+  admin_email            = "admin@example.org"
+  admin_password         = "password"
+  cluster_name           = "my-ipv6-cluster"
+  cluster_nodes = {
+    "my-cluster-node-1" = "2001:db8:100::100",
+    "my-cluster-node-2" = "2001:db8:100::101",
+    "my-cluster-node-3" = "2001:db8:100::102",
+  }
+  dns_search_domain      = ["example.org"]
+  dns_name_servers       = ["2001:db8:150::100"]
+  management_gateway     = "2001:db8:100::1"
+  management_subnet_mask = "ffff:ffff:ffff:ffff::"
+
+  ntp_server {
+    name = "ntp1.example.org"
+  }
 }
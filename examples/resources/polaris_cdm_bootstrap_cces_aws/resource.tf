@@ -13,6 +13,12 @@ resource "polaris_cdm_bootstrap_cces_aws" "default" {
   enable_immutability    = true
   management_gateway     = "10.1.100.1"
   management_subnet_mask = "255.255.255.0"
-  ntp_server1_name       = "10.1.200.100"
-  ntp_server2_name       = "10.1.200.200"
+
+  ntp_server {
+    name = "10.1.200.100"
+  }
+
+  ntp_server {
+    name = "10.1.200.200"
+  }
 }
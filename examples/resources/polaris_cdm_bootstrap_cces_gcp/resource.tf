@@ -0,0 +1,23 @@
+resource "polaris_cdm_bootstrap_cces_gcp" "default" {
+  admin_email            = "admin@example.org"
+  admin_password         = "password"
+  bucket_name            = "my-cluster-bucket"
+  cluster_name           = "my-cluster"
+  cluster_nodes          = {
+    "my-cluster-node-1" = "10.1.100.100",
+    "my-cluster-node-2" = "10.1.100.101",
+    "my-cluster-node-3" = "10.1.100.102",
+  }
+  dns_search_domain      = ["example.org"]
+  dns_name_servers       = ["10.1.150.100", "10.1.150.200"]
+  management_gateway     = "10.1.100.1"
+  management_subnet_mask = "255.255.255.0"
+
+  ntp_server {
+    name = "10.1.200.100"
+  }
+
+  ntp_server {
+    name = "10.1.200.200"
+  }
+}
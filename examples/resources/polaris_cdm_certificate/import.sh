@@ -0,0 +1 @@
+% terraform import polaris_cdm_certificate.web 10.1.100.100/Certificate:::8a5d7f2e-5a3f-4c4e-9d3b-2f1d0a6b9c11
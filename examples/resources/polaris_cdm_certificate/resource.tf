@@ -0,0 +1,12 @@
+resource "polaris_cdm_certificate" "web" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  name                    = "web-server"
+  description             = "Web server certificate"
+  certificate             = file("${path.module}/rubrik.example.org.crt")
+  private_key             = file("${path.module}/rubrik.example.org.key")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
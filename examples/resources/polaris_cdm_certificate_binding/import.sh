@@ -0,0 +1 @@
+% terraform import polaris_cdm_certificate_binding.web 10.1.100.100/WEB_SERVER
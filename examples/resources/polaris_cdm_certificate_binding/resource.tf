@@ -0,0 +1,22 @@
+resource "polaris_cdm_certificate" "web" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  name                    = "web-server"
+  certificate             = file("${path.module}/rubrik.example.org.crt")
+  private_key             = file("${path.module}/rubrik.example.org.key")
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "polaris_cdm_certificate_binding" "web" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  certificate_id          = polaris_cdm_certificate.web.id
+  endpoint                = "WEB_SERVER"
+}
+
+output "web_certificate_expiration" {
+  value = polaris_cdm_certificate_binding.web.expiration
+}
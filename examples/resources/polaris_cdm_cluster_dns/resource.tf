@@ -0,0 +1,6 @@
+resource "polaris_cdm_cluster_dns" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  dns_name_servers        = ["10.1.150.100", "10.1.150.200"]
+  dns_search_domain       = ["example.org"]
+}
@@ -0,0 +1 @@
+% terraform import polaris_cdm_cluster_nodes.default 10.1.100.100
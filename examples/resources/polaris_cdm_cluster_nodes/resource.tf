@@ -0,0 +1,17 @@
+# Expand a bootstrapped 3 node cluster to 4 nodes.
+resource "polaris_cdm_cluster_nodes" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  cluster_nodes = {
+    "my-cluster-node-1" = "10.1.100.100",
+    "my-cluster-node-2" = "10.1.100.101",
+    "my-cluster-node-3" = "10.1.100.102",
+    "my-cluster-node-4" = "10.1.100.103",
+  }
+  management_gateway     = "10.1.100.1"
+  management_subnet_mask = "255.255.255.0"
+}
+
+output "node_status" {
+  value = polaris_cdm_cluster_nodes.default.node_status
+}
@@ -0,0 +1,15 @@
+resource "polaris_cdm_cluster_ntp" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+
+  ntp_server {
+    name = "10.1.200.100"
+  }
+
+  ntp_server {
+    name     = "10.1.200.200"
+    key      = "ntp-key"
+    key_id   = 1
+    key_type = "SHA1"
+  }
+}
@@ -0,0 +1,7 @@
+resource "polaris_cdm_cluster_proxy" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  host                    = "proxy.example.org"
+  port                    = 3128
+  protocol                = "HTTP"
+}
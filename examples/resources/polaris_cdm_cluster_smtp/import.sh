@@ -0,0 +1 @@
+% terraform import polaris_cdm_cluster_smtp.default 10.1.100.100
@@ -0,0 +1,10 @@
+resource "polaris_cdm_cluster_smtp" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  host                    = "smtp.example.org"
+  port                    = 587
+  security                = "STARTTLS"
+  username                = "rubrik"
+  password                = "smtp-password"
+  from_email              = "rubrik@example.org"
+}
@@ -0,0 +1,9 @@
+resource "polaris_cdm_cluster_snmp" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+  community_string        = "community"
+
+  trap_receiver {
+    address = "10.1.250.100"
+  }
+}
@@ -0,0 +1,14 @@
+resource "polaris_cdm_cluster_syslog" "default" {
+  admin_password          = "password"
+  cluster_node_ip_address = "10.1.100.100"
+
+  server {
+    host = "syslog1.example.org"
+  }
+
+  server {
+    host     = "syslog2.example.org"
+    port     = 6514
+    protocol = "TCP"
+  }
+}
@@ -4,8 +4,19 @@ variable "admin_password" {
   sensitive   = true
 }
 
+# Register the cluster using offline entitlement.
 resource "polaris_cdm_registration" "cluster_registration" {
   admin_password          = var.admin_password
   cluster_name            = "my-cluster"
   cluster_node_ip_address = "10.0.100.101"
 }
+
+# Register the cluster using a registration token generated by RSC and remove
+# the cluster from RSC when the resource is destroyed.
+resource "polaris_cdm_registration" "token_registration" {
+  admin_password          = var.admin_password
+  cluster_name            = "my-cluster"
+  cluster_node_ip_address = "10.0.100.101"
+  registration_method     = "TOKEN"
+  unregister_on_destroy   = true
+}
@@ -0,0 +1 @@
+% terraform import polaris_cdm_sla_domain.gold 10.1.100.100/5d3a0c1e-8f7b-4b6a-9e2d-1c0b3a4f5e6d
@@ -0,0 +1,21 @@
+resource "polaris_cdm_sla_domain" "gold" {
+  cluster_node_ip_address = "10.1.100.100"
+  admin_password          = "password"
+  name                    = "gold"
+
+  hourly_schedule {
+    frequency = 4
+    retention = 24
+  }
+
+  daily_schedule {
+    frequency = 1
+    retention = 30
+  }
+
+  monthly_schedule {
+    frequency    = 1
+    retention    = 12
+    day_of_month = "LastDay"
+  }
+}
@@ -0,0 +1,29 @@
+# Upgrade a single cluster using a rolling upgrade.
+resource "polaris_cluster_upgrade" "default" {
+  cluster_id = "b6b1ad08-3d4c-4a5b-9c1e-2f2a8d0e7c51"
+  version    = "9.2.3-p2-29595"
+}
+
+# Upgrade a set of edge clusters, starting the upgrades within a nightly
+# maintenance window and upgrading at most 5 clusters at the same time.
+data "polaris_clusters" "edge" {
+  name = "edge-"
+}
+
+resource "polaris_cluster_upgrade" "edge" {
+  for_each = { for cluster in data.polaris_clusters.edge.clusters : cluster.name => cluster.id }
+
+  cluster_id              = each.value
+  version                 = "9.2.3-p2-29595"
+  max_concurrent_upgrades = 5
+
+  maintenance_window {
+    start_time = "22:00"
+    duration   = 6
+  }
+
+  timeouts {
+    create = "48h"
+    update = "48h"
+  }
+}
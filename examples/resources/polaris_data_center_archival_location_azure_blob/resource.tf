@@ -0,0 +1,17 @@
+data "polaris_data_center_azure_subscription" "archival" {
+  name = "archival-subscription"
+}
+
+resource "polaris_data_center_archival_location_azure_blob" "archival_location" {
+  name                 = "azure-blob-archival-location"
+  cluster_id           = "a501dae9-a27b-4ade-a604-fb103fba2fde"
+  cloud_account_id     = data.polaris_data_center_azure_subscription.archival.id
+  storage_account_name = "archivalstorage"
+  container_name       = "archival-container"
+  access_key           = var.storage_account_access_key
+  encryption_password  = var.encryption_password
+
+  immutability_settings {
+    lock_period = 30
+  }
+}
@@ -0,0 +1,9 @@
+resource "polaris_data_center_archival_location_gcs" "archival_location" {
+  name                = "gcs-archival-location"
+  cluster_id          = "a501dae9-a27b-4ade-a604-fb103fba2fde"
+  bucket_name         = "archival-bucket"
+  region              = "us-east1"
+  storage_class       = "NEARLINE"
+  credentials         = file("${path.module}/service-account-key.json")
+  encryption_password = var.encryption_password
+}
@@ -0,0 +1,12 @@
+resource "polaris_data_center_archival_location_nfs" "archival_location" {
+  name                = "nfs-archival-location"
+  cluster_id          = "a501dae9-a27b-4ade-a604-fb103fba2fde"
+  host                = "nfs.example.com"
+  export_directory    = "/exports/archival"
+  nfs_version         = "NFS_V4"
+  encryption_password = var.encryption_password
+
+  immutability_settings {
+    lock_period = 14
+  }
+}
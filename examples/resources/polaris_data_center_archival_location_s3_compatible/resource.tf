@@ -0,0 +1,16 @@
+resource "polaris_data_center_archival_location_s3_compatible" "archival_location" {
+  name                = "s3-compatible-archival-location"
+  cluster_id          = "a501dae9-a27b-4ade-a604-fb103fba2fde"
+  endpoint            = "https://s3.example.com:9000"
+  bucket_prefix       = "rubrik-archival"
+  access_key          = var.access_key
+  secret_key          = var.secret_key
+  ca_certificate      = file("${path.module}/ca.pem")
+  encryption_password = var.encryption_password
+
+  archival_proxy_settings {
+    protocol     = "HTTPS"
+    proxy_server = "proxy.example.com"
+    port_number  = 3128
+  }
+}
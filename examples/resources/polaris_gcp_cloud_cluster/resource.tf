@@ -0,0 +1,81 @@
+# Create a GCP cloud cluster using RSC
+resource "polaris_gcp_cloud_cluster" "example" {
+  cloud_account_id = "12345678-1234-1234-1234-123456789012"
+  region           = "us-west1"
+  zone             = "us-west1-a"
+
+  cluster_config {
+    cluster_name            = "my-cloud-cluster"
+    admin_email             = "admin@example.com"
+    admin_password          = "RubrikGoForward!"
+    dns_name_servers        = ["8.8.8.8", "8.8.4.4"]
+    dns_search_domains      = ["example.com"]
+    ntp_servers             = ["pool.ntp.org"]
+    num_nodes               = 3
+    bucket_name             = "my-gcs-bucket"
+    keep_cluster_on_failure = false
+  }
+
+  vm_config {
+    cdm_version   = "9.4.0-p2-30507"
+    instance_type = "N2_STANDARD_16"
+
+    network_config {
+      network = "my-vpc"
+      subnet  = "my-subnet"
+    }
+
+    service_accounts {
+      email  = "cloud-cluster@my-project.iam.gserviceaccount.com"
+      scopes = ["https://www.googleapis.com/auth/cloud-platform"]
+    }
+  }
+}
+
+# Create a GCP cloud cluster with zone resiliency using a shared VPC network
+resource "polaris_gcp_cloud_cluster" "multi_az" {
+  cloud_account_id = "12345678-1234-1234-1234-123456789012"
+  region           = "us-west1"
+  az_resilient     = true
+
+  cluster_config {
+    cluster_name            = "my-multi-az-cluster"
+    admin_email             = "admin@example.com"
+    admin_password          = "RubrikGoForward!"
+    dns_name_servers        = ["8.8.8.8", "8.8.4.4"]
+    ntp_servers             = ["pool.ntp.org"]
+    num_nodes               = 3
+    bucket_name             = "my-gcs-bucket"
+    keep_cluster_on_failure = false
+  }
+
+  vm_config {
+    cdm_version   = "9.4.0-p2-30507"
+    instance_type = "N2_STANDARD_16"
+
+    network_config {
+      host_project = "my-host-project"
+      network      = "my-shared-vpc"
+      subnet       = "my-subnet-a"
+    }
+
+    service_accounts {
+      email = "cloud-cluster@my-project.iam.gserviceaccount.com"
+    }
+
+    subnet_az_config {
+      availability_zone = "us-west1-a"
+      subnet            = "my-subnet-a"
+    }
+
+    subnet_az_config {
+      availability_zone = "us-west1-b"
+      subnet            = "my-subnet-b"
+    }
+
+    subnet_az_config {
+      availability_zone = "us-west1-c"
+      subnet            = "my-subnet-c"
+    }
+  }
+}
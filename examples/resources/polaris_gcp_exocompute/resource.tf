@@ -1,3 +1,4 @@
+# RSC managed Exocompute with customer managed networking.
 resource "polaris_gcp_exocompute" "exocompute" {
   cloud_account_id     = polaris_gcp_project.project.id
   trigger_health_check = true
@@ -14,3 +15,14 @@ resource "polaris_gcp_exocompute" "exocompute" {
     vpc_name    = "my-vpc-02"
   }
 }
+
+# Customer managed Exocompute. Use the polaris_gcp_exocompute_cluster_attachment
+# resource to attach a GKE cluster.
+resource "polaris_gcp_exocompute" "host" {
+  cloud_account_id = polaris_gcp_project.project.id
+
+  regional_config {
+    region           = "us-east1"
+    customer_managed = true
+  }
+}
@@ -0,0 +1,15 @@
+# Customer managed host Exocompute configuration.
+resource "polaris_gcp_exocompute" "host" {
+  cloud_account_id = polaris_gcp_project.project.id
+
+  regional_config {
+    region           = "us-east1"
+    customer_managed = true
+  }
+}
+
+resource "polaris_gcp_exocompute_cluster_attachment" "cluster" {
+  cloud_account_id = polaris_gcp_exocompute.host.id
+  cluster_name     = "my-gke-cluster"
+  region           = "us-east1"
+}
@@ -0,0 +1,4 @@
+import {
+  to = polaris_gcp_private_container_registry.registry
+  id = "1571ec5d-1738-439e-9f49-1830fbecd1b2"
+}
@@ -0,0 +1 @@
+% terraform import polaris_gcp_private_container_registry.registry 1571ec5d-1738-439e-9f49-1830fbecd1b2
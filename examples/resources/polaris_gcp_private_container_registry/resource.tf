@@ -0,0 +1,8 @@
+data "polaris_gcp_project" "project" {
+  name = "project"
+}
+
+resource "polaris_gcp_private_container_registry" "registry" {
+  cloud_account_id = data.polaris_gcp_project.project.id
+  url              = "us-east1-docker.pkg.dev/my-project/my-repository"
+}
@@ -0,0 +1 @@
+% terraform import polaris_replication_pair.nat 0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2:a9e1f58d-1b52-4a3f-b1d4-54f1d6c1e8b7
@@ -0,0 +1,47 @@
+# Replication pair using a private network.
+resource "polaris_replication_pair" "private_network" {
+  source_cluster_id      = "0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2"
+  target_cluster_id      = "f3c4ae0e-2d59-4e7e-9c35-8f0b7e04a5d1"
+  setup_type             = "PRIVATE_NETWORK"
+  target_cluster_address = "10.0.1.20"
+  username               = "admin"
+  password               = var.target_cluster_password
+}
+
+# Replication pair using NAT gateways, with the replication traffic limited
+# during business hours.
+resource "polaris_replication_pair" "nat" {
+  source_cluster_id = "0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2"
+  target_cluster_id = "a9e1f58d-1b52-4a3f-b1d4-54f1d6c1e8b7"
+  setup_type        = "NAT"
+  username          = "admin"
+  password          = var.target_cluster_password
+
+  source_gateway {
+    address = "203.0.113.10"
+    ports   = [7785, 7786]
+  }
+
+  target_gateway {
+    address = "198.51.100.20"
+    ports   = [7785, 7786]
+  }
+
+  bandwidth_throttle {
+    default_limit = 0
+
+    schedule {
+      day_of_week = "MONDAY"
+      start_time  = "08:00"
+      end_time    = "18:00"
+      limit       = 100
+    }
+
+    schedule {
+      day_of_week = "TUESDAY"
+      start_time  = "08:00"
+      end_time    = "18:00"
+      limit       = 100
+    }
+  }
+}
@@ -0,0 +1,17 @@
+# Re-point the existing archived snapshots to the new archival location. The
+# data of the old archival location must have been copied to the new archival
+# location before the migration.
+resource "polaris_sla_archival_location_migration" "migration" {
+  sla_domain_id      = polaris_sla_domain.gold.id
+  source_location_id = polaris_aws_archival_location.old.id
+  target_location_id = polaris_aws_archival_location.new.id
+}
+
+# Keep the existing archived snapshots in the old archival location until they
+# expire.
+resource "polaris_sla_archival_location_migration" "migration" {
+  sla_domain_id      = polaris_sla_domain.gold.id
+  source_location_id = polaris_aws_archival_location.old.id
+  target_location_id = polaris_aws_archival_location.new.id
+  existing_snapshots = "RETAIN"
+}
@@ -6,18 +6,18 @@ resource "polaris_sla_domain" "daily" {
   name         = "daily"
   description  = "Daily SLA Domain"
   object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
-  daily_schedule {
+  daily_schedule = {
     frequency = 1
     retention = 7
   }
-  snapshot_window {
+  snapshot_window = [{
     start_at = "09:00"
     duration = 4
-  }
-  first_full_snapshot {
+  }]
+  first_full_snapshot = [{
     start_at = "Tue, 19:00"
     duration = 5
-  }
+  }]
 }
 
 
@@ -33,13 +33,13 @@ resource "polaris_sla_domain" "weekly" {
   name         = "weekly"
   description  = "Weekly SLA Domain"
   object_types = ["AZURE_BLOB_OBJECT_TYPE"]
-  weekly_schedule {
+  weekly_schedule = {
     day_of_week    = "MONDAY"
     frequency      = 1
     retention      = 4
     retention_unit = "WEEKS"
   }
-  azure_blob_config {
+  azure_blob_config = {
     archival_location_id = data.polaris_azure_archival_location.archival_location.id
   }
 }
@@ -69,40 +69,40 @@ resource "polaris_sla_domain" "with_cascading_archival" {
   description  = "SLA Domain with replication and cascading archival"
   object_types = ["VSPHERE_OBJECT_TYPE"]
 
-  daily_schedule {
+  daily_schedule = {
     frequency      = 1
     retention      = 7
     retention_unit = "DAYS"
   }
 
-  replication_spec {
+  replication_spec = [{
     retention      = 7
     retention_unit = "DAYS"
 
-    local_retention {
+    local_retention = {
       retention      = 7
       retention_unit = "DAYS"
     }
 
-    replication_pair {
+    replication_pair = [{
       source_cluster = data.polaris_sla_source_cluster.mycluster2.id
       target_cluster = data.polaris_sla_source_cluster.mycluster1.id
-    }
+    }]
 
-    cascading_archival {
+    cascading_archival = [{
       archival_location_id    = data.polaris_data_center_archival_location.myarchivallocation.id
       archival_threshold      = 7
       archival_threshold_unit = "DAYS"
       frequency               = ["DAYS"]
 
-      archival_tiering {
+      archival_tiering = {
         instant_tiering                    = true
         cold_storage_class                 = "AZURE_ARCHIVE"
         min_accessible_duration_in_seconds = 86400
         tier_existing_snapshots            = false
       }
-    }
-  }
+    }]
+  }]
 }
 
 # Azure SQL Database V2 (Rubrik-managed) SLA
@@ -114,19 +114,19 @@ resource "polaris_sla_domain" "azure_sql_v2" {
   description  = "Rubrik-managed Azure SQL Database SLA"
   object_types = ["AZURE_SQL_DATABASE_OBJECT_TYPE"]
 
-  hourly_schedule {
+  hourly_schedule = {
     frequency      = 1
     retention      = 1
     retention_unit = "DAYS"
   }
 
-  azure_sql_database_config {
+  azure_sql_database_config = {
     log_retention = 7
   }
 
-  backup_location {
+  backup_location = [{
     archival_group_id = data.polaris_azure_archival_location.archival_location.id
-  }
+  }]
 }
 
 # Azure SQL Database V1 (Azure-managed / long-term retention) SLA
@@ -137,22 +137,58 @@ resource "polaris_sla_domain" "azure_sql_v1" {
   description  = "Azure-managed (LTR) Azure SQL Database SLA"
   object_types = ["AZURE_SQL_DATABASE_OBJECT_TYPE"]
 
-  azure_sql_database_config {
+  azure_sql_database_config = {
     log_retention = 7
-    ltr_config {
-      weekly_retention {
+    ltr_config = {
+      weekly_retention = {
         retention      = 4
         retention_unit = "WEEKS"
       }
-      monthly_retention {
+      monthly_retention = {
         retention      = 12
         retention_unit = "MONTHS"
       }
-      yearly_retention {
+      yearly_retention = {
         retention      = 7
         retention_unit = "YEARS"
         week_of_year   = 1
       }
     }
   }
-}
\ No newline at end of file
+}
+
+# Variant of the daily SLA domain, cloning all fields not specified. Changes to
+# the daily SLA domain are propagated to the variant on the next apply.
+resource "polaris_sla_domain" "daily_long_retention" {
+  name          = "daily-long-retention"
+  object_types  = polaris_sla_domain.daily.object_types
+  clone_from_id = polaris_sla_domain.daily.id
+
+  daily_schedule = {
+    frequency      = 1
+    retention      = 90
+    retention_unit = "DAYS"
+  }
+}
+
+# Compliance mode retention locked SLA domain. Reducing the daily retention or
+# destroying the SLA domain fails when planning.
+resource "polaris_sla_domain" "locked" {
+  name         = "locked"
+  object_types = ["VSPHERE_OBJECT_TYPE"]
+
+  daily_schedule = {
+    frequency      = 1
+    retention      = 30
+    retention_unit = "DAYS"
+  }
+
+  retention_lock = {
+    mode                           = "COMPLIANCE"
+    compliance_mode_acknowledgment = true
+  }
+}
+
+output "locked_at" {
+  value = polaris_sla_domain.locked.locked_at
+}
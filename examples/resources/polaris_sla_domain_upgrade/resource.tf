@@ -0,0 +1,21 @@
+# Convert a local SLA domain into a global SLA domain.
+resource "polaris_sla_domain_upgrade" "gold" {
+  cluster_id    = polaris_cdm_sla_domain.gold.cluster_id
+  sla_domain_id = polaris_cdm_sla_domain.gold.id
+}
+
+# Once converted, remove the local SLA domain resource from the configuration
+# without destroying the SLA domain and import the SLA domain into a
+# polaris_sla_domain resource.
+removed {
+  from = polaris_cdm_sla_domain.gold
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+import {
+  to = polaris_sla_domain.gold
+  id = "5d3a0c1e-8f7b-4b6a-9e2d-1c0b3a4f5e6d"
+}
@@ -0,0 +1,18 @@
+data "polaris_sla_domain" "gold" {
+  name = "gold"
+}
+
+# Pause the SLA domain on all Rubrik clusters it has been synced to during a
+# maintenance window. Snapshot scheduling is resumed by the first apply after
+# resume_at.
+resource "polaris_sla_pause" "maintenance" {
+  sla_domain_ids = [
+    data.polaris_sla_domain.gold.id,
+  ]
+  resume_at = "2026-11-01T06:00:00Z"
+}
+
+# Pause all protection on a Rubrik cluster until the resource is destroyed.
+resource "polaris_sla_pause" "cluster" {
+  cluster_id = "b2e5a8e9-7d3a-4c1e-9f5b-6a2d8c4e1f30"
+}
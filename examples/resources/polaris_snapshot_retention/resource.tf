@@ -0,0 +1,30 @@
+data "polaris_sla_domain" "legal_hold" {
+  name = "legal-hold"
+}
+
+data "polaris_snapshot" "before_incident" {
+  workload_id = "a3c1e5f7-2b4d-4f6a-8c0e-1d3f5a7b9c2e"
+  before_time = "2026-10-01T00:00:00Z"
+}
+
+# Retain the snapshot using the legal hold SLA domain.
+resource "polaris_snapshot_retention" "legal_hold" {
+  workload_id   = data.polaris_snapshot.before_incident.workload_id
+  snapshot_ids  = [data.polaris_snapshot.before_incident.id]
+  retention     = "SLA"
+  sla_domain_id = data.polaris_sla_domain.legal_hold.id
+}
+
+# Retain the snapshot forever.
+resource "polaris_snapshot_retention" "keep_forever" {
+  workload_id  = data.polaris_snapshot.before_incident.workload_id
+  snapshot_ids = [data.polaris_snapshot.before_incident.id]
+  retention    = "KEEP_FOREVER"
+}
+
+# Expire the snapshot immediately.
+resource "polaris_snapshot_retention" "cleanup" {
+  workload_id  = data.polaris_snapshot.before_incident.workload_id
+  snapshot_ids = [data.polaris_snapshot.before_incident.id]
+  retention    = "EXPIRE_NOW"
+}
@@ -0,0 +1,47 @@
+# Start the creation of a cloud cluster without waiting for it to finish and
+# wait for the create job in a separate resource.
+resource "polaris_aws_cloud_cluster" "cluster" {
+  cloud_account_id    = polaris_aws_cnp_account.account.id
+  region              = "us-west-2"
+  wait_for_completion = false
+
+  # ...
+}
+
+resource "polaris_task_wait" "cluster" {
+  task_id    = polaris_aws_cloud_cluster.cluster.task_id
+  task_type  = "ACTIVITY_SERIES"
+  cluster_id = polaris_aws_cloud_cluster.cluster.id
+
+  timeouts {
+    create = "2h"
+  }
+}
+
+# Wait for an SLA archival location migration started without waiting for it
+# to finish.
+resource "polaris_sla_archival_location_migration" "migration" {
+  sla_domain_id       = polaris_sla_domain.gold.id
+  source_location_id  = polaris_aws_archival_location.old.id
+  target_location_id  = polaris_aws_archival_location.new.id
+  wait_for_completion = false
+}
+
+resource "polaris_task_wait" "migration" {
+  task_id   = polaris_sla_archival_location_migration.migration.id
+  task_type = "TASK_CHAIN"
+}
+
+# Onboard an RSC-managed AWS account without waiting for the features to
+# connect. The onboarding is completed by the next apply.
+resource "polaris_aws_account_managed_stack" "account" {
+  account_id          = polaris_aws_account_managed.account.id
+  stack_arn           = aws_cloudformation_stack.rubrik.id
+  permissions_version = polaris_aws_account_managed.account.permissions_version
+  wait_for_completion = false
+}
+
+resource "polaris_task_wait" "account" {
+  task_id   = polaris_aws_account_managed_stack.account.task_id
+  task_type = "AWS_CLOUD_ACCOUNT"
+}
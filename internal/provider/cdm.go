@@ -0,0 +1,225 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+// cdmClusterClient returns a CDM client for the cluster node with the IP
+// address of the resource. The client authenticates as the admin user using
+// the admin password of the resource. If the admin password isn't specified,
+// the client authenticates using the RUBRIK_CDM_TOKEN environment variable or
+// the RUBRIK_CDM_USERNAME and RUBRIK_CDM_PASSWORD environment variables. This
+// allows the cluster settings resources to be imported.
+func cdmClusterClient(d *schema.ResourceData, m any) (*cdm.Client, error) {
+	nodeIP := d.Get(keyClusterNodeIPAddress).(string)
+	if nodeIP == "" {
+		return nil, fmt.Errorf("%s is required", keyClusterNodeIPAddress)
+	}
+
+	if password := d.Get(keyAdminPassword).(string); password != "" {
+		return cdm.NewClientFromCredentialsWithLogger(nodeIP, "admin", password, true, m.(*client).logger)
+	}
+	if token := os.Getenv("RUBRIK_CDM_TOKEN"); token != "" {
+		return cdm.NewClientFromToken(nodeIP, token, true)
+	}
+	username := os.Getenv("RUBRIK_CDM_USERNAME")
+	password := os.Getenv("RUBRIK_CDM_PASSWORD")
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("%s is required when the RUBRIK_CDM_TOKEN or the RUBRIK_CDM_USERNAME and "+
+			"RUBRIK_CDM_PASSWORD environment variables are not set", keyAdminPassword)
+	}
+	return cdm.NewClientFromCredentialsWithLogger(nodeIP, username, password, true, m.(*client).logger)
+}
+
+// cdmClusterID returns the ID of the cluster the CDM client is connected to.
+func cdmClusterID(ctx context.Context, client *cdm.Client) (string, error) {
+	var cluster struct {
+		ID string `json:"id"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.V1, "/cluster/me", nil, &cluster); err != nil {
+		return "", err
+	}
+	if cluster.ID == "" {
+		return "", errors.New("cluster ID not found in response")
+	}
+
+	return cluster.ID, nil
+}
+
+// cdmRequest performs the CDM REST API request and unmarshals the response
+// into result, which must be a pointer or nil. Only use this for CDM APIs
+// which are not yet wrapped by the SDK.
+func cdmRequest(ctx context.Context, client *cdm.Client, method string, version cdm.APIVersion, endpoint string, payload, result any) error {
+	var buf []byte
+	var code int
+	var err error
+	switch method {
+	case http.MethodGet:
+		buf, code, err = client.Get(ctx, version, endpoint)
+	case http.MethodPost:
+		buf, code, err = client.Post(ctx, version, endpoint, payload)
+	case http.MethodPut:
+		buf, code, err = client.Put(ctx, version, endpoint, payload)
+	case http.MethodPatch:
+		buf, code, err = client.Patch(ctx, version, endpoint, payload)
+	case http.MethodDelete:
+		buf, code, err = client.Delete(ctx, version, endpoint)
+	default:
+		return fmt.Errorf("unsupported HTTP method %q", method)
+	}
+	if err != nil {
+		return fmt.Errorf("failed %s request %q: %s", method, endpoint, err)
+	}
+	if code < 200 || code > 299 {
+		return fmt.Errorf("failed %s request %q: %s", method, endpoint, cdmErrorMessage(buf, code))
+	}
+
+	if result != nil && len(buf) > 0 {
+		if err := json.Unmarshal(buf, result); err != nil {
+			return fmt.Errorf("failed to unmarshal %s response %q: %s", method, endpoint, err)
+		}
+	}
+
+	return nil
+}
+
+// cdmWaitForRequest blocks until the asynchronous CDM request with the
+// specified status endpoint succeeds or fails. Both the bootstrap style status,
+// IN_PROGRESS, and the async request style status, QUEUED and RUNNING, are
+// supported.
+func cdmWaitForRequest(ctx context.Context, client *cdm.Client, version cdm.APIVersion, endpoint string, waitTime time.Duration) error {
+	for {
+		var request struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			Error   struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		if err := cdmRequest(ctx, client, http.MethodGet, version, endpoint, nil, &request); err != nil {
+			return err
+		}
+
+		switch request.Status {
+		case "IN_PROGRESS", "QUEUED", "ACQUIRING", "RUNNING", "FINISHING":
+			tflog.Debug(ctx, "request in progress", map[string]any{
+				"endpoint": endpoint,
+				"status":   request.Status,
+				"message":  request.Message,
+			})
+		case "FAILURE", "FAILED", "CANCELED":
+			msg := request.Message
+			if msg == "" {
+				msg = request.Error.Message
+			}
+			return fmt.Errorf("request %q failed: %s", endpoint, msg)
+		default:
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(waitTime):
+		}
+	}
+}
+
+// cdmErrorMessage returns an error message from the specified response and
+// HTTP status code.
+func cdmErrorMessage(res []byte, code int) string {
+	msg := fmt.Sprintf("%s (%d)", http.StatusText(code), code)
+
+	var cdmErr struct {
+		Type    string `json:"errorType"`
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(res, &cdmErr); err == nil && cdmErr.Message != "" {
+		if cdmErr.Type != "" {
+			return fmt.Sprintf("%s: %s: %s", msg, cdmErr.Type, cdmErr.Message)
+		}
+		return fmt.Sprintf("%s: %s", msg, cdmErr.Message)
+	}
+	if res := string(res); res != "" {
+		msg = fmt.Sprintf("%s: %s", msg, res)
+	}
+
+	return msg
+}
+
+// importCDMClusterSetting imports a cluster settings resource. The import ID
+// is the IP address of the cluster node to connect to. The credentials are
+// read from the environment, see cdmClusterClient.
+func importCDMClusterSetting(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	tflog.Trace(ctx, "importCDMClusterSetting")
+
+	if err := d.Set(keyClusterNodeIPAddress, d.Id()); err != nil {
+		return nil, err
+	}
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return nil, err
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(clusterID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// cdmAdminPasswordSchema returns the schema for the admin password used to
+// connect to the Rubrik cluster.
+func cdmAdminPasswordSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:      schema.TypeString,
+		Optional:  true,
+		Sensitive: true,
+		Description: "Password for the cluster admin account. If not specified, the credentials are read from the " +
+			"`RUBRIK_CDM_TOKEN`, or the `RUBRIK_CDM_USERNAME` and `RUBRIK_CDM_PASSWORD`, environment variables.",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
+
+// cdmClusterNodeIPAddressSchema returns the schema for the IP address of the
+// cluster node to connect to.
+func cdmClusterNodeIPAddressSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "The IP address of the cluster node to connect to.",
+		ValidateFunc: validation.IsIPAddress,
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCDMErrorMessage(t *testing.T) {
+	testCases := []struct {
+		name string
+		res  string
+		code int
+		msg  string
+	}{{
+		name: "NoBody",
+		code: http.StatusNotFound,
+		msg:  "Not Found (404)",
+	}, {
+		name: "JSONError",
+		res:  `{"errorType":"user_error","message":"Invalid hostname"}`,
+		code: http.StatusBadRequest,
+		msg:  "Bad Request (400): user_error: Invalid hostname",
+	}, {
+		name: "JSONErrorWithoutType",
+		res:  `{"message":"Invalid hostname"}`,
+		code: http.StatusBadRequest,
+		msg:  "Bad Request (400): Invalid hostname",
+	}, {
+		name: "TextError",
+		res:  "internal error",
+		code: http.StatusInternalServerError,
+		msg:  "Internal Server Error (500): internal error",
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if msg := cdmErrorMessage([]byte(testCase.res), testCase.code); msg != testCase.msg {
+				t.Fatalf("expected %q, got %q", testCase.msg, msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlcloudcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cloudcluster"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+)
+
+const (
+	// cloudClusterJobWaitTime is the time to wait between polls of a cloud
+	// cluster job.
+	cloudClusterJobWaitTime = 30 * time.Second
+
+	// cloudClusterJobStartTimeout is the time to wait for a cloud cluster job
+	// to show up as running. If the job hasn't started by then, waiting for the
+	// job fails.
+	cloudClusterJobStartTimeout = 10 * time.Minute
+)
+
+// addNodesToCloudClusterQuery is the GraphQL mutation used to add nodes to a
+// cloud cluster.
+const addNodesToCloudClusterQuery = `mutation SdkGolangAddNodesToCloudCluster($input: AddNodesToCloudClusterInput!) {
+    result: addNodesToCloudCluster(input: $input) {
+        jobId
+        message
+        success
+    }
+}`
+
+// migrateCloudClusterNodesQuery is the GraphQL mutation used to replace the
+// nodes of a cloud cluster with nodes of another instance type, one node at a
+// time.
+const migrateCloudClusterNodesQuery = `mutation SdkGolangMigrateCloudClusterNodes($input: MigrateCloudClusterNodesInput!) {
+    result: migrateCloudClusterNodes(input: $input) {
+        jobId
+        message
+        success
+    }
+}`
+
+// addNodesToCloudClusterInput holds the input of the add nodes to cloud
+// cluster mutation.
+type addNodesToCloudClusterInput struct {
+	ClusterID     uuid.UUID `json:"clusterUuid"`
+	NumNodesToAdd int       `json:"numNodesToAdd"`
+}
+
+// migrateCloudClusterNodesInput holds the input of the migrate cloud cluster
+// nodes mutation.
+type migrateCloudClusterNodesInput struct {
+	ClusterID    uuid.UUID `json:"clusterUuid"`
+	InstanceType string    `json:"instanceType"`
+	IsRolling    bool      `json:"isRolling"`
+}
+
+// cloudClusterJobReply holds the reply of a cloud cluster job mutation.
+type cloudClusterJobReply struct {
+	JobID   string `json:"jobId"`
+	Message string `json:"message"`
+	Success bool   `json:"success"`
+}
+
+// scaleOutCloudCluster adds nodes to the cloud cluster until the cluster has
+// numNodes nodes. Blocks until the add node job finishes.
+func scaleOutCloudCluster(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, oldNumNodes, numNodes int) error {
+	tflog.Info(ctx, "scaling out cloud cluster", map[string]any{
+		"cluster_id": clusterID.String(),
+		"num_nodes":  numNodes,
+	})
+
+	params := struct {
+		Input addNodesToCloudClusterInput `json:"input"`
+	}{Input: addNodesToCloudClusterInput{ClusterID: clusterID, NumNodesToAdd: numNodes - oldNumNodes}}
+	var reply cloudClusterJobReply
+	if err := gqlRequest(ctx, client.GQL, addNodesToCloudClusterQuery, params, &reply); err != nil {
+		return err
+	}
+	if !reply.Success {
+		return fmt.Errorf("failed to add nodes to cloud cluster %q: %s", clusterID, reply.Message)
+	}
+
+	return waitForCloudClusterJob(ctx, client.GQL, clusterID, gqlcluster.CCPJobTypeAddNode, func(c gqlcluster.Cluster) bool {
+		return len(c.ClusterNodes.Edges) >= numNodes
+	})
+}
+
+// replaceCloudClusterInstanceType replaces the nodes of the cloud cluster with
+// nodes of the specified instance type. The nodes are replaced one at a time,
+// keeping the cluster available. Blocks until the migrate nodes job finishes.
+func replaceCloudClusterInstanceType(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, instanceType string) error {
+	tflog.Info(ctx, "replacing cloud cluster nodes", map[string]any{
+		"cluster_id":    clusterID.String(),
+		"instance_type": instanceType,
+	})
+
+	// Look up the number of vCPUs of the new instance type, used to verify that
+	// the nodes have been replaced when the migrate nodes job completes.
+	cluster, err := cloudClusterByID(ctx, client.GQL, clusterID)
+	if err != nil {
+		return err
+	}
+	vcpuCount, err := cloudClusterInstanceTypeVCPUs(ctx, client.GQL, cluster, instanceType)
+	if err != nil {
+		return err
+	}
+
+	params := struct {
+		Input migrateCloudClusterNodesInput `json:"input"`
+	}{Input: migrateCloudClusterNodesInput{ClusterID: clusterID, InstanceType: instanceType, IsRolling: true}}
+	var reply cloudClusterJobReply
+	if err := gqlRequest(ctx, client.GQL, migrateCloudClusterNodesQuery, params, &reply); err != nil {
+		return err
+	}
+	if !reply.Success {
+		return fmt.Errorf("failed to replace nodes of cloud cluster %q: %s", clusterID, reply.Message)
+	}
+
+	return waitForCloudClusterJob(ctx, client.GQL, clusterID, gqlcluster.CCPJobTypeMigrateNodes,
+		cloudClusterNodesHaveVCPUs(vcpuCount))
+}
+
+// cloudClusterInstanceTypeVCPUs returns the number of vCPUs of the instance
+// type, for the cloud vendor of the cloud cluster.
+func cloudClusterInstanceTypeVCPUs(ctx context.Context, gql *graphql.Client, cluster gqlcluster.Cluster, instanceType string) (int, error) {
+	vendor := cluster.ProvisionInfo.Vendor
+	if vendor == "" {
+		vendor = cluster.CloudInfo.Vendor
+	}
+	props, err := gqlcloudcluster.Wrap(gql).CloudClusterInstanceProperties(ctx, gqlcloudcluster.CloudClusterInstancePropertiesRequest{
+		CloudVendor:  vendor,
+		InstanceType: instanceType,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to get properties of instance type %s: %s", instanceType, err)
+	}
+	if props.VcpuCount <= 0 {
+		return 0, fmt.Errorf("instance type %s has no vCPU count", instanceType)
+	}
+
+	return props.VcpuCount, nil
+}
+
+// cloudClusterInstanceType returns the instance type of the cloud cluster
+// nodes. RSC doesn't report the instance type of the nodes, so the instance
+// type is verified using the number of vCPUs reported for the nodes. When the
+// nodes don't have the number of vCPUs of the instance type, the empty string
+// is returned, so that the drift shows up in the plan. The instance type is
+// returned as is when the nodes don't report their number of vCPUs.
+func cloudClusterInstanceType(ctx context.Context, gql *graphql.Client, cluster gqlcluster.Cluster, instanceType string) (string, error) {
+	if instanceType == "" || len(cluster.ClusterNodes.Edges) == 0 {
+		return instanceType, nil
+	}
+	for _, edge := range cluster.ClusterNodes.Edges {
+		if edge.Node.CPUCores <= 0 {
+			return instanceType, nil
+		}
+	}
+
+	vcpuCount, err := cloudClusterInstanceTypeVCPUs(ctx, gql, cluster, instanceType)
+	if err != nil {
+		return "", err
+	}
+	if !cloudClusterNodesHaveVCPUs(vcpuCount)(cluster) {
+		return "", nil
+	}
+
+	return instanceType, nil
+}
+
+// cloudClusterNodesHaveVCPUs returns a done check which is true when all nodes
+// of the cluster have the specified number of vCPUs.
+func cloudClusterNodesHaveVCPUs(vcpuCount int) func(gqlcluster.Cluster) bool {
+	return func(cluster gqlcluster.Cluster) bool {
+		if len(cluster.ClusterNodes.Edges) == 0 {
+			return false
+		}
+		for _, edge := range cluster.ClusterNodes.Edges {
+			if edge.Node.CPUCores != vcpuCount {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// cloudClusterByID returns the cloud cluster with the specified ID.
+func cloudClusterByID(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID) (gqlcluster.Cluster, error) {
+	filter := gqlcluster.SearchFilter{ID: []string{clusterID.String()}}
+	page, err := gqlcluster.AllClusters(ctx, gql, 1, "", filter, gqlcluster.SortByClusterName, core.SortOrderDesc)
+	if err != nil {
+		return gqlcluster.Cluster{}, err
+	}
+	if len(page.Clusters) == 0 || page.Clusters[0].ID != clusterID {
+		return gqlcluster.Cluster{}, fmt.Errorf("cloud cluster %q %w", clusterID, graphql.ErrNotFound)
+	}
+
+	return page.Clusters[0], nil
+}
+
+// cloudClusterJobTracker tracks a cloud cluster job between polls. Since the
+// job status of a cloud cluster is only reported for the latest job, a job
+// status seen before the job has started can be left over from an earlier job.
+type cloudClusterJobTracker struct {
+	clusterID uuid.UUID
+	jobType   gqlcluster.CCPJobType
+	done      func(gqlcluster.Cluster) bool
+	start     time.Time
+	started   bool
+}
+
+// check returns true if the job has completed. An error is returned if the job
+// failed or if the job hasn't started within cloudClusterJobStartTimeout.
+func (t *cloudClusterJobTracker) check(cluster gqlcluster.Cluster, now time.Time) (bool, error) {
+	info := cluster.ProvisionInfo
+	if info.JobType == t.jobType {
+		switch info.JobStatus {
+		case gqlcluster.CCPJobStatusFailed:
+			if t.started {
+				return false, fmt.Errorf("cloud cluster %q job %s failed", t.clusterID, t.jobType)
+			}
+		case gqlcluster.CCPJobStatusCompleted:
+			// A completed job which hasn't been seen running is only accepted
+			// if the done check confirms the change.
+			if t.done == nil {
+				if t.started {
+					return true, nil
+				}
+			} else if t.done(cluster) {
+				return true, nil
+			}
+		default:
+			t.started = true
+		}
+	}
+
+	if !t.started && now.Sub(t.start) > cloudClusterJobStartTimeout {
+		return false, fmt.Errorf("cloud cluster %q job %s didn't start within %s, the latest job is %s with status %s",
+			t.clusterID, t.jobType, cloudClusterJobStartTimeout, info.JobType, info.JobStatus)
+	}
+
+	return false, nil
+}
+
+// waitForCloudClusterJob blocks until the cloud cluster job of the specified
+// type completes or fails. If done is not nil, the job is only considered
+// complete when done returns true for the cluster.
+func waitForCloudClusterJob(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID, jobType gqlcluster.CCPJobType, done func(gqlcluster.Cluster) bool) error {
+	tracker := cloudClusterJobTracker{clusterID: clusterID, jobType: jobType, done: done, start: time.Now()}
+	for {
+		cluster, err := cloudClusterByID(ctx, gql, clusterID)
+		if err != nil {
+			return err
+		}
+		completed, err := tracker.check(cluster, time.Now())
+		if err != nil {
+			return err
+		}
+		if completed {
+			return nil
+		}
+		if tracker.started {
+			tflog.Info(ctx, "cloud cluster job in progress", map[string]any{
+				"cluster_id": clusterID.String(),
+				"job_type":   jobType,
+				"job_status": cluster.ProvisionInfo.JobStatus,
+				"progress":   cluster.ProvisionInfo.Progress,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait for cloud cluster %q job %s: %w", clusterID, jobType, ctx.Err())
+		case <-time.After(cloudClusterJobWaitTime):
+		}
+	}
+}
+
+// customizeDiffCloudClusterLifecycle verifies that the in-place changes to an
+// existing cloud cluster are supported. The number of nodes can only grow and
+// the CDM version is checked for upgrade compatibility, so that a failing
+// upgrade is detected when planning.
+func customizeDiffCloudClusterLifecycle(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffCloudClusterLifecycle")
+
+	if diff.Id() == "" {
+		return nil
+	}
+
+	numNodesKey := keyClusterConfig + ".0." + keyNumNodes
+	if diff.HasChange(numNodesKey) && diff.NewValueKnown(numNodesKey) {
+		oldNumNodes, newNumNodes := diff.GetChange(numNodesKey)
+		if newNumNodes.(int) < oldNumNodes.(int) {
+			return fmt.Errorf("removing nodes from a cloud cluster is not supported, %s can only be increased",
+				keyNumNodes)
+		}
+		if oldNumNodes.(int) == 1 {
+			return errors.New("single node cloud clusters cannot be scaled out")
+		}
+	}
+
+	cdmVersionKey := keyVMConfig + ".0." + keyCDMVersion
+	if diff.HasChange(cdmVersionKey) && diff.NewValueKnown(cdmVersionKey) {
+		clusterID, err := uuid.Parse(diff.Id())
+		if err != nil {
+			return err
+		}
+		client, err := m.(*client).polaris()
+		if err != nil {
+			return err
+		}
+		oldVersion, newVersion := diff.GetChange(cdmVersionKey)
+		if err := checkClusterUpgrade(ctx, client.GQL, clusterID, oldVersion.(string), newVersion.(string)); err != nil {
+			return fmt.Errorf("pre-upgrade check failed: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// updateCloudClusterLifecycle performs the in-place lifecycle operations of a
+// cloud cluster: scale out, instance type replacement and CDM version upgrade.
+// The operations are performed one at a time, in that order.
+func updateCloudClusterLifecycle(ctx context.Context, d *schema.ResourceData, client *polaris.Client, clusterID uuid.UUID) error {
+	// When an operation fails, the planned values must not be saved to the
+	// state. Read refreshes the number of nodes and the instance type from RSC.
+	d.Partial(true)
+
+	numNodesKey := keyClusterConfig + ".0." + keyNumNodes
+	if d.HasChange(numNodesKey) {
+		oldNumNodes, newNumNodes := d.GetChange(numNodesKey)
+		if err := scaleOutCloudCluster(ctx, client, clusterID, oldNumNodes.(int), newNumNodes.(int)); err != nil {
+			return err
+		}
+	}
+
+	instanceTypeKey := keyVMConfig + ".0." + keyInstanceType
+	if d.HasChange(instanceTypeKey) {
+		if err := replaceCloudClusterInstanceType(ctx, client, clusterID, d.Get(instanceTypeKey).(string)); err != nil {
+			return err
+		}
+	}
+
+	cdmVersionKey := keyVMConfig + ".0." + keyCDMVersion
+	if d.HasChange(cdmVersionKey) {
+		if err := upgradeCluster(ctx, client, clusterID, gqlcluster.UpgradeTypeRolling, d.Get(cdmVersionKey).(string)); err != nil {
+			return err
+		}
+	}
+
+	d.Partial(false)
+	return nil
+}
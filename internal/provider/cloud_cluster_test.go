@@ -0,0 +1,293 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+)
+
+// testCloudCluster returns a cloud cluster with the specified job type, job
+// status and nodes with the specified number of vCPUs.
+func testCloudCluster(jobType gqlcluster.CCPJobType, jobStatus gqlcluster.CCPJobStatus, vcpus ...int) gqlcluster.Cluster {
+	cluster := gqlcluster.Cluster{
+		ProvisionInfo: gqlcluster.ProvisionInfo{JobType: jobType, JobStatus: jobStatus},
+	}
+	for _, vcpu := range vcpus {
+		var edge struct {
+			Node gqlcluster.Node `json:"node"`
+		}
+		edge.Node.CPUCores = vcpu
+		cluster.ClusterNodes.Edges = append(cluster.ClusterNodes.Edges, edge)
+	}
+
+	return cluster
+}
+
+func TestCloudClusterNodesHaveVCPUs(t *testing.T) {
+	done := cloudClusterNodesHaveVCPUs(16)
+
+	tests := []struct {
+		name    string
+		cluster gqlcluster.Cluster
+		want    bool
+	}{
+		{name: "NoNodes", cluster: testCloudCluster("", ""), want: false},
+		{name: "AllNodesReplaced", cluster: testCloudCluster("", "", 16, 16, 16), want: true},
+		{name: "SomeNodesReplaced", cluster: testCloudCluster("", "", 16, 8, 16), want: false},
+		{name: "NoNodesReplaced", cluster: testCloudCluster("", "", 8, 8, 8), want: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := done(tc.cluster); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCloudClusterJobTrackerCheck(t *testing.T) {
+	const (
+		migrate   = gqlcluster.CCPJobTypeMigrateNodes
+		addNode   = gqlcluster.CCPJobTypeAddNode
+		completed = gqlcluster.CCPJobStatusCompleted
+		failed    = gqlcluster.CCPJobStatusFailed
+		running   = gqlcluster.CCPJobStatusNodeCreate
+	)
+	start := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	beforeTimeout := start.Add(time.Minute)
+	afterTimeout := start.Add(cloudClusterJobStartTimeout + time.Minute)
+
+	type poll struct {
+		cluster gqlcluster.Cluster
+		now     time.Time
+	}
+	tests := []struct {
+		name          string
+		done          func(gqlcluster.Cluster) bool
+		polls         []poll
+		wantCompleted bool
+		wantErr       string
+	}{
+		{
+			name: "StartedThenCompleted",
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, running), now: beforeTimeout},
+				{cluster: testCloudCluster(migrate, completed), now: afterTimeout},
+			},
+			wantCompleted: true,
+		},
+		{
+			name: "StaleCompletedBeforeTimeout",
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, completed), now: beforeTimeout},
+			},
+		},
+		{
+			name: "StaleCompletedAfterTimeout",
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, completed), now: beforeTimeout},
+				{cluster: testCloudCluster(migrate, completed), now: afterTimeout},
+			},
+			wantErr: "didn't start within",
+		},
+		{
+			name: "StaleCompletedInstanceTypeNotChanged",
+			done: cloudClusterNodesHaveVCPUs(16),
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, completed, 8, 8, 8), now: afterTimeout},
+			},
+			wantErr: "didn't start within",
+		},
+		{
+			name: "CompletedBetweenPollsInstanceTypeChanged",
+			done: cloudClusterNodesHaveVCPUs(16),
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, completed, 16, 16, 16), now: beforeTimeout},
+			},
+			wantCompleted: true,
+		},
+		{
+			name: "StartedThenCompletedInstanceTypeNotChanged",
+			done: cloudClusterNodesHaveVCPUs(16),
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, running, 8, 8, 8), now: beforeTimeout},
+				{cluster: testCloudCluster(migrate, completed, 16, 8, 8), now: afterTimeout},
+			},
+		},
+		{
+			name: "StaleFailed",
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, failed), now: beforeTimeout},
+			},
+		},
+		{
+			name: "StartedThenFailed",
+			polls: []poll{
+				{cluster: testCloudCluster(migrate, running), now: beforeTimeout},
+				{cluster: testCloudCluster(migrate, failed), now: beforeTimeout},
+			},
+			wantErr: "failed",
+		},
+		{
+			name: "OtherJobTypeAfterTimeout",
+			polls: []poll{
+				{cluster: testCloudCluster(addNode, running), now: afterTimeout},
+			},
+			wantErr: "didn't start within",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := cloudClusterJobTracker{clusterID: uuid.New(), jobType: migrate, done: tc.done, start: start}
+			var completed bool
+			var err error
+			for _, p := range tc.polls {
+				completed, err = tracker.check(p.cluster, p.now)
+				if err != nil || completed {
+					break
+				}
+			}
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if completed != tc.wantCompleted {
+				t.Errorf("expected completed %t, got %t", tc.wantCompleted, completed)
+			}
+		})
+	}
+}
+
+func TestReplaceCloudClusterInstanceType(t *testing.T) {
+	clusterID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		switch req.Operation {
+		case "SdkGolangAllClustersConnection":
+			node := func(cpuCores int) any {
+				return map[string]any{"node": map[string]any{"cpuCores": cpuCores}}
+			}
+			return map[string]any{"edges": []any{map[string]any{"node": map[string]any{
+				"id":                    clusterID.String(),
+				"ccprovisionInfo":       map[string]any{"jobType": "MIGRATE_NODES", "jobStatus": "COMPLETED", "vendor": "AWS"},
+				"clusterNodeConnection": map[string]any{"edges": []any{node(16), node(16), node(16)}},
+			}}}}, nil
+		case "SdkGolangCloudClusterInstanceProperties":
+			return map[string]any{"instanceProperties": map[string]any{"instanceType": "M6I_4XLARGE", "vcpuCount": 16}}, nil
+		case "SdkGolangMigrateCloudClusterNodes":
+			return map[string]any{"jobId": "1", "success": true}, nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	if err := replaceCloudClusterInstanceType(context.Background(), c.polarisClient, clusterID, "M6I_4XLARGE"); err != nil {
+		t.Fatal(err)
+	}
+
+	var props, migrate map[string]any
+	for _, req := range srv.Requests() {
+		switch req.Operation {
+		case "SdkGolangCloudClusterInstanceProperties":
+			props = req.Variables["input"].(map[string]any)
+		case "SdkGolangMigrateCloudClusterNodes":
+			migrate = req.Variables["input"].(map[string]any)
+		}
+	}
+	if props["cloudVendor"] != "AWS" || props["instanceType"] != "M6I_4XLARGE" {
+		t.Errorf("unexpected instance properties input: %v", props)
+	}
+	if migrate["clusterUuid"] != clusterID.String() || migrate["instanceType"] != "M6I_4XLARGE" || migrate["isRolling"] != true {
+		t.Errorf("unexpected migrate nodes input: %v", migrate)
+	}
+}
+
+func TestReplaceCloudClusterInstanceTypeRejected(t *testing.T) {
+	clusterID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+	c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		switch req.Operation {
+		case "SdkGolangAllClustersConnection":
+			return map[string]any{"edges": []any{map[string]any{"node": map[string]any{
+				"id":              clusterID.String(),
+				"ccprovisionInfo": map[string]any{"vendor": "AWS"},
+			}}}}, nil
+		case "SdkGolangCloudClusterInstanceProperties":
+			return map[string]any{"instanceProperties": map[string]any{"vcpuCount": 16}}, nil
+		case "SdkGolangMigrateCloudClusterNodes":
+			return map[string]any{"message": "instance type not supported", "success": false}, nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	err := replaceCloudClusterInstanceType(context.Background(), c.polarisClient, clusterID, "M6I_4XLARGE")
+	if err == nil || !strings.Contains(err.Error(), "instance type not supported") {
+		t.Fatalf("expected migrate nodes error, got %v", err)
+	}
+}
+
+func TestCloudClusterInstanceType(t *testing.T) {
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		if req.Operation == "SdkGolangCloudClusterInstanceProperties" {
+			return map[string]any{"instanceProperties": map[string]any{"instanceType": "M6I_4XLARGE", "vcpuCount": 16}}, nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	tests := []struct {
+		name         string
+		cluster      gqlcluster.Cluster
+		want         string
+		wantRequests int
+	}{
+		{name: "NoNodes", cluster: testCloudCluster("", ""), want: "M6I_4XLARGE"},
+		{name: "NoVCPUs", cluster: testCloudCluster("", "", 0, 0), want: "M6I_4XLARGE"},
+		{name: "Match", cluster: testCloudCluster("", "", 16, 16), want: "M6I_4XLARGE", wantRequests: 1},
+		{name: "Drift", cluster: testCloudCluster("", "", 8, 16), want: "", wantRequests: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			before := len(srv.Requests())
+			got, err := cloudClusterInstanceType(context.Background(), c.polarisClient.GQL, tc.cluster, "M6I_4XLARGE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("expected instance type %q, got %q", tc.want, got)
+			}
+			if n := len(srv.Requests()) - before; n != tc.wantRequests {
+				t.Errorf("expected %d requests, got %d", tc.wantRequests, n)
+			}
+		})
+	}
+}
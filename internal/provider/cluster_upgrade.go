@@ -0,0 +1,271 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+)
+
+// clusterUpgradeProgressInterval is the time between progress reports of a
+// running cluster upgrade.
+const clusterUpgradeProgressInterval = 2 * time.Minute
+
+// upgradeCluster upgrades the cluster to the specified CDM version using an
+// upgrade orchestrated by RSC. The upgrade package is downloaded to the
+// cluster, unless already staged, and the pre-upgrade checks are verified
+// before the upgrade starts. Blocks until the upgrade finishes.
+func upgradeCluster(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, upgradeType gqlcluster.UpgradeType, version string) error {
+	if err := stageClusterUpgrade(ctx, client, clusterID, version); err != nil {
+		return err
+	}
+	return runClusterUpgrade(ctx, client, clusterID, upgradeType, version)
+}
+
+// stageClusterUpgrade downloads the upgrade package of the specified CDM
+// version to the cluster, unless already staged, and verifies that the
+// pre-upgrade checks of the cluster passed.
+func stageClusterUpgrade(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, version string) error {
+	release, err := clusterRelease(ctx, client.GQL, clusterID, version)
+	if err != nil {
+		return err
+	}
+
+	clusterAPI := cluster.Wrap(client)
+	details, err := clusterAPI.ClusterUpgrade(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	info := details.CDMInfo
+	if !info.IsStaged(version) {
+		tflog.Info(ctx, "downloading cluster upgrade package", map[string]any{
+			"cluster_id": clusterID.String(),
+			"version":    version,
+		})
+		staged, err := clusterAPI.DownloadPackageAndWait(ctx, clusterID, release.URL, release.MD5Sum, version)
+		if err != nil {
+			return err
+		}
+		info = &staged
+	}
+	if msg, failed := clusterPrecheckFailure(info); failed {
+		return fmt.Errorf("pre-upgrade checks of cluster %q failed: %s", clusterID, msg)
+	}
+
+	return nil
+}
+
+// runClusterUpgrade starts the upgrade of the cluster to the specified CDM
+// version, which must already be staged, and blocks until the upgrade
+// finishes. If the upgrade fails and the cluster rolls back to the previous
+// CDM version, runClusterUpgrade blocks until the rollback finishes.
+func runClusterUpgrade(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, upgradeType gqlcluster.UpgradeType, version string) error {
+	clusterAPI := cluster.Wrap(client)
+	if err := startClusterUpgrade(ctx, client, clusterID, upgradeType, version); err != nil {
+		return err
+	}
+
+	return waitForClusterUpgrade(ctx, clusterAPI, clusterID, version)
+}
+
+// startClusterUpgrade starts the upgrade of the cluster to the specified CDM
+// version without waiting for the upgrade to finish.
+func startClusterUpgrade(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, upgradeType gqlcluster.UpgradeType, version string) error {
+	tflog.Info(ctx, "upgrading cluster", map[string]any{
+		"cluster_id":   clusterID.String(),
+		"upgrade_type": upgradeType,
+		"version":      version,
+	})
+	if _, err := cluster.Wrap(client).Upgrade(ctx, clusterID, upgradeType, version); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// waitForClusterUpgrade blocks until the upgrade of the cluster to the
+// specified CDM version finishes. The progress of the upgrade is reported
+// periodically. If the upgrade fails and the cluster rolls back to the
+// previous CDM version, waitForClusterUpgrade blocks until the rollback
+// finishes.
+func waitForClusterUpgrade(ctx context.Context, clusterAPI cluster.API, clusterID uuid.UUID, version string) error {
+	progressCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go reportClusterUpgradeProgress(progressCtx, clusterAPI, clusterID, version)
+
+	info, err := clusterAPI.WaitForUpgrade(ctx, clusterID, version)
+	if err == nil {
+		return nil
+	}
+	if msg := clusterUpgradeErrorMessage(info); msg != "" {
+		err = fmt.Errorf("%s: %s", err, msg)
+	}
+	if info.UpgradeStatusV2 == nil || info.UpgradeStatusV2.RSCClusterUpgradeStatus != gqlcluster.RSCUpgradeStatusRollingBack {
+		return err
+	}
+
+	tflog.Warn(ctx, "cluster upgrade failed, waiting for rollback", map[string]any{
+		"cluster_id":       clusterID.String(),
+		"version":          version,
+		"previous_version": info.PreviousVersion,
+	})
+	if _, rollbackErr := clusterAPI.WaitForRollback(ctx, clusterID, info.PreviousVersion); rollbackErr != nil {
+		return fmt.Errorf("%s: %s", err, rollbackErr)
+	}
+	return fmt.Errorf("%s, the cluster was rolled back to the previous CDM version", err)
+}
+
+// reportClusterUpgradeProgress logs the progress of the cluster upgrade until
+// the context is canceled.
+func reportClusterUpgradeProgress(ctx context.Context, clusterAPI cluster.API, clusterID uuid.UUID, version string) {
+	ticker := time.NewTicker(clusterUpgradeProgressInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		details, err := clusterAPI.ClusterUpgrade(ctx, clusterID)
+		if err != nil || details.CDMInfo == nil {
+			continue
+		}
+		fields := map[string]any{
+			"cluster_id":       clusterID.String(),
+			"version":          version,
+			"status":           clusterUpgradeStatus(details.CDMInfo),
+			"overall_progress": details.CDMInfo.OverallProgress,
+		}
+		if status := details.CDMInfo.ClusterStatus; status != nil && status.StatusInfo != nil {
+			fields["current_node"] = status.StatusInfo.CurrentNode
+			fields["completed_nodes"] = status.StatusInfo.CompletedNodes
+		}
+		tflog.Info(ctx, "cluster upgrade in progress", fields)
+	}
+}
+
+// checkClusterUpgrade verifies that the cluster can be upgraded from the
+// current CDM version to the target CDM version in a single upgrade.
+func checkClusterUpgrade(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID, currentVersion, targetVersion string) error {
+	if version, err := cluster.ParseCDMVersion(currentVersion); err == nil && version.GreaterThan(targetVersion) {
+		return fmt.Errorf("downgrading the cluster from CDM version %q to %q is not supported", currentVersion,
+			targetVersion)
+	}
+
+	release, err := clusterRelease(ctx, gql, clusterID, targetVersion)
+	if err != nil {
+		return err
+	}
+	if !release.Upgradable {
+		return fmt.Errorf("the cluster cannot be upgraded from CDM version %q to %q", currentVersion, targetVersion)
+	}
+
+	path, err := gqlcluster.MultiHopUpgradePath(ctx, gql, clusterID, "", targetVersion, true)
+	if err != nil {
+		return err
+	}
+	if len(path) > 2 {
+		return fmt.Errorf("upgrading the cluster from CDM version %q to %q requires intermediate upgrades, "+
+			"upgrade to each version in turn: %s", currentVersion, targetVersion, strings.Join(path[1:], ", "))
+	}
+
+	return nil
+}
+
+// clusterRelease returns the CDM release with the specified version available
+// for the cluster.
+func clusterRelease(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID, version string) (gqlcluster.ReleaseDetail, error) {
+	releases, err := gqlcluster.ListUpgrades(ctx, gql, []uuid.UUID{clusterID}, gqlcluster.ListUpgradesOptions{
+		FilterVersion: version,
+		FetchLinks:    true,
+		ShouldShowAll: true,
+	})
+	if err != nil {
+		return gqlcluster.ReleaseDetail{}, err
+	}
+	for _, release := range releases {
+		if release.Name == version {
+			return release, nil
+		}
+	}
+
+	return gqlcluster.ReleaseDetail{}, fmt.Errorf("CDM version %q %w for cluster %q", version, graphql.ErrNotFound,
+		clusterID)
+}
+
+// clusterUpgradeStatus returns the upgrade status of the cluster. The V2
+// status is preferred, falling back to the V1 cluster job status when the V2
+// status is not available.
+func clusterUpgradeStatus(info *gqlcluster.CDMInfo) string {
+	if info == nil {
+		return ""
+	}
+	if info.UpgradeStatusV2 != nil {
+		return string(info.UpgradeStatusV2.RSCClusterUpgradeStatus)
+	}
+	return string(info.ClusterJobStatus)
+}
+
+// clusterUpgradeErrorMessage returns the error message of the last upgrade
+// operation of the cluster. Returns an empty string if there is no error
+// message.
+func clusterUpgradeErrorMessage(info gqlcluster.CDMInfo) string {
+	if info.UpgradeStatusV2 != nil && info.UpgradeStatusV2.UIStatusAttributes.ErrorMsg != "" {
+		return info.UpgradeStatusV2.UIStatusAttributes.ErrorMsg
+	}
+	if info.ClusterStatus != nil {
+		return info.ClusterStatus.Message
+	}
+	return ""
+}
+
+// clusterPrecheckFailure returns true if the pre-upgrade checks of the cluster
+// failed with an error, together with the failure message. Warnings from the
+// pre-upgrade checks don't block the upgrade.
+func clusterPrecheckFailure(info *gqlcluster.CDMInfo) (string, bool) {
+	if info == nil {
+		return "", false
+	}
+
+	var failed bool
+	if info.UpgradeStatusV2 != nil {
+		failed = info.UpgradeStatusV2.RSCClusterUpgradeStatus == gqlcluster.RSCUpgradeStatusPrecheckFailed
+	} else {
+		failed = info.ClusterJobStatus == gqlcluster.ClusterJobStatusPreCheckFailureError
+	}
+	if !failed {
+		return "", false
+	}
+	if msg := clusterUpgradeErrorMessage(*info); msg != "" {
+		return msg, true
+	}
+	return "unknown reason", true
+}
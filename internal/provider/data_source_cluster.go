@@ -0,0 +1,435 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+)
+
+const dataSourceClusterDescription = `
+The ´polaris_cluster´ data source is used to access information about a Rubrik
+cluster registered with RSC. A cluster is looked up using either the cluster ID
+or the cluster name.
+
+The data source returns the connection state and health of the cluster, the
+nodes of the cluster, the storage capacity, the CDM version and upgrade
+availability, the number of global SLA domains and the location of the cluster.
+The storage capacity is reported in bytes.
+`
+
+// clusterInfoQuery is the GraphQL query used to look up the storage capacity,
+// location and node status of Rubrik clusters.
+const clusterInfoQuery = `query SdkGolangClusterInfo($first: Int, $filter: ClusterFilterInput) {
+    result: clusterConnection(first: $first, filter: $filter) {
+        nodes {
+            id
+            geoLocation {
+                address
+            }
+            metric {
+                totalCapacity
+                usedCapacity
+                availableCapacity
+            }
+            clusterNodeConnection {
+                nodes {
+                    id
+                    hostname
+                    ipAddress
+                    status
+                }
+            }
+        }
+    }
+}`
+
+// clusterNode holds the information of a Rubrik cluster node.
+type clusterNode struct {
+	ID        string `json:"id"`
+	Hostname  string `json:"hostname"`
+	IPAddress string `json:"ipAddress"`
+	Status    string `json:"status"`
+}
+
+// clusterInfo holds the storage capacity, location and node status of a Rubrik
+// cluster.
+type clusterInfo struct {
+	ID          uuid.UUID `json:"id"`
+	GeoLocation *struct {
+		Address string `json:"address"`
+	} `json:"geoLocation"`
+	Metric *struct {
+		TotalCapacity     int64 `json:"totalCapacity"`
+		UsedCapacity      int64 `json:"usedCapacity"`
+		AvailableCapacity int64 `json:"availableCapacity"`
+	} `json:"metric"`
+	ClusterNodes struct {
+		Nodes []clusterNode `json:"nodes"`
+	} `json:"clusterNodeConnection"`
+}
+
+// clusterDetails holds the information returned by the cluster data sources
+// for a Rubrik cluster.
+type clusterDetails struct {
+	gqlcluster.Cluster
+	Info                 clusterInfo
+	Upgrade              *gqlcluster.CDMInfo
+	GlobalSLADomainCount int
+}
+
+// clusterDetailsSchema returns the schema of the information returned by the
+// cluster data sources, excluding the cluster ID and name.
+func clusterDetailsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		keyAvailableCapacity: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Available storage capacity of the cluster in bytes.",
+		},
+		keyConnectionState: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Connection state of the cluster. Possible values are `Connected`, `Disconnected` and " +
+				"`Initializing`.",
+		},
+		keyGlobalSLADomainCount: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of global SLA domains protecting objects on the cluster.",
+		},
+		keyLocation: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Location of the cluster.",
+		},
+		keyNodeCount: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of nodes in the cluster.",
+		},
+		keyNodes: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Nodes of the cluster.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					keyHostname: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Hostname of the node.",
+					},
+					keyID: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Node ID.",
+					},
+					keyIPAddress: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "IP address of the node.",
+					},
+					keyStatus: {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Status of the node, e.g. `OK`.",
+					},
+				},
+			},
+		},
+		keyProductType: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Product type of the cluster. Possible values are `CDM`, `CLOUD_DIRECT`, `DATOS`, " +
+				"`POLARIS` and `RSCP_APPLIANCE`.",
+		},
+		keyRecommendedVersion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CDM version RSC recommends upgrading the cluster to. Empty if no upgrade is recommended.",
+		},
+		keySystemStatus: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Health of the cluster. Possible values are `OK`, `WARNING` and `FATAL`.",
+		},
+		keyTimezone: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timezone of the cluster.",
+		},
+		keyTotalCapacity: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total storage capacity of the cluster in bytes.",
+		},
+		keyType: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Type of the cluster. Possible values are `Cloud`, `ExoCompute`, `OnPrem`, `Polaris`, " +
+				"`Robo` and `Unknown`.",
+		},
+		keyUpgradeAvailable: {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if there are CDM versions available which the cluster can be upgraded to.",
+		},
+		keyUsedCapacity: {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Used storage capacity of the cluster in bytes.",
+		},
+		keyVersion: {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CDM version of the cluster.",
+		},
+		keyVersionStatus: {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "Status of the CDM version of the cluster. Possible values are `STABLE`, " +
+				"`UPGRADE_RECOMMENDED` and `UNKNOWN`.",
+		},
+	}
+}
+
+func dataSourceCluster() *schema.Resource {
+	clusterSchema := clusterDetailsSchema()
+	clusterSchema[keyID] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{keyName},
+		Description:  "Cluster ID (UUID).",
+		ValidateFunc: validation.IsUUID,
+	}
+	clusterSchema[keyName] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{keyID},
+		Description:  "Cluster name.",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+
+	return &schema.Resource{
+		ReadContext: clusterRead,
+
+		Description: description(dataSourceClusterDescription),
+		Schema:      clusterSchema,
+	}
+}
+
+func clusterRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "clusterRead")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var filter gqlcluster.SearchFilter
+	name := d.Get(keyName).(string)
+	if id := d.Get(keyID).(string); id != "" {
+		filter.ID = []string{id}
+	} else {
+		filter.Name = []string{name}
+	}
+	clusters, err := clusterDetailsByFilter(ctx, client, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The name filter matches on substrings, so the exact name is matched
+	// here.
+	var details *clusterDetails
+	for i := range clusters {
+		if len(filter.ID) == 0 && clusters[i].Name != name {
+			continue
+		}
+		if details != nil {
+			return diag.Errorf("multiple clusters named %q", name)
+		}
+		details = &clusters[i]
+	}
+	if details == nil {
+		if len(filter.ID) > 0 {
+			return diag.Errorf("cluster %q not found", filter.ID[0])
+		}
+		return diag.Errorf("cluster %q not found", name)
+	}
+
+	if err := d.Set(keyName, details.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	for key, value := range fromClusterDetails(*details) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(details.ID.String())
+	return nil
+}
+
+// clusterDetailsByFilter returns the details of the Rubrik clusters matching
+// the filter, sorted by cluster name.
+func clusterDetailsByFilter(ctx context.Context, client *polaris.Client, filter gqlcluster.SearchFilter) ([]clusterDetails, error) {
+	clusters, err := cluster.Wrap(client).ListClusters(ctx, filter, gqlcluster.SortByClusterName, core.SortOrderAsc)
+	if err != nil {
+		return nil, err
+	}
+	if len(clusters) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(clusters))
+	upgradeIDs := make([]uuid.UUID, 0, len(clusters))
+	for _, cluster := range clusters {
+		ids = append(ids, cluster.ID.String())
+		upgradeIDs = append(upgradeIDs, cluster.ID)
+	}
+
+	var infos struct {
+		Nodes []clusterInfo `json:"nodes"`
+	}
+	if err := gqlRequest(ctx, client.GQL, clusterInfoQuery, struct {
+		First  int `json:"first"`
+		Filter struct {
+			ID []string `json:"id"`
+		} `json:"filter"`
+	}{First: len(ids), Filter: struct {
+		ID []string `json:"id"`
+	}{ID: ids}}, &infos); err != nil {
+		return nil, fmt.Errorf("failed to get cluster info: %s", err)
+	}
+	infoByID := make(map[uuid.UUID]clusterInfo, len(infos.Nodes))
+	for _, info := range infos.Nodes {
+		infoByID[info.ID] = info
+	}
+
+	upgrades, err := cluster.Wrap(client).ListClusterUpgrades(ctx, &gqlcluster.CDMInfoFilter{ID: upgradeIDs}, "", "")
+	if err != nil {
+		return nil, err
+	}
+	upgradeByID := make(map[uuid.UUID]*gqlcluster.CDMInfo, len(upgrades))
+	for _, upgrade := range upgrades {
+		upgradeByID[upgrade.ID] = upgrade.CDMInfo
+	}
+
+	details := make([]clusterDetails, 0, len(clusters))
+	for _, c := range clusters {
+		var globalSLADomainCount int
+		if c.ProductType == gqlcluster.CDM {
+			slaDomains, err := cluster.Wrap(client).GlobalSLAs(ctx, c.ID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get global SLA domains for cluster %q: %s", c.ID, err)
+			}
+			globalSLADomainCount = len(slaDomains)
+		}
+		details = append(details, clusterDetails{
+			Cluster:              c,
+			Info:                 infoByID[c.ID],
+			Upgrade:              upgradeByID[c.ID],
+			GlobalSLADomainCount: globalSLADomainCount,
+		})
+	}
+
+	return details, nil
+}
+
+// fromClusterDetails returns the values of the fields in clusterDetailsSchema
+// for the cluster details.
+func fromClusterDetails(details clusterDetails) map[string]any {
+	var nodes []any
+	for _, node := range details.Info.ClusterNodes.Nodes {
+		nodes = append(nodes, map[string]any{
+			keyHostname:  node.Hostname,
+			keyID:        node.ID,
+			keyIPAddress: node.IPAddress,
+			keyStatus:    node.Status,
+		})
+	}
+
+	// Fall back on the nodes of the cluster listing when the node status isn't
+	// available.
+	if len(nodes) == 0 {
+		for _, edge := range details.ClusterNodes.Edges {
+			nodes = append(nodes, map[string]any{
+				keyHostname:  edge.Node.Hostname,
+				keyID:        edge.Node.ID,
+				keyIPAddress: edge.Node.IPAddress,
+				keyStatus:    "",
+			})
+		}
+	}
+
+	var location string
+	if details.Info.GeoLocation != nil {
+		location = details.Info.GeoLocation.Address
+	}
+
+	var totalCapacity, usedCapacity, availableCapacity int64
+	if metric := details.Info.Metric; metric != nil {
+		totalCapacity = metric.TotalCapacity
+		usedCapacity = metric.UsedCapacity
+		availableCapacity = metric.AvailableCapacity
+	}
+
+	var recommendedVersion, versionStatus string
+	var upgradeAvailable bool
+	if upgrade := details.Upgrade; upgrade != nil {
+		versionStatus = string(upgrade.VersionStatus)
+		if info := upgrade.UpgradeRecommendationInfo; info != nil {
+			recommendedVersion = info.Recommendation
+			upgradeAvailable = len(info.Upgradability) > 0
+		}
+	}
+
+	return map[string]any{
+		keyAvailableCapacity:    availableCapacity,
+		keyConnectionState:      string(details.Status),
+		keyGlobalSLADomainCount: details.GlobalSLADomainCount,
+		keyLocation:             location,
+		keyNodeCount:            len(nodes),
+		keyNodes:                nodes,
+		keyProductType:          string(details.ProductType),
+		keyRecommendedVersion:   recommendedVersion,
+		keySystemStatus:         string(details.SystemStatus),
+		keyTimezone:             string(details.Timezone),
+		keyTotalCapacity:        totalCapacity,
+		keyType:                 string(details.Type),
+		keyUpgradeAvailable:     upgradeAvailable,
+		keyUsedCapacity:         usedCapacity,
+		keyVersion:              details.Version,
+		keyVersionStatus:        versionStatus,
+	}
+}
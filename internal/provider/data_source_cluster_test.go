@@ -0,0 +1,101 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+)
+
+func TestFromClusterDetails(t *testing.T) {
+	var info clusterInfo
+	if err := json.Unmarshal([]byte(`{
+		"id": "0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2",
+		"geoLocation": {"address": "Palo Alto, CA"},
+		"metric": {"totalCapacity": 1000, "usedCapacity": 400, "availableCapacity": 600},
+		"clusterNodeConnection": {"nodes": [
+			{"id": "RVM1", "hostname": "node-1", "ipAddress": "10.0.0.1", "status": "OK"},
+			{"id": "RVM2", "hostname": "node-2", "ipAddress": "10.0.0.2", "status": "BAD"}
+		]}
+	}`), &info); err != nil {
+		t.Fatal(err)
+	}
+
+	details := clusterDetails{
+		Cluster: gqlcluster.Cluster{
+			Status:       gqlcluster.Connected,
+			SystemStatus: gqlcluster.SystemStatusWARNING,
+			Version:      "9.2.1-p1-12345",
+		},
+		Info: info,
+		Upgrade: &gqlcluster.CDMInfo{
+			VersionStatus: gqlcluster.VersionStatusUpgradeRecommended,
+			UpgradeRecommendationInfo: &gqlcluster.UpgradeRecommendationInfo{
+				Recommendation: "9.3.0-p1-23456",
+				Upgradability:  []string{"9.3.0-p1-23456"},
+			},
+		},
+		GlobalSLADomainCount: 3,
+	}
+	attrs := fromClusterDetails(details)
+	expected := map[string]any{
+		keyAvailableCapacity:    int64(600),
+		keyConnectionState:      "Connected",
+		keyGlobalSLADomainCount: 3,
+		keyLocation:             "Palo Alto, CA",
+		keyNodeCount:            2,
+		keyRecommendedVersion:   "9.3.0-p1-23456",
+		keySystemStatus:         "WARNING",
+		keyTotalCapacity:        int64(1000),
+		keyUpgradeAvailable:     true,
+		keyUsedCapacity:         int64(400),
+		keyVersion:              "9.2.1-p1-12345",
+		keyVersionStatus:        "UPGRADE_RECOMMENDED",
+	}
+	for key, value := range expected {
+		if attrs[key] != value {
+			t.Errorf("expected %s to be %v, got %v", key, value, attrs[key])
+		}
+	}
+	if status := attrs[keyNodes].([]any)[1].(map[string]any)[keyStatus]; status != "BAD" {
+		t.Errorf("expected node status BAD, got %v", status)
+	}
+}
+
+func TestFromClusterDetailsWithoutInfo(t *testing.T) {
+	var details clusterDetails
+	details.ClusterNodes.Edges = make([]struct {
+		Node gqlcluster.Node `json:"node"`
+	}, 3)
+
+	attrs := fromClusterDetails(details)
+	if count := attrs[keyNodeCount]; count != 3 {
+		t.Errorf("expected 3 nodes, got %v", count)
+	}
+	if available := attrs[keyUpgradeAvailable]; available != false {
+		t.Errorf("expected no upgrade to be available, got %v", available)
+	}
+	if capacity := attrs[keyTotalCapacity]; capacity != int64(0) {
+		t.Errorf("expected no capacity, got %v", capacity)
+	}
+}
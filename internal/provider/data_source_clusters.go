@@ -0,0 +1,155 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+)
+
+const dataSourceClustersDescription = `
+The ´polaris_clusters´ data source is used to list the Rubrik clusters
+registered with RSC. The clusters can be filtered on name, type and connection
+state. The clusters are sorted by name.
+
+Each cluster in the list has the same information as the ´polaris_cluster´
+data source, which makes it possible to choose clusters programmatically, e.g.
+the connected cluster with the most available storage capacity.
+`
+
+func dataSourceClusters() *schema.Resource {
+	clusterSchema := clusterDetailsSchema()
+	clusterSchema[keyID] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Cluster ID (UUID).",
+	}
+	clusterSchema[keyName] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Cluster name.",
+	}
+
+	return &schema.Resource{
+		ReadContext: clustersRead,
+
+		Description: description(dataSourceClustersDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the cluster IDs.",
+			},
+			keyClusters: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Clusters matching the filters.",
+				Elem: &schema.Resource{
+					Schema: clusterSchema,
+				},
+			},
+			keyConnectionStates: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(gqlcluster.Connected),
+						string(gqlcluster.Disconnected),
+						string(gqlcluster.Initializing),
+					}, false),
+				},
+				Optional: true,
+				Description: "Only list clusters with one of the connection states. Possible values are " +
+					"`Connected`, `Disconnected` and `Initializing`.",
+			},
+			keyName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Only list clusters with a name containing the value.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyTypes: {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(gqlcluster.CLOUD),
+						string(gqlcluster.EXOCOMPUTE),
+						string(gqlcluster.ONPREM),
+						string(gqlcluster.RSC),
+						string(gqlcluster.ROBO),
+						string(gqlcluster.UNKNOWN),
+					}, false),
+				},
+				Optional: true,
+				Description: "Only list clusters of one of the types. Possible values are `Cloud`, `ExoCompute`, " +
+					"`OnPrem`, `Polaris`, `Robo` and `Unknown`.",
+			},
+		},
+	}
+}
+
+func clustersRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "clustersRead")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var filter gqlcluster.SearchFilter
+	if name := d.Get(keyName).(string); name != "" {
+		filter.Name = []string{name}
+	}
+	for _, state := range d.Get(keyConnectionStates).(*schema.Set).List() {
+		filter.ConnectionState = append(filter.ConnectionState, gqlcluster.Status(state.(string)))
+	}
+	for _, clusterType := range d.Get(keyTypes).(*schema.Set).List() {
+		filter.Type = append(filter.Type, gqlcluster.ProductType(clusterType.(string)))
+	}
+	clusters, err := clusterDetailsByFilter(ctx, client, filter)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clustersAttr := make([]any, 0, len(clusters))
+	hash := sha256.New()
+	for _, cluster := range clusters {
+		clusterAttr := fromClusterDetails(cluster)
+		clusterAttr[keyID] = cluster.ID.String()
+		clusterAttr[keyName] = cluster.Name
+		clustersAttr = append(clustersAttr, clusterAttr)
+		hash.Write([]byte(cluster.ID.String()))
+	}
+	if err := d.Set(keyClusters, clustersAttr); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%x", hash.Sum(nil)))
+	return nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const dataSourceReplicationTargetClusterDescription = `
+The ´polaris_replication_target_cluster´ data source is used to access
+information about a replication target cluster in RSC. A replication target
+cluster is a Rubrik cluster which is the target of at least one replication
+pair. A replication target cluster is looked up using the cluster name,
+optionally restricted to the replication pairs of a specific source cluster.
+`
+
+func dataSourceReplicationTargetCluster() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: replicationTargetClusterRead,
+
+		Description: description(dataSourceReplicationTargetClusterDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Cluster name.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keySourceClusterID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Source cluster ID (UUID). When specified, only replication pairs with the source " +
+					"cluster are considered.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keySourceClusterIDs: {
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Source cluster IDs (UUIDs) of the replication pairs with the target cluster.",
+			},
+			keyVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster version.",
+			},
+		},
+	}
+}
+
+func replicationTargetClusterRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "replicationTargetClusterRead")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var sourceClusterID *uuid.UUID
+	if id := d.Get(keySourceClusterID).(string); id != "" {
+		id, err := uuid.Parse(id)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		sourceClusterID = &id
+	}
+	pairs, err := replicationPairs(ctx, client.GQL, sourceClusterID, nil)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get(keyName).(string)
+	var target *replicationCluster
+	var sourceClusterIDs []any
+	for _, pair := range pairs {
+		if pair.TargetCluster.Name != name {
+			continue
+		}
+		if target != nil && target.ID != pair.TargetCluster.ID {
+			return diag.Errorf("multiple replication target clusters named %q", name)
+		}
+		target = &pair.TargetCluster
+		sourceClusterIDs = append(sourceClusterIDs, pair.SourceCluster.ID.String())
+	}
+	if target == nil {
+		return diag.Errorf("replication target cluster %q not found", name)
+	}
+
+	if err := d.Set(keyName, target.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySourceClusterIDs, schema.NewSet(schema.HashString, sourceClusterIDs)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyVersion, target.Version); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(target.ID.String())
+	return nil
+}
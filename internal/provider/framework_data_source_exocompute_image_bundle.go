@@ -0,0 +1,286 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+const dataSourceExocomputeImageBundleDescription = `
+The ´polaris_exocompute_image_bundle´ data source is used to access information
+about the image bundle used by RSC for Exocompute. The image bundle lists the
+container images which must be mirrored into a private container registry (PCR)
+before the ´polaris_aws_private_container_registry´,
+´polaris_azure_private_container_registry´ or
+´polaris_gcp_private_container_registry´ resource is used to enable the PCR
+feature.
+
+For AWS, the image bundle depends on the version of the customer's EKS cluster,
+specified using the ´eks_version´ field. For Azure and GCP, ´eks_version´ should
+not be specified.
+
+When ´registry_url´ is specified, each image in the bundle also holds the image
+reference in the private container registry, which can be used as the target
+when mirroring the images.
+`
+
+// exotaskImageBundleQuery is the GraphQL query used to look up the Exocompute
+// image bundle.
+const exotaskImageBundleQuery = `query SdkGolangExotaskImageBundle($eksVersion: String) {
+    result: exotaskImageBundle(input: {
+        eksVersion: $eksVersion
+    }) {
+        bundleImages {
+            name
+            sha
+            tag
+        }
+        bundleVersion
+        eksVersion
+        repoUrl
+    }
+}`
+
+// exotaskImageBundle holds the result of the exotaskImageBundle query.
+type exotaskImageBundle struct {
+	BundleImages []struct {
+		Name string `json:"name"`
+		SHA  string `json:"sha"`
+		Tag  string `json:"tag"`
+	} `json:"bundleImages"`
+	BundleVersion string `json:"bundleVersion"`
+	EKSVersion    string `json:"eksVersion"`
+	RepoURL       string `json:"repoUrl"`
+}
+
+// exocomputeImageBundle returns the Exocompute image bundle for the specified
+// EKS version. If the EKS version is empty, RSC defaults to the latest EKS
+// version supported.
+func exocomputeImageBundle(ctx context.Context, gql *graphql.Client, eksVersion string) (exotaskImageBundle, error) {
+	var version *string
+	if eksVersion != "" {
+		version = &eksVersion
+	}
+
+	var bundle exotaskImageBundle
+	err := gqlRequest(ctx, gql, exotaskImageBundleQuery, struct {
+		EKSVersion *string `json:"eksVersion,omitempty"`
+	}{EKSVersion: version}, &bundle)
+	if err != nil {
+		return exotaskImageBundle{}, err
+	}
+
+	return bundle, nil
+}
+
+// imageReference returns the image reference for the image with the specified
+// name and tag in the container registry.
+func imageReference(registryURL, name, tag string) string {
+	return fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(registryURL, "/"), name, tag)
+}
+
+var _ datasource.DataSource = &exocomputeImageBundleDataSource{}
+
+type exocomputeImageBundleDataSource struct {
+	client *client
+}
+
+type exocomputeImageBundleModel struct {
+	ID            types.String `tfsdk:"id"`
+	EKSVersion    types.String `tfsdk:"eks_version"`
+	RegistryURL   types.String `tfsdk:"registry_url"`
+	BundleVersion types.String `tfsdk:"bundle_version"`
+	RepoURL       types.String `tfsdk:"repo_url"`
+	Images        types.List   `tfsdk:"images"`
+}
+
+func exocomputeImageAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		keyName:      types.StringType,
+		keySHA:       types.StringType,
+		keyTag:       types.StringType,
+		keySourceURI: types.StringType,
+		keyTargetURI: types.StringType,
+	}
+}
+
+func newExocomputeImageBundleDataSource() datasource.DataSource {
+	return &exocomputeImageBundleDataSource{}
+}
+
+func (d *exocomputeImageBundleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	tflog.Trace(ctx, "exocomputeImageBundleDataSource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keyExocomputeImageBundle
+}
+
+func (d *exocomputeImageBundleDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	tflog.Trace(ctx, "exocomputeImageBundleDataSource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(dataSourceExocomputeImageBundleDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the bundle version, EKS version and repository URL.",
+			},
+			keyEKSVersion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Version of the customer's EKS cluster, e.g. `1.29`. Defaults to the latest EKS " +
+					"version supported by RSC. Should not be specified for Azure and GCP.",
+				Validators: []validator.String{
+					isNotWhiteSpace(),
+				},
+			},
+			keyRegistryURL: schema.StringAttribute{
+				Optional: true,
+				Description: "URL of the customer provided private container registry. When specified, the " +
+					"`target_uri` field of each image holds the image reference in the private container registry.",
+				Validators: []validator.String{
+					isNotWhiteSpace(),
+				},
+			},
+			keyBundleVersion: schema.StringAttribute{
+				Computed:    true,
+				Description: "Image bundle version.",
+			},
+			keyRepoURL: schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the RSC container registry from where the images can be pulled.",
+			},
+			keyImages: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Images in the image bundle.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyName: schema.StringAttribute{
+							Computed:    true,
+							Description: "Image name.",
+						},
+						keySHA: schema.StringAttribute{
+							Computed:    true,
+							Description: "Image SHA digest.",
+						},
+						keyTag: schema.StringAttribute{
+							Computed:    true,
+							Description: "Image tag.",
+						},
+						keySourceURI: schema.StringAttribute{
+							Computed:    true,
+							Description: "Image reference in the RSC container registry.",
+						},
+						keyTargetURI: schema.StringAttribute{
+							Computed: true,
+							Description: "Image reference in the customer provided private container registry. " +
+								"Only set when `registry_url` is specified.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *exocomputeImageBundleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "exocomputeImageBundleDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client)
+}
+
+func (d *exocomputeImageBundleDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	tflog.Trace(ctx, "exocomputeImageBundleDataSource.Read")
+
+	var config exocomputeImageBundleModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := d.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	bundle, err := exocomputeImageBundle(ctx, polarisClient.GQL, config.EKSVersion.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read Exocompute image bundle", err.Error())
+		return
+	}
+
+	registryURL := config.RegistryURL.ValueString()
+	imageValues := make([]attr.Value, 0, len(bundle.BundleImages))
+	for _, image := range bundle.BundleImages {
+		targetURI := types.StringNull()
+		if registryURL != "" {
+			targetURI = types.StringValue(imageReference(registryURL, image.Name, image.Tag))
+		}
+		imageValue, diags := types.ObjectValue(exocomputeImageAttrTypes(), map[string]attr.Value{
+			keyName:      types.StringValue(image.Name),
+			keySHA:       types.StringValue(image.SHA),
+			keyTag:       types.StringValue(image.Tag),
+			keySourceURI: types.StringValue(imageReference(bundle.RepoURL, image.Name, image.Tag)),
+			keyTargetURI: targetURI,
+		})
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		imageValues = append(imageValues, imageValue)
+	}
+
+	images, diags := types.ListValue(types.ObjectType{AttrTypes: exocomputeImageAttrTypes()}, imageValues)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(bundle.BundleVersion))
+	hash.Write([]byte(bundle.EKSVersion))
+	hash.Write([]byte(bundle.RepoURL))
+
+	state := exocomputeImageBundleModel{
+		ID:            types.StringValue(fmt.Sprintf("%x", hash.Sum(nil))),
+		EKSVersion:    types.StringValue(bundle.EKSVersion),
+		RegistryURL:   config.RegistryURL,
+		BundleVersion: types.StringValue(bundle.BundleVersion),
+		RepoURL:       types.StringValue(bundle.RepoURL),
+		Images:        images,
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccExocomputeImageBundleDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			// Look up the default image bundle, with and without a private
+			// container registry URL.
+			Config: `
+				data "polaris_exocompute_image_bundle" "default" {}
+
+				data "polaris_exocompute_image_bundle" "pcr" {
+					registry_url = "123456789012.dkr.ecr.us-east-2.amazonaws.com"
+				}
+			`,
+			ConfigStateChecks: []statecheck.StateCheck{
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.default", tfjsonpath.New(keyID),
+					knownvalue.StringRegexp(sha256Hex)),
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.default", tfjsonpath.New(keyBundleVersion),
+					knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.default", tfjsonpath.New(keyEKSVersion),
+					knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.default", tfjsonpath.New(keyRepoURL),
+					knownvalue.NotNull()),
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.default",
+					tfjsonpath.New(keyImages).AtSliceIndex(0).AtMapKey(keyTargetURI), knownvalue.Null()),
+				statecheck.ExpectKnownValue("data.polaris_exocompute_image_bundle.pcr",
+					tfjsonpath.New(keyImages).AtSliceIndex(0).AtMapKey(keyTargetURI),
+					knownvalue.StringRegexp(regexp.MustCompile(`^123456789012\.dkr\.ecr\.us-east-2\.amazonaws\.com/.+:.+$`))),
+			},
+		}},
+	})
+}
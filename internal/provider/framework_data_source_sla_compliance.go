@@ -0,0 +1,315 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const dataSourceSLAComplianceDescription = `
+The ´polaris_sla_compliance´ data source is used to look up the compliance state
+of objects protected by SLA domains. Specify ´sla_domain_id´ to look up the
+objects protected by an SLA domain, ´object_ids´ to look up specific objects or
+both to look up specific objects protected by an SLA domain.
+
+The compliance state of an object is one of:
+  * ´IN_COMPLIANCE´ - The object has the snapshots required by the SLA domain.
+  * ´OUT_OF_COMPLIANCE´ - The object is missing snapshots required by the SLA
+    domain.
+  * ´NOT_YET_RUN´ - No snapshot has been taken of the object yet.
+
+The counts can be used in ´check´ blocks to fail a pipeline when protection is
+degraded.
+`
+
+var (
+	_ datasource.DataSource                     = &slaComplianceDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &slaComplianceDataSource{}
+)
+
+type slaComplianceDataSource struct {
+	client *client
+}
+
+type slaComplianceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	InComplianceCount    types.Int64  `tfsdk:"in_compliance_count"`
+	NotYetRunCount       types.Int64  `tfsdk:"not_yet_run_count"`
+	ObjectIDs            types.Set    `tfsdk:"object_ids"`
+	Objects              types.List   `tfsdk:"objects"`
+	OutOfComplianceCount types.Int64  `tfsdk:"out_of_compliance_count"`
+	SLADomainID          types.String `tfsdk:"sla_domain_id"`
+}
+
+func newSLAComplianceDataSource() datasource.DataSource {
+	return &slaComplianceDataSource{}
+}
+
+func (d *slaComplianceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	tflog.Trace(ctx, "slaComplianceDataSource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySLACompliance
+}
+
+func (d *slaComplianceDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	tflog.Trace(ctx, "slaComplianceDataSource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(dataSourceSLAComplianceDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the SLA domain ID, object IDs and objects returned.",
+			},
+			keyInComplianceCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of objects in compliance.",
+			},
+			keyNotYetRunCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of objects which haven't had a snapshot taken yet.",
+			},
+			keyObjectIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Object IDs (UUIDs) to look up the compliance state for.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(isUUID()),
+				},
+			},
+			keyObjects: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Compliance state of the objects, ordered by object ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyArchivalSnapshotLag: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of snapshots which haven't been archived yet.",
+						},
+						keyComplianceStatus: schema.StringAttribute{
+							Computed: true,
+							Description: "Compliance state of the object. Possible values are `IN_COMPLIANCE`, " +
+								"`OUT_OF_COMPLIANCE` and `NOT_YET_RUN`.",
+						},
+						keyID: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object ID (UUID).",
+						},
+						keyLastSnapshot: schema.StringAttribute{
+							Computed:    true,
+							Description: "RFC3339 timestamp of the last successful snapshot. Null if no snapshot has been taken.",
+						},
+						keyMissedSnapshots: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of missed snapshots.",
+						},
+						keyName: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object name.",
+						},
+						keyObjectType: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object type.",
+						},
+						keySLADomainID: schema.StringAttribute{
+							Computed:    true,
+							Description: "SLA domain ID (UUID).",
+						},
+						keySLADomainName: schema.StringAttribute{
+							Computed:    true,
+							Description: "SLA domain name.",
+						},
+					},
+				},
+			},
+			keyOutOfComplianceCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of objects out of compliance.",
+			},
+			keySLADomainID: schema.StringAttribute{
+				Optional:    true,
+				Description: "SLA domain ID (UUID) to look up the compliance state of the protected objects for.",
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+		},
+	}
+}
+
+func (d *slaComplianceDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	tflog.Trace(ctx, "slaComplianceDataSource.ConfigValidators")
+
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot(keySLADomainID),
+			path.MatchRoot(keyObjectIDs),
+		),
+	}
+}
+
+func (d *slaComplianceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "slaComplianceDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client)
+}
+
+func (d *slaComplianceDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	tflog.Trace(ctx, "slaComplianceDataSource.Read")
+
+	var config slaComplianceModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := d.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	var slaID *uuid.UUID
+	if !config.SLADomainID.IsNull() {
+		id, err := uuid.Parse(config.SLADomainID.ValueString())
+		if err != nil {
+			res.Diagnostics.AddError("Invalid sla_domain_id", err.Error())
+			return
+		}
+		slaID = &id
+	}
+	var objectIDs []uuid.UUID
+	for _, value := range stringsFromSet(config.ObjectIDs) {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			res.Diagnostics.AddError("Invalid object_ids", err.Error())
+			return
+		}
+		objectIDs = append(objectIDs, id)
+	}
+
+	objects, err := slaComplianceObjects(ctx, polarisClient.GQL, slaID, objectIDs)
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read SLA compliance", err.Error())
+		return
+	}
+	slices.SortFunc(objects, func(a, b slaComplianceObject) int {
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+
+	hash := sha256.New()
+	hash.Write([]byte(config.SLADomainID.ValueString()))
+	for _, id := range objectIDs {
+		hash.Write([]byte(id.String()))
+	}
+
+	var inCompliance, outOfCompliance, notYetRun int64
+	objectValues := make([]attr.Value, 0, len(objects))
+	for _, object := range objects {
+		status := object.status()
+		switch status {
+		case slaComplianceInCompliance:
+			inCompliance++
+		case slaComplianceOutOfCompliance:
+			outOfCompliance++
+		case slaComplianceNotYetRun:
+			notYetRun++
+		}
+
+		lastSnapshot := types.StringNull()
+		if object.LastSnapshot != nil {
+			lastSnapshot = types.StringValue(object.LastSnapshot.Format(time.RFC3339))
+		}
+
+		hash.Write([]byte(object.ID.String()))
+		hash.Write([]byte(status))
+		hash.Write([]byte(lastSnapshot.ValueString()))
+
+		objectValue, diags := types.ObjectValue(slaComplianceObjectAttrTypes(), map[string]attr.Value{
+			keyArchivalSnapshotLag: types.Int64Value(int64(object.ArchivalSnapshotLag)),
+			keyComplianceStatus:    types.StringValue(status),
+			keyID:                  types.StringValue(object.ID.String()),
+			keyLastSnapshot:        lastSnapshot,
+			keyMissedSnapshots:     types.Int64Value(int64(object.MissedSnapshots)),
+			keyName:                types.StringValue(object.Name),
+			keyObjectType:          types.StringValue(object.ObjectType),
+			keySLADomainID:         types.StringValue(object.SLADomain.ID),
+			keySLADomainName:       types.StringValue(object.SLADomain.Name),
+		})
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		objectValues = append(objectValues, objectValue)
+	}
+
+	objectsList, diags := types.ListValue(types.ObjectType{AttrTypes: slaComplianceObjectAttrTypes()}, objectValues)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	state := slaComplianceModel{
+		ID:                   types.StringValue(fmt.Sprintf("%x", hash.Sum(nil))),
+		InComplianceCount:    types.Int64Value(inCompliance),
+		NotYetRunCount:       types.Int64Value(notYetRun),
+		ObjectIDs:            config.ObjectIDs,
+		Objects:              objectsList,
+		OutOfComplianceCount: types.Int64Value(outOfCompliance),
+		SLADomainID:          config.SLADomainID,
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+func slaComplianceObjectAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		keyArchivalSnapshotLag: types.Int64Type,
+		keyComplianceStatus:    types.StringType,
+		keyID:                  types.StringType,
+		keyLastSnapshot:        types.StringType,
+		keyMissedSnapshots:     types.Int64Type,
+		keyName:                types.StringType,
+		keyObjectType:          types.StringType,
+		keySLADomainID:         types.StringType,
+		keySLADomainName:       types.StringType,
+	}
+}
@@ -0,0 +1,391 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const dataSourceSLADomainEstimateDescription = `
+The ´polaris_sla_domain_estimate´ data source estimates the number of snapshots
+retained and the storage used when protecting objects with an SLA domain
+configuration. The estimate can be used to review the effect of a new or changed
+SLA domain before it's applied.
+
+The ´configuration´ field holds the object types, schedules, archival,
+replication, snapshot windows and object specific configurations of the SLA
+domain, using the same field names and structure as the ´polaris_sla_domain´
+resource. The ´template´ field of the ´polaris_sla_domain_template´ data source
+can be used as is or merged with changes.
+
+The number of snapshots retained is derived from the snapshot schedules, where
+a snapshot is only counted once even if it's retained by multiple schedules.
+Months, quarters and years use their average length. The storage used by a
+snapshot of an object is the average storage used by the local snapshots of the
+object. Objects without snapshots use the logical size of the object, i.e. a
+full snapshot without data reduction. The estimate doesn't account for changes
+in the data reduction or the change rate of the objects.
+`
+
+var (
+	_ datasource.DataSource = &slaDomainEstimateDataSource{}
+)
+
+type slaDomainEstimateDataSource struct {
+	client *client
+}
+
+type slaDomainEstimateModel struct {
+	ID                      types.String  `tfsdk:"id"`
+	Archival                types.List    `tfsdk:"archival"`
+	Configuration           types.Dynamic `tfsdk:"configuration"`
+	LocalSnapshotCount      types.Int64   `tfsdk:"local_snapshot_count"`
+	LogicalBytes            types.Int64   `tfsdk:"logical_bytes"`
+	ObjectIDs               types.Set     `tfsdk:"object_ids"`
+	Objects                 types.List    `tfsdk:"objects"`
+	PhysicalBytes           types.Int64   `tfsdk:"physical_bytes"`
+	ReplicatedPhysicalBytes types.Int64   `tfsdk:"replicated_physical_bytes"`
+	ReplicatedSnapshotCount types.Int64   `tfsdk:"replicated_snapshot_count"`
+	SnapshotCount           types.Int64   `tfsdk:"snapshot_count"`
+}
+
+func newSLADomainEstimateDataSource() datasource.DataSource {
+	return &slaDomainEstimateDataSource{}
+}
+
+func (d *slaDomainEstimateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	tflog.Trace(ctx, "slaDomainEstimateDataSource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySLADomainEstimate
+}
+
+func (d *slaDomainEstimateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	tflog.Trace(ctx, "slaDomainEstimateDataSource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(dataSourceSLADomainEstimateDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "SHA-256 hash of the object IDs and the estimate.",
+			},
+			keyArchival: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Estimated archival storage, one entry per archival location in the configuration.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyArchivalLocationID: schema.StringAttribute{
+							Computed:    true,
+							Description: "Archival location ID (UUID).",
+						},
+						keyColdPhysicalBytes: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated storage, in bytes, used by the snapshots tiered to cold storage.",
+						},
+						keyColdSnapshotCount: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated number of snapshots per object tiered to cold storage.",
+						},
+						keyColdStorageClass: schema.StringAttribute{
+							Computed:    true,
+							Description: "Cold storage class. Null if archival tiering isn't configured.",
+						},
+						keyPhysicalBytes: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated storage, in bytes, used by the archived snapshots.",
+						},
+						keySnapshotCount: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated number of archived snapshots per object.",
+						},
+					},
+				},
+			},
+			keyConfiguration: schema.DynamicAttribute{
+				Required: true,
+				Description: "SLA domain configuration, with the same field names and structure as the " +
+					"`polaris_sla_domain` resource. Supports the object types, schedules, archival, replication, " +
+					"snapshot windows and object specific configurations.",
+			},
+			keyLocalSnapshotCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated number of snapshots per object retained on the local cluster or cloud account.",
+			},
+			keyLogicalBytes: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated logical size, in bytes, of the snapshots retained for all objects.",
+			},
+			keyObjectIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Object IDs (UUIDs) to estimate the storage for.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(isUUID()),
+				},
+			},
+			keyObjects: schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Estimated local storage per object, ordered by object ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyID: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object ID (UUID).",
+						},
+						keyLogicalBytes: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated logical size, in bytes, of the snapshots retained for the object.",
+						},
+						keyName: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object name.",
+						},
+						keyObjectType: schema.StringAttribute{
+							Computed:    true,
+							Description: "Object type.",
+						},
+						keyPhysicalBytes: schema.Int64Attribute{
+							Computed:    true,
+							Description: "Estimated storage, in bytes, used by the local snapshots of the object.",
+						},
+					},
+				},
+			},
+			keyPhysicalBytes: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated storage, in bytes, used by the local snapshots of all objects.",
+			},
+			keyReplicatedPhysicalBytes: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated storage, in bytes, used by the replicated snapshots of all objects.",
+			},
+			keyReplicatedSnapshotCount: schema.Int64Attribute{
+				Computed: true,
+				Description: "Estimated number of replicated snapshots per object, summed over all replication " +
+					"targets.",
+			},
+			keySnapshotCount: schema.Int64Attribute{
+				Computed:    true,
+				Description: "Estimated number of snapshots retained per object.",
+			},
+		},
+	}
+}
+
+func (d *slaDomainEstimateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "slaDomainEstimateDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client)
+}
+
+func (d *slaDomainEstimateDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	tflog.Trace(ctx, "slaDomainEstimateDataSource.Read")
+
+	var config slaDomainEstimateModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	configValue, err := config.Configuration.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keyConfiguration), "Invalid configuration", err.Error())
+		return
+	}
+	model, err := slaDomainModelFromConfiguration(ctx, configValue)
+	if err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keyConfiguration), "Invalid configuration", err.Error())
+		return
+	}
+	schedule := model.snapshotSchedule()
+	if len(slaSnapshotTiers(schedule)) == 0 {
+		res.Diagnostics.AddAttributeError(path.Root(keyConfiguration), "Invalid configuration",
+			"at least one snapshot schedule retaining snapshots must be specified")
+		return
+	}
+	archivalSpecs, err := model.archivalSpecs(schedule)
+	if err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keyConfiguration), "Invalid configuration", err.Error())
+		return
+	}
+	replicationSpecs, err := model.replicationSpecs()
+	if err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keyConfiguration), "Invalid configuration", err.Error())
+		return
+	}
+	estimate := slaEstimateSnapshots(schedule, model.localRetention(), archivalSpecs, replicationSpecs)
+
+	var objectIDs []uuid.UUID
+	for _, value := range stringsFromSet(config.ObjectIDs) {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			res.Diagnostics.AddError("Invalid object_ids", err.Error())
+			return
+		}
+		objectIDs = append(objectIDs, id)
+	}
+
+	polarisClient, err := d.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	objects, err := slaEstimateObjects(ctx, polarisClient.GQL, objectIDs)
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read objects", err.Error())
+		return
+	}
+	var missing []string
+	for _, id := range objectIDs {
+		if !slices.ContainsFunc(objects, func(object slaEstimateObject) bool { return object.ID == id }) {
+			missing = append(missing, id.String())
+		}
+	}
+	if len(missing) > 0 {
+		res.Diagnostics.AddError("Objects not found", fmt.Sprintf("objects not found: %s", strings.Join(missing, ", ")))
+		return
+	}
+	slices.SortFunc(objects, func(a, b slaEstimateObject) int {
+		return cmp.Compare(a.ID.String(), b.ID.String())
+	})
+
+	hash := sha256.New()
+	hash.Write([]byte(fmt.Sprintf("%+v", estimate)))
+
+	var logicalBytes, physicalBytes, replicatedBytes int64
+	archivalBytes := make([]int64, len(estimate.Archival))
+	coldBytes := make([]int64, len(estimate.Archival))
+	objectValues := make([]attr.Value, 0, len(objects))
+	for _, object := range objects {
+		snapshotBytes := object.snapshotBytes()
+		objectLogicalBytes := object.LogicalBytes * estimate.Snapshots
+		objectPhysicalBytes := snapshotBytes * estimate.LocalSnapshots
+		logicalBytes += objectLogicalBytes
+		physicalBytes += objectPhysicalBytes
+		replicatedBytes += snapshotBytes * estimate.ReplicatedSnapshots
+		for i, archival := range estimate.Archival {
+			archivalBytes[i] += snapshotBytes * archival.Snapshots
+			coldBytes[i] += snapshotBytes * archival.ColdSnapshots
+		}
+
+		hash.Write([]byte(object.ID.String()))
+		hash.Write([]byte(fmt.Sprintf("%d/%d", objectLogicalBytes, objectPhysicalBytes)))
+
+		objectValue, diags := types.ObjectValue(slaDomainEstimateObjectAttrTypes(), map[string]attr.Value{
+			keyID:            types.StringValue(object.ID.String()),
+			keyLogicalBytes:  types.Int64Value(objectLogicalBytes),
+			keyName:          types.StringValue(object.Name),
+			keyObjectType:    types.StringValue(object.ObjectType),
+			keyPhysicalBytes: types.Int64Value(objectPhysicalBytes),
+		})
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		objectValues = append(objectValues, objectValue)
+	}
+
+	archivalValues := make([]attr.Value, 0, len(estimate.Archival))
+	for i, archival := range estimate.Archival {
+		coldStorageClass := types.StringNull()
+		if archival.ColdStorageClass != "" {
+			coldStorageClass = types.StringValue(archival.ColdStorageClass)
+		}
+		archivalValue, diags := types.ObjectValue(slaDomainEstimateArchivalAttrTypes(), map[string]attr.Value{
+			keyArchivalLocationID: types.StringValue(archival.ArchivalLocationID.String()),
+			keyColdPhysicalBytes:  types.Int64Value(coldBytes[i]),
+			keyColdSnapshotCount:  types.Int64Value(archival.ColdSnapshots),
+			keyColdStorageClass:   coldStorageClass,
+			keyPhysicalBytes:      types.Int64Value(archivalBytes[i]),
+			keySnapshotCount:      types.Int64Value(archival.Snapshots),
+		})
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+		archivalValues = append(archivalValues, archivalValue)
+	}
+
+	objectsList, diags := types.ListValue(types.ObjectType{AttrTypes: slaDomainEstimateObjectAttrTypes()}, objectValues)
+	res.Diagnostics.Append(diags...)
+	archivalList, diags := types.ListValue(types.ObjectType{AttrTypes: slaDomainEstimateArchivalAttrTypes()}, archivalValues)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	state := slaDomainEstimateModel{
+		ID:                      types.StringValue(fmt.Sprintf("%x", hash.Sum(nil))),
+		Archival:                archivalList,
+		Configuration:           config.Configuration,
+		LocalSnapshotCount:      types.Int64Value(estimate.LocalSnapshots),
+		LogicalBytes:            types.Int64Value(logicalBytes),
+		ObjectIDs:               config.ObjectIDs,
+		Objects:                 objectsList,
+		PhysicalBytes:           types.Int64Value(physicalBytes),
+		ReplicatedPhysicalBytes: types.Int64Value(replicatedBytes),
+		ReplicatedSnapshotCount: types.Int64Value(estimate.ReplicatedSnapshots),
+		SnapshotCount:           types.Int64Value(estimate.Snapshots),
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+func slaDomainEstimateArchivalAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		keyArchivalLocationID: types.StringType,
+		keyColdPhysicalBytes:  types.Int64Type,
+		keyColdSnapshotCount:  types.Int64Type,
+		keyColdStorageClass:   types.StringType,
+		keyPhysicalBytes:      types.Int64Type,
+		keySnapshotCount:      types.Int64Type,
+	}
+}
+
+func slaDomainEstimateObjectAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		keyID:            types.StringType,
+		keyLogicalBytes:  types.Int64Type,
+		keyName:          types.StringType,
+		keyObjectType:    types.StringType,
+		keyPhysicalBytes: types.Int64Type,
+	}
+}
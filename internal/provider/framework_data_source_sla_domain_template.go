@@ -0,0 +1,222 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+const dataSourceSLADomainTemplateDescription = `
+The ´polaris_sla_domain_template´ data source exposes an RSC global SLA domain
+as a template for other SLA domains. The SLA domain is looked up using either
+the ID or the name.
+
+The ´template´ field holds the object types, schedules, archival, replication,
+snapshot windows and object specific configurations of the SLA domain, using
+the same field names and structure as the ´polaris_sla_domain´ resource. This
+allows each field to be used as is or overridden, e.g. using ´merge´.
+
+To copy all fields not specified in the configuration, use the ´clone_from_id´
+field of the ´polaris_sla_domain´ resource instead.
+`
+
+var (
+	_ datasource.DataSource                     = &slaDomainTemplateDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &slaDomainTemplateDataSource{}
+)
+
+type slaDomainTemplateDataSource struct {
+	client *client
+}
+
+type slaDomainTemplateModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Description types.String  `tfsdk:"description"`
+	Name        types.String  `tfsdk:"name"`
+	Template    types.Dynamic `tfsdk:"template"`
+}
+
+func newSLADomainTemplateDataSource() datasource.DataSource {
+	return &slaDomainTemplateDataSource{}
+}
+
+func (d *slaDomainTemplateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, res *datasource.MetadataResponse) {
+	tflog.Trace(ctx, "slaDomainTemplateDataSource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySLADomainTemplate
+}
+
+func (d *slaDomainTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, res *datasource.SchemaResponse) {
+	tflog.Trace(ctx, "slaDomainTemplateDataSource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(dataSourceSLADomainTemplateDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SLA domain ID (UUID).",
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+			keyDescription: schema.StringAttribute{
+				Computed:    true,
+				Description: "SLA domain description.",
+			},
+			keyName: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "SLA domain name.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			keyTemplate: schema.DynamicAttribute{
+				Computed: true,
+				Description: "Object types, schedules, archival, replication, snapshot windows and object specific " +
+					"configurations of the SLA domain, with the same field names and structure as the " +
+					"`polaris_sla_domain` resource.",
+			},
+		},
+	}
+}
+
+func (d *slaDomainTemplateDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	tflog.Trace(ctx, "slaDomainTemplateDataSource.ConfigValidators")
+
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot(keyID),
+			path.MatchRoot(keyName),
+		),
+	}
+}
+
+func (d *slaDomainTemplateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, res *datasource.ConfigureResponse) {
+	tflog.Trace(ctx, "slaDomainTemplateDataSource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	d.client = req.ProviderData.(*client)
+}
+
+func (d *slaDomainTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, res *datasource.ReadResponse) {
+	tflog.Trace(ctx, "slaDomainTemplateDataSource.Read")
+
+	var config slaDomainTemplateModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := d.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	var domain gqlsla.Domain
+	if !config.ID.IsNull() {
+		id, err := uuid.Parse(config.ID.ValueString())
+		if err != nil {
+			res.Diagnostics.AddError("Invalid SLA domain ID", err.Error())
+			return
+		}
+		domain, err = sla.Wrap(polarisClient).DomainByID(ctx, id)
+	} else {
+		domain, err = sla.Wrap(polarisClient).DomainByName(ctx, config.Name.ValueString())
+	}
+	if errors.Is(err, graphql.ErrNotFound) {
+		res.Diagnostics.AddError("SLA domain not found", err.Error())
+		return
+	}
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read SLA domain", err.Error())
+		return
+	}
+
+	workload, err := slaDomainWorkloadConfigs(ctx, polarisClient.GQL, domain.ID)
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read SLA domain", err.Error())
+		return
+	}
+
+	template, diags := slaDomainTemplateObject(ctx, domain, workload)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	state := slaDomainTemplateModel{
+		ID:          types.StringValue(domain.ID.String()),
+		Description: types.StringValue(domain.Description),
+		Name:        types.StringValue(domain.Name),
+		Template:    types.DynamicValue(template),
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+// slaDomainTemplateObject returns the template object of the SLA domain. The
+// template holds the object types and the fields which can be cloned, using
+// the types of the SLA domain resource schema.
+func slaDomainTemplateObject(ctx context.Context, domain gqlsla.Domain, workload slaWorkloadConfigsReply) (types.Object, diag.Diagnostics) {
+	values, diags := slaDomainTemplateValues(ctx, domain, workload)
+	if diags.HasError() {
+		return types.ObjectNull(nil), diags
+	}
+
+	attrs := slaDomainSchema().Attributes
+	keys := append([]string{keyObjectTypes}, slaDomainCloneKeys...)
+	attrTypes := make(map[string]attr.Type, len(keys))
+	attrValues := make(map[string]attr.Value, len(keys))
+	for _, key := range keys {
+		attrType := attrs[key].GetType()
+		value, err := attrType.ValueFromTerraform(ctx, values[key])
+		if err != nil {
+			diags.AddError("Failed to read SLA domain", err.Error())
+			return types.ObjectNull(nil), diags
+		}
+		attrTypes[key] = attrType
+		attrValues[key] = value
+	}
+
+	object, d := types.ObjectValue(attrTypes, attrValues)
+	diags.Append(d...)
+	return object, diags
+}
@@ -0,0 +1,1677 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlaws "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/aws"
+	gqlazure "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/azure"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+// slaDomainModel is the Terraform Framework model of the polaris_sla_domain
+// resource. Optional single nested attributes are held by pointers and list
+// nested attributes by slices, a nil pointer or slice being a null value.
+type slaDomainModel struct {
+	ID                               types.String                `tfsdk:"id"`
+	ApplyChangesToExistingSnapshots  types.Bool                  `tfsdk:"apply_changes_to_existing_snapshots"`
+	ApplyChangesToNonPolicySnapshots types.Bool                  `tfsdk:"apply_changes_to_non_policy_snapshots"`
+	Archival                         []slaArchivalModel          `tfsdk:"archival"`
+	AWSDynamoDBConfig                *slaAWSDynamoDBConfigModel  `tfsdk:"aws_dynamodb_config"`
+	AWSRDSConfig                     *slaLogRetentionModel       `tfsdk:"aws_rds_config"`
+	AzureBlobConfig                  *slaAzureBlobConfigModel    `tfsdk:"azure_blob_config"`
+	AzureSQLDatabaseConfig           *slaAzureSQLConfigModel     `tfsdk:"azure_sql_database_config"`
+	AzureSQLManagedInstanceConfig    *slaAzureSQLConfigModel     `tfsdk:"azure_sql_managed_instance_config"`
+	BackupLocation                   []slaBackupLocationModel    `tfsdk:"backup_location"`
+	BackupType                       types.String                `tfsdk:"backup_type"`
+	CloneFromID                      types.String                `tfsdk:"clone_from_id"`
+	DailySchedule                    *slaBasicScheduleModel      `tfsdk:"daily_schedule"`
+	DB2Config                        *slaDB2ConfigModel          `tfsdk:"db2_config"`
+	Description                      types.String                `tfsdk:"description"`
+	ExchangeConfig                   *slaFrequencyRetentionModel `tfsdk:"exchange_config"`
+	FirstFullSnapshot                []slaSnapshotWindowModel    `tfsdk:"first_full_snapshot"`
+	GCPCloudSQLConfig                *slaLogRetentionModel       `tfsdk:"gcp_cloud_sql_config"`
+	HourlySchedule                   *slaBasicScheduleModel      `tfsdk:"hourly_schedule"`
+	InformixConfig                   *slaInformixConfigModel     `tfsdk:"informix_config"`
+	KubernetesConfig                 *slaKubernetesConfigModel   `tfsdk:"kubernetes_config"`
+	LocalRetention                   *slaRetentionModel          `tfsdk:"local_retention"`
+	LockedAt                         types.String                `tfsdk:"locked_at"`
+	ManagedVolumeConfig              *slaLogRetentionModel       `tfsdk:"managed_volume_config"`
+	Microsoft365Config               *slaMicrosoft365ConfigModel `tfsdk:"microsoft_365_config"`
+	MinuteSchedule                   *slaBasicScheduleModel      `tfsdk:"minute_schedule"`
+	MongoConfig                      *slaFrequencyRetentionModel `tfsdk:"mongo_config"`
+	MonthlySchedule                  *slaMonthlyScheduleModel    `tfsdk:"monthly_schedule"`
+	MSSQLConfig                      *slaMSSQLConfigModel        `tfsdk:"mssql_config"`
+	MySQLDBConfig                    *slaFrequencyRetentionModel `tfsdk:"mysqldb_config"`
+	Name                             types.String                `tfsdk:"name"`
+	NCDConfig                        *slaNCDConfigModel          `tfsdk:"ncd_config"`
+	ObjectTypes                      types.Set                   `tfsdk:"object_types"`
+	OracleConfig                     *slaOracleConfigModel       `tfsdk:"oracle_config"`
+	Paused                           types.Bool                  `tfsdk:"paused"`
+	PostgresDBClusterConfig          *slaLogRetentionModel       `tfsdk:"postgres_db_cluster_config"`
+	QuarterlySchedule                *slaQuarterlyScheduleModel  `tfsdk:"quarterly_schedule"`
+	ReplicationSpec                  []slaReplicationSpecModel   `tfsdk:"replication_spec"`
+	RetentionLock                    *slaRetentionLockModel      `tfsdk:"retention_lock"`
+	RetentionLockMode                types.String                `tfsdk:"retention_lock_mode"`
+	SapHanaConfig                    *slaSapHanaConfigModel      `tfsdk:"sap_hana_config"`
+	SnapshotWindow                   []slaSnapshotWindowModel    `tfsdk:"snapshot_window"`
+	VMwareVMConfig                   *slaVMwareVMConfigModel     `tfsdk:"vmware_vm_config"`
+	WeeklySchedule                   *slaWeeklyScheduleModel     `tfsdk:"weekly_schedule"`
+	YearlySchedule                   *slaYearlyScheduleModel     `tfsdk:"yearly_schedule"`
+}
+
+type slaArchivalModel struct {
+	ArchivalLocationID               types.String                     `tfsdk:"archival_location_id"`
+	Threshold                        types.Int64                      `tfsdk:"threshold"`
+	ThresholdUnit                    types.String                     `tfsdk:"threshold_unit"`
+	ArchivalLocationToClusterMapping []slaArchivalClusterMappingModel `tfsdk:"archival_location_to_cluster_mapping"`
+	ArchivalTiering                  *slaArchivalTieringModel         `tfsdk:"archival_tiering"`
+	Frequency                        types.Set                        `tfsdk:"frequency"`
+}
+
+type slaArchivalClusterMappingModel struct {
+	ClusterID          types.String `tfsdk:"cluster_id"`
+	ArchivalLocationID types.String `tfsdk:"archival_location_id"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	Name               types.String `tfsdk:"name"`
+}
+
+type slaArchivalTieringModel struct {
+	InstantTiering                 types.Bool   `tfsdk:"instant_tiering"`
+	MinAccessibleDurationInSeconds types.Int64  `tfsdk:"min_accessible_duration_in_seconds"`
+	ColdStorageClass               types.String `tfsdk:"cold_storage_class"`
+	TierExistingSnapshots          types.Bool   `tfsdk:"tier_existing_snapshots"`
+}
+
+type slaAWSDynamoDBConfigModel struct {
+	KMSAlias types.String `tfsdk:"kms_alias"`
+}
+
+type slaLogRetentionModel struct {
+	LogRetention     types.Int64  `tfsdk:"log_retention"`
+	LogRetentionUnit types.String `tfsdk:"log_retention_unit"`
+}
+
+type slaAzureBlobConfigModel struct {
+	ArchivalLocationID types.String `tfsdk:"archival_location_id"`
+}
+
+type slaAzureSQLConfigModel struct {
+	LogRetention types.Int64        `tfsdk:"log_retention"`
+	LTRConfig    *slaLTRConfigModel `tfsdk:"ltr_config"`
+}
+
+type slaLTRConfigModel struct {
+	WeeklyRetention  *slaRetentionModel          `tfsdk:"weekly_retention"`
+	MonthlyRetention *slaRetentionModel          `tfsdk:"monthly_retention"`
+	YearlyRetention  *slaLTRYearlyRetentionModel `tfsdk:"yearly_retention"`
+}
+
+type slaLTRYearlyRetentionModel struct {
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+	WeekOfYear    types.Int64  `tfsdk:"week_of_year"`
+}
+
+type slaVMwareVMConfigModel struct {
+	LogRetention        types.Int64  `tfsdk:"log_retention"`
+	SnapshotConsistency types.String `tfsdk:"snapshot_consistency"`
+}
+
+type slaMicrosoft365ConfigModel struct {
+	ArchivalTiering *slaArchivalTieringModel `tfsdk:"archival_tiering"`
+	BackupWindow    []slaSnapshotWindowModel `tfsdk:"backup_window"`
+}
+
+type slaKubernetesConfigModel struct {
+	IncludeClusterScopedResources types.Bool `tfsdk:"include_cluster_scoped_resources"`
+	IncludePersistentVolumeClaims types.Bool `tfsdk:"include_persistent_volume_claims"`
+}
+
+type slaSapHanaConfigModel struct {
+	IncrementalFrequency      types.Int64                 `tfsdk:"incremental_frequency"`
+	IncrementalFrequencyUnit  types.String                `tfsdk:"incremental_frequency_unit"`
+	LogRetention              types.Int64                 `tfsdk:"log_retention"`
+	LogRetentionUnit          types.String                `tfsdk:"log_retention_unit"`
+	DifferentialFrequency     types.Int64                 `tfsdk:"differential_frequency"`
+	DifferentialFrequencyUnit types.String                `tfsdk:"differential_frequency_unit"`
+	StorageSnapshotConfig     *slaFrequencyRetentionModel `tfsdk:"storage_snapshot_config"`
+}
+
+type slaDB2ConfigModel struct {
+	IncrementalFrequency      types.Int64  `tfsdk:"incremental_frequency"`
+	IncrementalFrequencyUnit  types.String `tfsdk:"incremental_frequency_unit"`
+	LogRetention              types.Int64  `tfsdk:"log_retention"`
+	LogRetentionUnit          types.String `tfsdk:"log_retention_unit"`
+	DifferentialFrequency     types.Int64  `tfsdk:"differential_frequency"`
+	DifferentialFrequencyUnit types.String `tfsdk:"differential_frequency_unit"`
+	LogArchivalMethod         types.String `tfsdk:"log_archival_method"`
+}
+
+type slaMSSQLConfigModel struct {
+	Frequency        types.Int64  `tfsdk:"frequency"`
+	FrequencyUnit    types.String `tfsdk:"frequency_unit"`
+	LogRetention     types.Int64  `tfsdk:"log_retention"`
+	LogRetentionUnit types.String `tfsdk:"log_retention_unit"`
+}
+
+type slaOracleConfigModel struct {
+	Frequency                     types.Int64  `tfsdk:"frequency"`
+	FrequencyUnit                 types.String `tfsdk:"frequency_unit"`
+	LogRetention                  types.Int64  `tfsdk:"log_retention"`
+	LogRetentionUnit              types.String `tfsdk:"log_retention_unit"`
+	HostLogRetention              types.Int64  `tfsdk:"host_log_retention"`
+	HostLogRetentionUnit          types.String `tfsdk:"host_log_retention_unit"`
+	RetainArchiveLogsIndefinitely types.Bool   `tfsdk:"retain_archive_logs_indefinitely"`
+}
+
+// slaFrequencyRetentionModel is used by the MongoDB and MySQL configurations
+// and by the SAP HANA storage snapshot configuration.
+type slaFrequencyRetentionModel struct {
+	Frequency     types.Int64  `tfsdk:"frequency"`
+	FrequencyUnit types.String `tfsdk:"frequency_unit"`
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+}
+
+type slaInformixConfigModel struct {
+	IncrementalFrequency     types.Int64  `tfsdk:"incremental_frequency"`
+	IncrementalFrequencyUnit types.String `tfsdk:"incremental_frequency_unit"`
+	IncrementalRetention     types.Int64  `tfsdk:"incremental_retention"`
+	IncrementalRetentionUnit types.String `tfsdk:"incremental_retention_unit"`
+	Frequency                types.Int64  `tfsdk:"frequency"`
+	FrequencyUnit            types.String `tfsdk:"frequency_unit"`
+	Retention                types.Int64  `tfsdk:"retention"`
+	RetentionUnit            types.String `tfsdk:"retention_unit"`
+}
+
+type slaNCDConfigModel struct {
+	MinutelyBackupLocations  types.List `tfsdk:"minutely_backup_locations"`
+	HourlyBackupLocations    types.List `tfsdk:"hourly_backup_locations"`
+	DailyBackupLocations     types.List `tfsdk:"daily_backup_locations"`
+	WeeklyBackupLocations    types.List `tfsdk:"weekly_backup_locations"`
+	MonthlyBackupLocations   types.List `tfsdk:"monthly_backup_locations"`
+	QuarterlyBackupLocations types.List `tfsdk:"quarterly_backup_locations"`
+	YearlyBackupLocations    types.List `tfsdk:"yearly_backup_locations"`
+}
+
+type slaBackupLocationModel struct {
+	ArchivalGroupID types.String `tfsdk:"archival_group_id"`
+}
+
+type slaSnapshotWindowModel struct {
+	Duration types.Int64  `tfsdk:"duration"`
+	StartAt  types.String `tfsdk:"start_at"`
+}
+
+// slaRetentionModel is used by the local retention, the replication local
+// retention and the Azure SQL weekly and monthly long-term retention.
+type slaRetentionModel struct {
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+}
+
+type slaRetentionLockModel struct {
+	Mode                         types.String `tfsdk:"mode"`
+	ComplianceModeAcknowledgment types.Bool   `tfsdk:"compliance_mode_acknowledgment"`
+}
+
+type slaBasicScheduleModel struct {
+	Frequency     types.Int64  `tfsdk:"frequency"`
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+}
+
+type slaMonthlyScheduleModel struct {
+	DayOfMonth    types.String `tfsdk:"day_of_month"`
+	Frequency     types.Int64  `tfsdk:"frequency"`
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+}
+
+type slaQuarterlyScheduleModel struct {
+	DayOfQuarter      types.String `tfsdk:"day_of_quarter"`
+	Frequency         types.Int64  `tfsdk:"frequency"`
+	QuarterStartMonth types.String `tfsdk:"quarter_start_month"`
+	Retention         types.Int64  `tfsdk:"retention"`
+	RetentionUnit     types.String `tfsdk:"retention_unit"`
+}
+
+type slaWeeklyScheduleModel struct {
+	DayOfWeek     types.String `tfsdk:"day_of_week"`
+	Frequency     types.Int64  `tfsdk:"frequency"`
+	Retention     types.Int64  `tfsdk:"retention"`
+	RetentionUnit types.String `tfsdk:"retention_unit"`
+}
+
+type slaYearlyScheduleModel struct {
+	DayOfYear      types.String `tfsdk:"day_of_year"`
+	Frequency      types.Int64  `tfsdk:"frequency"`
+	Retention      types.Int64  `tfsdk:"retention"`
+	RetentionUnit  types.String `tfsdk:"retention_unit"`
+	YearStartMonth types.String `tfsdk:"year_start_month"`
+}
+
+type slaReplicationSpecModel struct {
+	AWSRegion         types.String                `tfsdk:"aws_region"`
+	AWSCrossAccount   types.String                `tfsdk:"aws_cross_account"`
+	AzureRegion       types.String                `tfsdk:"azure_region"`
+	ReplicationPair   []slaReplicationPairModel   `tfsdk:"replication_pair"`
+	Retention         types.Int64                 `tfsdk:"retention"`
+	RetentionUnit     types.String                `tfsdk:"retention_unit"`
+	LocalRetention    *slaRetentionModel          `tfsdk:"local_retention"`
+	CascadingArchival []slaCascadingArchivalModel `tfsdk:"cascading_archival"`
+}
+
+type slaReplicationPairModel struct {
+	SourceCluster types.String `tfsdk:"source_cluster"`
+	TargetCluster types.String `tfsdk:"target_cluster"`
+}
+
+type slaCascadingArchivalModel struct {
+	ArchivalLocationID    types.String             `tfsdk:"archival_location_id"`
+	ArchivalThreshold     types.Int64              `tfsdk:"archival_threshold"`
+	ArchivalThresholdUnit types.String             `tfsdk:"archival_threshold_unit"`
+	ArchivalTiering       *slaArchivalTieringModel `tfsdk:"archival_tiering"`
+	Frequency             types.Set                `tfsdk:"frequency"`
+}
+
+// objectTypes returns the object types protected by the SLA domain.
+func (m slaDomainModel) objectTypes() []gqlsla.ObjectType {
+	var objectTypes []gqlsla.ObjectType
+	for _, objectType := range stringsFromSet(m.ObjectTypes) {
+		objectTypes = append(objectTypes, gqlsla.ObjectType(objectType))
+	}
+	return objectTypes
+}
+
+// snapshotSchedule returns the snapshot schedule of the SLA domain.
+// Unspecified time frame schedules are nil.
+func (m slaDomainModel) snapshotSchedule() gqlsla.SnapshotSchedule {
+	var schedule gqlsla.SnapshotSchedule
+	if s := m.DailySchedule; s != nil {
+		schedule.Daily = &gqlsla.DailySnapshotSchedule{BasicSchedule: s.basicSchedule()}
+	}
+	if s := m.HourlySchedule; s != nil {
+		schedule.Hourly = &gqlsla.HourlySnapshotSchedule{BasicSchedule: s.basicSchedule()}
+	}
+	if s := m.MinuteSchedule; s != nil {
+		schedule.Minute = &gqlsla.MinuteSnapshotSchedule{BasicSchedule: s.basicSchedule()}
+	}
+	if s := m.MonthlySchedule; s != nil {
+		schedule.Monthly = &gqlsla.MonthlySnapshotSchedule{
+			BasicSchedule: basicSchedule(s.Frequency, s.Retention, s.RetentionUnit),
+			DayOfMonth:    gqlsla.DayOfMonth(s.DayOfMonth.ValueString()),
+		}
+	}
+	if s := m.QuarterlySchedule; s != nil {
+		schedule.Quarterly = &gqlsla.QuarterlySnapshotSchedule{
+			BasicSchedule:     basicSchedule(s.Frequency, s.Retention, s.RetentionUnit),
+			DayOfQuarter:      gqlsla.DayOfQuarter(s.DayOfQuarter.ValueString()),
+			QuarterStartMonth: gqlsla.Month(s.QuarterStartMonth.ValueString()),
+		}
+	}
+	if s := m.WeeklySchedule; s != nil {
+		// For M365 Backup Storage SLAs, the day of week is omitted.
+		schedule.Weekly = &gqlsla.WeeklySnapshotSchedule{
+			BasicSchedule: basicSchedule(s.Frequency, s.Retention, s.RetentionUnit),
+			DayOfWeek:     gqlsla.Day(s.DayOfWeek.ValueString()),
+		}
+	}
+	if s := m.YearlySchedule; s != nil {
+		schedule.Yearly = &gqlsla.YearlySnapshotSchedule{
+			BasicSchedule:  basicSchedule(s.Frequency, s.Retention, s.RetentionUnit),
+			DayOfYear:      gqlsla.DayOfYear(s.DayOfYear.ValueString()),
+			YearStartMonth: gqlsla.Month(s.YearStartMonth.ValueString()),
+		}
+	}
+
+	return schedule
+}
+
+func (m slaBasicScheduleModel) basicSchedule() gqlsla.BasicSnapshotSchedule {
+	return basicSchedule(m.Frequency, m.Retention, m.RetentionUnit)
+}
+
+func basicSchedule(frequency, retention types.Int64, retentionUnit types.String) gqlsla.BasicSnapshotSchedule {
+	return gqlsla.BasicSnapshotSchedule{
+		Frequency:     int(frequency.ValueInt64()),
+		Retention:     int(retention.ValueInt64()),
+		RetentionUnit: gqlsla.RetentionUnit(retentionUnit.ValueString()),
+	}
+}
+
+// archivalSpecs returns the archival specifications of the SLA domain. When no
+// frequencies are specified for an archival location, the frequencies are
+// derived from the snapshot schedule.
+func (m slaDomainModel) archivalSpecs(schedule gqlsla.SnapshotSchedule) ([]gqlsla.ArchivalSpec, error) {
+	var archivalSpecs []gqlsla.ArchivalSpec
+	for _, archival := range m.Archival {
+		var groupID uuid.UUID
+		if alID := archival.ArchivalLocationID.ValueString(); alID != "" {
+			var err error
+			if groupID, err = uuid.Parse(alID); err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %s", keyArchivalLocationID, err)
+			}
+		}
+
+		var mappings []gqlsla.ArchivalLocationToClusterMapping
+		for _, mapping := range archival.ArchivalLocationToClusterMapping {
+			locationID, err := uuid.Parse(mapping.ArchivalLocationID.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse archival location ID in mapping: %s", err)
+			}
+
+			var clusterID uuid.UUID
+			if id := mapping.ClusterID.ValueString(); id != "" {
+				if clusterID, err = uuid.Parse(id); err != nil {
+					return nil, fmt.Errorf("failed to parse cluster ID in mapping: %s", err)
+				}
+			}
+
+			mappings = append(mappings, gqlsla.ArchivalLocationToClusterMapping{
+				ClusterID:  clusterID,
+				LocationID: locationID,
+			})
+		}
+
+		frequencies := retentionUnitsFromSet(archival.Frequency)
+		if len(frequencies) == 0 {
+			frequencies = frequenciesFromSchedule(schedule)
+		}
+
+		archivalSpecs = append(archivalSpecs, gqlsla.ArchivalSpec{
+			GroupID:                          groupID,
+			Frequencies:                      frequencies,
+			Threshold:                        int(archival.Threshold.ValueInt64()),
+			ThresholdUnit:                    gqlsla.RetentionUnit(archival.ThresholdUnit.ValueString()),
+			ArchivalLocationToClusterMapping: mappings,
+			ArchivalTieringSpec:              archival.ArchivalTiering.tieringSpec(),
+		})
+	}
+
+	return archivalSpecs, nil
+}
+
+func (m *slaArchivalTieringModel) tieringSpec() *gqlsla.ArchivalTieringSpec {
+	if m == nil {
+		return nil
+	}
+
+	return &gqlsla.ArchivalTieringSpec{
+		InstantTiering:                 m.InstantTiering.ValueBool(),
+		MinAccessibleDurationInSeconds: m.MinAccessibleDurationInSeconds.ValueInt64(),
+		ColdStorageClass:               gqlsla.ColdStorageClass(m.ColdStorageClass.ValueString()),
+		TierExistingSnapshots:          m.TierExistingSnapshots.ValueBool(),
+	}
+}
+
+// replicationSpecs returns the replication specifications of the SLA domain.
+func (m slaDomainModel) replicationSpecs() ([]gqlsla.ReplicationSpec, error) {
+	var replicationSpecs []gqlsla.ReplicationSpec
+	for _, spec := range m.ReplicationSpec {
+		var awsRegion gqlaws.Region
+		var awsCrossAccount string
+		if name := spec.AWSRegion.ValueString(); name != "" {
+			awsRegion = gqlaws.RegionFromName(name)
+			if awsRegion == gqlaws.RegionUnknown {
+				return nil, fmt.Errorf("unknown AWS region: %s", name)
+			}
+			awsCrossAccount = spec.AWSCrossAccount.ValueString()
+			if awsCrossAccount == "" {
+				awsCrossAccount = "SAME"
+			}
+		}
+		var azureRegion gqlazure.Region
+		var azureCrossSubscription string
+		if name := spec.AzureRegion.ValueString(); name != "" {
+			azureRegion = gqlazure.RegionFromName(name)
+			if azureRegion == gqlazure.RegionUnknown {
+				return nil, fmt.Errorf("unknown Azure region: %s", name)
+			}
+			azureCrossSubscription = "SAME"
+		}
+
+		var replicationPairs []gqlsla.ReplicationPair
+		for _, pair := range spec.ReplicationPair {
+			replicationPairs = append(replicationPairs, gqlsla.ReplicationPair{
+				SourceClusterID: pair.SourceCluster.ValueString(),
+				TargetClusterID: pair.TargetCluster.ValueString(),
+			})
+		}
+
+		var cascadingArchivalSpecs []gqlsla.CascadingArchivalSpec
+		for _, archival := range spec.CascadingArchival {
+			archivalLocationID, err := uuid.Parse(archival.ArchivalLocationID.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid archival location ID: %w", err)
+			}
+
+			// Build an archival location to cluster mapping for each target
+			// cluster, instead of using the deprecated archival location ID.
+			var cascadingSpec gqlsla.CascadingArchivalSpec
+			for _, pair := range replicationPairs {
+				targetClusterID, err := uuid.Parse(pair.TargetClusterID)
+				if err != nil {
+					return nil, fmt.Errorf("invalid target cluster ID: %w", err)
+				}
+				cascadingSpec.ArchivalLocationToClusterMappings = append(cascadingSpec.ArchivalLocationToClusterMappings,
+					gqlsla.ArchivalLocationToClusterMapping{
+						ClusterID:  targetClusterID,
+						LocationID: archivalLocationID,
+					})
+			}
+			if threshold := archival.ArchivalThreshold.ValueInt64(); threshold > 0 {
+				cascadingSpec.ArchivalThreshold = &gqlsla.RetentionDuration{
+					Duration: int(threshold),
+					Unit:     gqlsla.RetentionUnit(archival.ArchivalThresholdUnit.ValueString()),
+				}
+			}
+			cascadingSpec.ArchivalTieringSpec = archival.ArchivalTiering.tieringSpec()
+			cascadingSpec.Frequencies = retentionUnitsFromSet(archival.Frequency)
+
+			cascadingArchivalSpecs = append(cascadingArchivalSpecs, cascadingSpec)
+		}
+
+		replicationSpecs = append(replicationSpecs, gqlsla.ReplicationSpec{
+			AWSRegion:                         awsRegion.ToRegionForReplicationEnum(),
+			AWSAccount:                        awsCrossAccount,
+			AzureRegion:                       azureRegion.ToRegionForReplicationEnum(),
+			AzureSubscription:                 azureCrossSubscription,
+			RetentionDuration:                 retentionDuration(spec.Retention, spec.RetentionUnit),
+			ReplicationPairs:                  replicationPairs,
+			ReplicationLocalRetentionDuration: spec.LocalRetention.retentionDuration(),
+			CascadingArchivalSpecs:            cascadingArchivalSpecs,
+		})
+	}
+
+	return replicationSpecs, nil
+}
+
+func (m *slaRetentionModel) retentionDuration() *gqlsla.RetentionDuration {
+	if m == nil {
+		return nil
+	}
+
+	return retentionDuration(m.Retention, m.RetentionUnit)
+}
+
+func retentionDuration(duration types.Int64, unit types.String) *gqlsla.RetentionDuration {
+	return &gqlsla.RetentionDuration{
+		Duration: int(duration.ValueInt64()),
+		Unit:     gqlsla.RetentionUnit(unit.ValueString()),
+	}
+}
+
+// optionalRetentionDuration returns the retention duration, or the zero value
+// if the duration isn't set.
+func optionalRetentionDuration(duration types.Int64, unit types.String) gqlsla.RetentionDuration {
+	if duration.ValueInt64() <= 0 {
+		return gqlsla.RetentionDuration{}
+	}
+
+	return *retentionDuration(duration, unit)
+}
+
+// backupLocations returns the backup location specifications of the SLA
+// domain.
+func (m slaDomainModel) backupLocations() ([]gqlsla.BackupLocationSpec, error) {
+	var locations []gqlsla.BackupLocationSpec
+	for _, location := range m.BackupLocation {
+		groupID, err := uuid.Parse(location.ArchivalGroupID.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %s", keyArchivalGroupID, err)
+		}
+		locations = append(locations, gqlsla.BackupLocationSpec{ArchivalGroupID: groupID})
+	}
+
+	return locations, nil
+}
+
+// awsS3Config returns the AWS S3 configuration of the SLA domain, used when
+// multiple backup locations are not enabled for the account.
+func (m slaDomainModel) awsS3Config() (*gqlsla.AWSS3Config, error) {
+	if len(m.BackupLocation) == 0 {
+		return nil, nil
+	}
+	if len(m.BackupLocation) > 1 {
+		return nil, fmt.Errorf("multiple backup locations not supported")
+	}
+
+	groupID, err := uuid.Parse(m.BackupLocation[0].ArchivalGroupID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlsla.AWSS3Config{ArchivalLocationID: groupID}, nil
+}
+
+// azureBlobConfig returns the Azure Blob configuration of the SLA domain.
+func (m slaDomainModel) azureBlobConfig() (*gqlsla.AzureBlobConfig, error) {
+	if m.AzureBlobConfig == nil {
+		return nil, nil
+	}
+
+	archivalLocationID, err := uuid.Parse(m.AzureBlobConfig.ArchivalLocationID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlsla.AzureBlobConfig{
+		BackupLocationID:                archivalLocationID,
+		ContinuousBackupRetentionInDays: 1,
+	}, nil
+}
+
+// azureDBConfig returns the Azure SQL configuration. The long-term retention
+// config is nil when not set, marking the SLA as V2 (Rubrik-managed).
+func (m *slaAzureSQLConfigModel) azureDBConfig() *gqlsla.AzureDBConfig {
+	if m == nil {
+		return nil
+	}
+
+	config := &gqlsla.AzureDBConfig{LogRetentionInDays: int(m.LogRetention.ValueInt64())}
+	if ltr := m.LTRConfig; ltr != nil {
+		config.LTRConfig = &gqlsla.AzureSQLLTRConfig{
+			WeeklyBackupRetention:  ltr.WeeklyRetention.ltrRetention(),
+			MonthlyBackupRetention: ltr.MonthlyRetention.ltrRetention(),
+		}
+		if yearly := ltr.YearlyRetention; yearly != nil {
+			config.LTRConfig.YearlyBackupRetention = &gqlsla.AzureSQLYearlyLTRRetention{
+				Retention: gqlsla.AzureSQLLTRRetention{
+					Retention:     int(yearly.Retention.ValueInt64()),
+					RetentionUnit: gqlsla.RetentionUnit(yearly.RetentionUnit.ValueString()),
+				},
+				WeekOfYear: int(yearly.WeekOfYear.ValueInt64()),
+			}
+		}
+	}
+
+	return config
+}
+
+// hasLTRConfig reports whether the Azure SQL configuration carries a long-term
+// retention config, i.e. is a V1 (Azure-managed) SLA.
+func (m *slaAzureSQLConfigModel) hasLTRConfig() bool {
+	return m != nil && m.LTRConfig != nil
+}
+
+func (m *slaRetentionModel) ltrRetention() *gqlsla.AzureSQLLTRRetention {
+	if m == nil {
+		return nil
+	}
+
+	return &gqlsla.AzureSQLLTRRetention{
+		Retention:     int(m.Retention.ValueInt64()),
+		RetentionUnit: gqlsla.RetentionUnit(m.RetentionUnit.ValueString()),
+	}
+}
+
+// objectSpecificConfigs returns the object specific configurations of the SLA
+// domain, except for the AWS S3 and Azure Blob configurations.
+func (m slaDomainModel) objectSpecificConfigs() (gqlsla.ObjectSpecificConfigs, error) {
+	configs := gqlsla.ObjectSpecificConfigs{
+		AzureSQLDatabaseDBConfig:        m.AzureSQLDatabaseConfig.azureDBConfig(),
+		AzureSQLManagedInstanceDBConfig: m.AzureSQLManagedInstanceConfig.azureDBConfig(),
+	}
+	if c := m.AWSDynamoDBConfig; c != nil {
+		configs.AWSDynamoDBConfig = &gqlsla.AWSDynamoDBConfig{KMSAliasForPrimaryBackup: c.KMSAlias.ValueString()}
+	}
+	if c := m.AWSRDSConfig; c != nil {
+		configs.AWSRDSConfig = &gqlsla.AWSRDSConfig{LogRetention: *c.logRetention()}
+	}
+	if c := m.VMwareVMConfig; c != nil {
+		configs.VMwareVMConfig = &gqlsla.VMwareVMConfig{LogRetentionSeconds: c.LogRetention.ValueInt64()}
+	}
+	if c := m.SapHanaConfig; c != nil {
+		configs.SapHanaConfig = &gqlsla.SapHanaConfig{
+			IncrementalFrequency:  optionalRetentionDuration(c.IncrementalFrequency, c.IncrementalFrequencyUnit),
+			LogRetention:          optionalRetentionDuration(c.LogRetention, c.LogRetentionUnit),
+			DifferentialFrequency: optionalRetentionDuration(c.DifferentialFrequency, c.DifferentialFrequencyUnit),
+		}
+		if s := c.StorageSnapshotConfig; s != nil {
+			configs.SapHanaConfig.StorageSnapshotConfig = &gqlsla.SapHanaStorageSnapshotConfig{
+				Frequency: *retentionDuration(s.Frequency, s.FrequencyUnit),
+				Retention: *retentionDuration(s.Retention, s.RetentionUnit),
+			}
+		}
+	}
+	if c := m.DB2Config; c != nil {
+		configs.DB2Config = &gqlsla.DB2Config{
+			IncrementalFrequency:  optionalRetentionDuration(c.IncrementalFrequency, c.IncrementalFrequencyUnit),
+			LogRetention:          optionalRetentionDuration(c.LogRetention, c.LogRetentionUnit),
+			DifferentialFrequency: optionalRetentionDuration(c.DifferentialFrequency, c.DifferentialFrequencyUnit),
+			LogArchivalMethod:     gqlsla.Db2LogArchivalMethod(c.LogArchivalMethod.ValueString()),
+		}
+	}
+	if c := m.MSSQLConfig; c != nil {
+		configs.MssqlConfig = &gqlsla.MssqlConfig{
+			Frequency:    *retentionDuration(c.Frequency, c.FrequencyUnit),
+			LogRetention: *retentionDuration(c.LogRetention, c.LogRetentionUnit),
+		}
+	}
+	if c := m.OracleConfig; c != nil {
+		configs.OracleConfig = c.oracleConfig()
+	}
+	if c := m.MongoConfig; c != nil {
+		configs.MongoConfig = &gqlsla.MongoConfig{
+			LogFrequency: *retentionDuration(c.Frequency, c.FrequencyUnit),
+			LogRetention: *retentionDuration(c.Retention, c.RetentionUnit),
+		}
+	}
+	if c := m.ManagedVolumeConfig; c != nil {
+		configs.ManagedVolumeSlaConfig = &gqlsla.ManagedVolumeSlaConfig{LogRetention: *c.logRetention()}
+	}
+	if c := m.PostgresDBClusterConfig; c != nil {
+		configs.PostgresDbClusterSlaConfig = &gqlsla.PostgresDbClusterSlaConfig{LogRetention: *c.logRetention()}
+	}
+	if c := m.MySQLDBConfig; c != nil {
+		configs.MysqldbSlaConfig = &gqlsla.MysqldbSlaConfig{
+			LogFrequency: *retentionDuration(c.Frequency, c.FrequencyUnit),
+			LogRetention: *retentionDuration(c.Retention, c.RetentionUnit),
+		}
+	}
+	if c := m.InformixConfig; c != nil {
+		configs.InformixSlaConfig = &gqlsla.InformixSlaConfig{
+			IncrementalFrequency: optionalRetentionDuration(c.IncrementalFrequency, c.IncrementalFrequencyUnit),
+			IncrementalRetention: optionalRetentionDuration(c.IncrementalRetention, c.IncrementalRetentionUnit),
+			LogFrequency:         optionalRetentionDuration(c.Frequency, c.FrequencyUnit),
+			LogRetention:         optionalRetentionDuration(c.Retention, c.RetentionUnit),
+		}
+	}
+	if c := m.GCPCloudSQLConfig; c != nil {
+		configs.GcpCloudSqlConfig = &gqlsla.GcpCloudSqlConfig{LogRetention: *c.logRetention()}
+	}
+	if c := m.NCDConfig; c != nil {
+		var err error
+		configs.NcdSlaConfig = &gqlsla.NcdSlaConfig{}
+		if configs.NcdSlaConfig.MinutelyBackupLocations, err = uuidsFromList(c.MinutelyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.HourlyBackupLocations, err = uuidsFromList(c.HourlyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.DailyBackupLocations, err = uuidsFromList(c.DailyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.WeeklyBackupLocations, err = uuidsFromList(c.WeeklyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.MonthlyBackupLocations, err = uuidsFromList(c.MonthlyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.QuarterlyBackupLocations, err = uuidsFromList(c.QuarterlyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+		if configs.NcdSlaConfig.YearlyBackupLocations, err = uuidsFromList(c.YearlyBackupLocations); err != nil {
+			return gqlsla.ObjectSpecificConfigs{}, err
+		}
+	}
+
+	return configs, nil
+}
+
+// workloadConfigs returns the workload configurations of the SLA domain not
+// yet supported by the SDK.
+func (m slaDomainModel) workloadConfigs() (slaWorkloadConfigs, error) {
+	var configs slaWorkloadConfigs
+	if c := m.ExchangeConfig; c != nil {
+		configs.ExchangeConfig = &slaExchangeConfig{
+			LogFrequency: *retentionDuration(c.Frequency, c.FrequencyUnit),
+			LogRetention: *retentionDuration(c.Retention, c.RetentionUnit),
+		}
+	}
+	if c := m.KubernetesConfig; c != nil {
+		configs.K8sConfig = &slaK8sConfig{
+			IncludeClusterScopedResources: c.IncludeClusterScopedResources.ValueBool(),
+			IncludePVCs:                   c.IncludePersistentVolumeClaims.ValueBool(),
+		}
+	}
+	if c := m.Microsoft365Config; c != nil {
+		windows, err := backupWindows(c.BackupWindow)
+		if err != nil {
+			return slaWorkloadConfigs{}, err
+		}
+		configs.O365Config = &slaO365ConfigInput{
+			ArchivalTieringSpec: c.ArchivalTiering.tieringSpec(),
+			BackupWindows:       windows,
+		}
+	}
+	// The VMware vSphere VM configuration replaces the configuration of the
+	// SDK, it's only needed for the snapshot consistency.
+	if c := m.VMwareVMConfig; c != nil && !c.SnapshotConsistency.IsNull() {
+		configs.VMwareVMConfig = &slaVMwareVMConfig{
+			LogRetentionSeconds:        c.LogRetention.ValueInt64(),
+			SnapshotConsistencyMandate: c.SnapshotConsistency.ValueString(),
+		}
+	}
+
+	return configs, nil
+}
+
+// hasWorkloadConfigs returns true if the SLA domain has any of the workload
+// configurations not yet read by the SDK.
+func (m slaDomainModel) hasWorkloadConfigs() bool {
+	if m.VMwareVMConfig != nil && !m.VMwareVMConfig.SnapshotConsistency.IsNull() {
+		return true
+	}
+
+	return m.ExchangeConfig != nil || m.KubernetesConfig != nil || m.Microsoft365Config != nil
+}
+
+func (m slaLogRetentionModel) logRetention() *gqlsla.RetentionDuration {
+	return retentionDuration(m.LogRetention, m.LogRetentionUnit)
+}
+
+func (m slaOracleConfigModel) oracleConfig() *gqlsla.OracleConfig {
+	config := &gqlsla.OracleConfig{
+		Frequency:        *retentionDuration(m.Frequency, m.FrequencyUnit),
+		LogRetention:     *retentionDuration(m.LogRetention, m.LogRetentionUnit),
+		HostLogRetention: optionalRetentionDuration(m.HostLogRetention, m.HostLogRetentionUnit),
+	}
+	if m.RetainArchiveLogsIndefinitely.ValueBool() {
+		// -2/Minute is a CDM-specific sentinel meaning "retain all archived
+		// redo logs indefinitely".
+		config.HostLogRetention = gqlsla.RetentionDuration{Duration: -2, Unit: gqlsla.Minute}
+	}
+
+	return config
+}
+
+// localRetention returns the local retention of the SLA domain, or nil if
+// local retention isn't configured.
+func (m slaDomainModel) localRetention() *gqlsla.RetentionDuration {
+	return m.LocalRetention.retentionDuration()
+}
+
+// retentionLockMode returns the retention lock mode of the SLA domain, or the
+// empty string if retention lock isn't configured.
+func (m slaDomainModel) retentionLockMode() gqlsla.RetentionLockMode {
+	if m.RetentionLock == nil {
+		return ""
+	}
+
+	return gqlsla.RetentionLockMode(m.RetentionLock.Mode.ValueString())
+}
+
+// backupWindows returns the backup windows for the snapshot windows.
+func backupWindows(windows []slaSnapshotWindowModel) ([]gqlsla.BackupWindow, error) {
+	var backupWindows []gqlsla.BackupWindow
+	for _, window := range windows {
+		// Parse start time, e.g. "Mon, 15:30" or "16:45".
+		startAt := window.StartAt.ValueString()
+		var day gqlsla.Day
+		var timeParts []string
+		parts := strings.Split(startAt, ", ")
+		switch len(parts) {
+		case 1:
+			// No day of week specified.
+			timeParts = strings.Split(parts[0], ":")
+		case 2:
+			// Day of week specified.
+			switch strings.ToUpper(parts[0]) {
+			case "MON":
+				day = gqlsla.Monday
+			case "TUE":
+				day = gqlsla.Tuesday
+			case "WED":
+				day = gqlsla.Wednesday
+			case "THU":
+				day = gqlsla.Thursday
+			case "FRI":
+				day = gqlsla.Friday
+			case "SAT":
+				day = gqlsla.Saturday
+			case "SUN":
+				day = gqlsla.Sunday
+			default:
+				return nil, fmt.Errorf("invalid day of week for %s: %s", keyStartAt, startAt)
+			}
+			timeParts = strings.Split(parts[1], ":")
+		default:
+			return nil, fmt.Errorf("invalid format for %s: %s", keyStartAt, startAt)
+		}
+
+		if len(timeParts) != 2 {
+			return nil, fmt.Errorf("invalid time format for %s: %s", keyStartAt, startAt)
+		}
+		h, err := strconv.Atoi(timeParts[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse hour for %s: %s", keyStartAt, err)
+		}
+		m, err := strconv.Atoi(timeParts[1])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse minute for %s: %s", keyStartAt, err)
+		}
+
+		backupWindows = append(backupWindows, gqlsla.BackupWindow{
+			DurationInHours: int(window.Duration.ValueInt64()),
+			StartTime:       gqlsla.StartTime{DayOfWeek: gqlsla.DayOfWeek{Day: day}, Hour: h, Minute: m},
+		})
+	}
+
+	return backupWindows, nil
+}
+
+// slaDomainModelFromDomain returns the model of the SLA domain. The prior
+// model, the state or the plan, decides between null and empty values for
+// optional attributes, RSC doesn't distinguish between the two. This avoids
+// perpetual diffs for optional attributes which aren't configured.
+func slaDomainModelFromDomain(domain gqlsla.Domain, prior slaDomainModel) (slaDomainModel, error) {
+	var objectTypes []string
+	for _, objectType := range domain.ObjectTypes {
+		objectTypes = append(objectTypes, string(objectType))
+	}
+
+	model := slaDomainModel{
+		ID:                               types.StringValue(domain.ID.String()),
+		ApplyChangesToExistingSnapshots:  types.BoolNull(),
+		ApplyChangesToNonPolicySnapshots: types.BoolNull(),
+		BackupType:                       types.StringValue(string(domain.BackupType)),
+		CloneFromID:                      prior.CloneFromID,
+		Description:                      stringValueOrNull(prior.Description, domain.Description),
+		Name:                             types.StringValue(domain.Name),
+		ObjectTypes:                      setFromStrings(objectTypes),
+		// Local retention is only reported by RSC when configured.
+		LocalRetention: prior.LocalRetention,
+		// The pause state isn't part of the SLA domain, it's read separately.
+		Paused: prior.Paused,
+		// The workload configurations not read by the SDK are read
+		// separately.
+		ExchangeConfig:     prior.ExchangeConfig,
+		KubernetesConfig:   prior.KubernetesConfig,
+		Microsoft365Config: prior.Microsoft365Config,
+	}
+	if r := domain.LocalRetentionLimit; r != nil {
+		model.LocalRetention = &slaRetentionModel{
+			Retention:     types.Int64Value(int64(r.Duration)),
+			RetentionUnit: types.StringValue(string(r.Unit)),
+		}
+	}
+
+	schedule := domain.SnapshotSchedule
+	if s := schedule.Daily; s != nil {
+		model.DailySchedule = basicScheduleModel(s.BasicSchedule)
+	}
+	if s := schedule.Hourly; s != nil {
+		model.HourlySchedule = basicScheduleModel(s.BasicSchedule)
+	}
+	if s := schedule.Minute; s != nil {
+		model.MinuteSchedule = basicScheduleModel(s.BasicSchedule)
+	}
+	if s := schedule.Monthly; s != nil {
+		model.MonthlySchedule = &slaMonthlyScheduleModel{
+			DayOfMonth:    types.StringValue(string(s.DayOfMonth)),
+			Frequency:     types.Int64Value(int64(s.BasicSchedule.Frequency)),
+			Retention:     types.Int64Value(int64(s.BasicSchedule.Retention)),
+			RetentionUnit: types.StringValue(string(s.BasicSchedule.RetentionUnit)),
+		}
+	}
+	if s := schedule.Quarterly; s != nil {
+		model.QuarterlySchedule = &slaQuarterlyScheduleModel{
+			DayOfQuarter:      types.StringValue(string(s.DayOfQuarter)),
+			Frequency:         types.Int64Value(int64(s.BasicSchedule.Frequency)),
+			QuarterStartMonth: types.StringValue(string(s.QuarterStartMonth)),
+			Retention:         types.Int64Value(int64(s.BasicSchedule.Retention)),
+			RetentionUnit:     types.StringValue(string(s.BasicSchedule.RetentionUnit)),
+		}
+	}
+	if s := schedule.Weekly; s != nil {
+		priorDayOfWeek := types.StringNull()
+		if prior.WeeklySchedule != nil {
+			priorDayOfWeek = prior.WeeklySchedule.DayOfWeek
+		}
+		model.WeeklySchedule = &slaWeeklyScheduleModel{
+			DayOfWeek:     stringValueOrNull(priorDayOfWeek, string(s.DayOfWeek)),
+			Frequency:     types.Int64Value(int64(s.BasicSchedule.Frequency)),
+			Retention:     types.Int64Value(int64(s.BasicSchedule.Retention)),
+			RetentionUnit: types.StringValue(string(s.BasicSchedule.RetentionUnit)),
+		}
+	}
+	if s := schedule.Yearly; s != nil {
+		model.YearlySchedule = &slaYearlyScheduleModel{
+			DayOfYear:      types.StringValue(string(s.DayOfYear)),
+			Frequency:      types.Int64Value(int64(s.BasicSchedule.Frequency)),
+			Retention:      types.Int64Value(int64(s.BasicSchedule.Retention)),
+			RetentionUnit:  types.StringValue(string(s.BasicSchedule.RetentionUnit)),
+			YearStartMonth: types.StringValue(string(s.YearStartMonth)),
+		}
+	}
+
+	archival, err := archivalModels(domain, prior.Archival)
+	if err != nil {
+		return slaDomainModel{}, err
+	}
+	model.Archival = archival
+
+	backupLocations, err := backupLocationModels(domain, prior.BackupLocation)
+	if err != nil {
+		return slaDomainModel{}, err
+	}
+	model.BackupLocation = backupLocations
+
+	if model.SnapshotWindow, err = snapshotWindowModels(domain.BackupWindows, prior.SnapshotWindow); err != nil {
+		return slaDomainModel{}, err
+	}
+	if model.FirstFullSnapshot, err = snapshotWindowModels(domain.FirstFullBackupWindows, prior.FirstFullSnapshot); err != nil {
+		return slaDomainModel{}, err
+	}
+
+	model.ReplicationSpec = replicationSpecModels(domain, prior.ReplicationSpec)
+	model.RetentionLock = retentionLockModel(domain, prior.RetentionLock)
+	lockMode := domainRetentionLockMode(domain)
+	model.RetentionLockMode = types.StringValue(string(lockMode))
+	model.LockedAt = retentionLockedAt(lockMode, prior.LockedAt, time.Now())
+	objectSpecificConfigModels(domain, prior, &model)
+
+	return model, nil
+}
+
+func basicScheduleModel(schedule gqlsla.BasicSnapshotSchedule) *slaBasicScheduleModel {
+	return &slaBasicScheduleModel{
+		Frequency:     types.Int64Value(int64(schedule.Frequency)),
+		Retention:     types.Int64Value(int64(schedule.Retention)),
+		RetentionUnit: types.StringValue(string(schedule.RetentionUnit)),
+	}
+}
+
+// archivalModels returns the archival models of the SLA domain. The order of
+// the prior archival locations is preserved and new archival locations are
+// added to the end. Frequencies are only persisted when explicitly configured,
+// since they are otherwise derived from the snapshot schedule.
+func archivalModels(domain gqlsla.Domain, prior []slaArchivalModel) ([]slaArchivalModel, error) {
+	priorByID := make(map[string]slaArchivalModel)
+	for _, archival := range prior {
+		priorByID[archival.ArchivalLocationID.ValueString()] = archival
+	}
+
+	models := make(map[string]slaArchivalModel)
+	for _, spec := range domain.ArchivalSpecs {
+		id := spec.StorageSetting.ID
+		if _, ok := models[id]; ok {
+			return nil, fmt.Errorf("archival location %q used multiple times", id)
+		}
+
+		priorArchival, ok := priorByID[id]
+		if !ok {
+			priorArchival.Frequency = types.SetNull(types.StringType)
+		}
+
+		var mappings []slaArchivalClusterMappingModel
+		for _, mapping := range spec.ArchivalLocationToClusterMapping {
+			mappings = append(mappings, slaArchivalClusterMappingModel{
+				ClusterID:          types.StringValue(mapping.Cluster.ID),
+				ArchivalLocationID: types.StringValue(mapping.Location.ID),
+				ClusterName:        types.StringValue(mapping.Cluster.Name),
+				Name:               types.StringValue(mapping.Location.Name),
+			})
+		}
+
+		frequency := types.SetNull(types.StringType)
+		if !priorArchival.Frequency.IsNull() {
+			var frequencies []string
+			for _, freq := range spec.Frequencies {
+				frequencies = append(frequencies, string(freq))
+			}
+			frequency = setFromStrings(frequencies)
+		}
+
+		archival := slaArchivalModel{
+			ArchivalLocationID:               types.StringValue(id),
+			Threshold:                        types.Int64Value(int64(spec.Threshold)),
+			ThresholdUnit:                    types.StringValue(string(spec.ThresholdUnit)),
+			ArchivalLocationToClusterMapping: listOrNull(priorArchival.ArchivalLocationToClusterMapping, mappings),
+			Frequency:                        frequency,
+		}
+		if t := spec.ArchivalTieringSpec; t != nil {
+			archival.ArchivalTiering = archivalTieringModel(priorArchival.ArchivalTiering, t.InstantTiering,
+				t.MinAccessibleDurationInSeconds, t.ColdStorageClass, t.TierExistingSnapshots)
+		}
+		models[id] = archival
+	}
+
+	var archival []slaArchivalModel
+	for _, p := range prior {
+		id := p.ArchivalLocationID.ValueString()
+		if model, ok := models[id]; ok {
+			archival = append(archival, model)
+			delete(models, id)
+		}
+	}
+	for _, spec := range domain.ArchivalSpecs {
+		if model, ok := models[spec.StorageSetting.ID]; ok {
+			archival = append(archival, model)
+		}
+	}
+
+	return listOrNull(prior, archival), nil
+}
+
+func archivalTieringModel(prior *slaArchivalTieringModel, instantTiering bool, minAccessibleDuration int64,
+	coldStorageClass gqlsla.ColdStorageClass, tierExistingSnapshots bool) *slaArchivalTieringModel {
+	if prior == nil {
+		prior = &slaArchivalTieringModel{
+			InstantTiering:                 types.BoolNull(),
+			MinAccessibleDurationInSeconds: types.Int64Null(),
+			ColdStorageClass:               types.StringNull(),
+			TierExistingSnapshots:          types.BoolNull(),
+		}
+	}
+
+	return &slaArchivalTieringModel{
+		InstantTiering:                 boolValueOrNull(prior.InstantTiering, instantTiering),
+		MinAccessibleDurationInSeconds: int64ValueOrNull(prior.MinAccessibleDurationInSeconds, minAccessibleDuration),
+		ColdStorageClass:               stringValueOrNull(prior.ColdStorageClass, string(coldStorageClass)),
+		TierExistingSnapshots:          boolValueOrNull(prior.TierExistingSnapshots, tierExistingSnapshots),
+	}
+}
+
+// backupLocationModels returns the backup location models of the SLA domain,
+// preserving the order of the prior backup locations. AWS S3 SLA domains use
+// the object specific configuration when multiple backup locations are not
+// enabled for the account.
+func backupLocationModels(domain gqlsla.Domain, prior []slaBackupLocationModel) ([]slaBackupLocationModel, error) {
+	ids := make(map[string]bool)
+	for _, spec := range domain.BackupLocationSpecs {
+		id := spec.ArchivalGroup.ID
+		if ids[id] {
+			return nil, fmt.Errorf("archival location %q used multiple times", id)
+		}
+		ids[id] = true
+	}
+
+	var locations []slaBackupLocationModel
+	for _, p := range prior {
+		if id := p.ArchivalGroupID.ValueString(); ids[id] {
+			locations = append(locations, slaBackupLocationModel{ArchivalGroupID: types.StringValue(id)})
+			delete(ids, id)
+		}
+	}
+	for _, spec := range domain.BackupLocationSpecs {
+		if id := spec.ArchivalGroup.ID; ids[id] {
+			locations = append(locations, slaBackupLocationModel{ArchivalGroupID: types.StringValue(id)})
+		}
+	}
+
+	if len(locations) == 0 && domain.ObjectSpecificConfigs.AWSS3Config != nil {
+		locations = append(locations, slaBackupLocationModel{
+			ArchivalGroupID: types.StringValue(domain.ObjectSpecificConfigs.AWSS3Config.ArchivalLocationID.String()),
+		})
+	}
+
+	return listOrNull(prior, locations), nil
+}
+
+func snapshotWindowModels(backupWindows []gqlsla.BackupWindow, prior []slaSnapshotWindowModel) ([]slaSnapshotWindowModel, error) {
+	var windows []slaSnapshotWindowModel
+	for _, backupWindow := range backupWindows {
+		startAt := fmt.Sprintf("%02d:%02d", backupWindow.StartTime.Hour, backupWindow.StartTime.Minute)
+		if day := backupWindow.StartTime.DayOfWeek.Day; day != "" {
+			wd, err := day.ToWeekday()
+			if err != nil {
+				return nil, err
+			}
+			startAt = wd.String()[:3] + ", " + startAt
+		}
+		windows = append(windows, slaSnapshotWindowModel{
+			Duration: types.Int64Value(int64(backupWindow.DurationInHours)),
+			StartAt:  types.StringValue(startAt),
+		})
+	}
+
+	return listOrNull(prior, windows), nil
+}
+
+// replicationSpecModels returns the replication specification models of the
+// SLA domain. The prior replication specifications are matched by position.
+func replicationSpecModels(domain gqlsla.Domain, prior []slaReplicationSpecModel) []slaReplicationSpecModel {
+	var specs []slaReplicationSpecModel
+	for i, spec := range domain.ReplicationSpecs {
+		priorSpec := slaReplicationSpecModel{
+			AWSRegion:       types.StringNull(),
+			AWSCrossAccount: types.StringNull(),
+			AzureRegion:     types.StringNull(),
+		}
+		if i < len(prior) {
+			priorSpec = prior[i]
+		}
+
+		var pairs []slaReplicationPairModel
+		for _, pair := range spec.ReplicationPairs {
+			pairs = append(pairs, slaReplicationPairModel{
+				SourceCluster: types.StringValue(pair.SourceCluster.ID),
+				TargetCluster: types.StringValue(pair.TargetCluster.ID),
+			})
+		}
+
+		var cascading []slaCascadingArchivalModel
+		for j, spec := range spec.CascadingArchivalSpecs {
+			priorArchival := slaCascadingArchivalModel{
+				ArchivalThreshold:     types.Int64Null(),
+				ArchivalThresholdUnit: types.StringNull(),
+				Frequency:             types.SetNull(types.StringType),
+			}
+			if j < len(priorSpec.CascadingArchival) {
+				priorArchival = priorSpec.CascadingArchival[j]
+			}
+
+			// The archival location is the same for all target clusters.
+			archival := slaCascadingArchivalModel{
+				ArchivalLocationID:    types.StringValue(""),
+				ArchivalThreshold:     int64ValueOrNull(priorArchival.ArchivalThreshold, 0),
+				ArchivalThresholdUnit: stringValueOrNull(priorArchival.ArchivalThresholdUnit, ""),
+			}
+			if len(spec.ArchivalLocationToClusterMapping) > 0 {
+				archival.ArchivalLocationID = types.StringValue(spec.ArchivalLocationToClusterMapping[0].Location.ID)
+			} else if spec.ArchivalLocation != nil {
+				archival.ArchivalLocationID = types.StringValue(spec.ArchivalLocation.ID)
+			}
+			if t := spec.ArchivalThreshold; t != nil {
+				archival.ArchivalThreshold = types.Int64Value(int64(t.Duration))
+				archival.ArchivalThresholdUnit = types.StringValue(string(t.Unit))
+			}
+			if t := spec.ArchivalTieringSpec; t != nil {
+				archival.ArchivalTiering = archivalTieringModel(priorArchival.ArchivalTiering, t.InstantTiering,
+					t.MinAccessibleDurationInSeconds, t.ColdStorageClass, t.TierExistingSnapshots)
+			}
+			var frequencies []string
+			for _, freq := range spec.Frequencies {
+				frequencies = append(frequencies, string(freq))
+			}
+			archival.Frequency = setValueOrNull(priorArchival.Frequency, frequencies)
+			cascading = append(cascading, archival)
+		}
+
+		model := slaReplicationSpecModel{
+			AWSRegion:         stringValueOrNull(priorSpec.AWSRegion, spec.AWSRegion.Name()),
+			AWSCrossAccount:   stringValueOrNull(priorSpec.AWSCrossAccount, spec.AWS.AccountID),
+			AzureRegion:       stringValueOrNull(priorSpec.AzureRegion, spec.AzureRegion.Name()),
+			ReplicationPair:   listOrNull(priorSpec.ReplicationPair, pairs),
+			Retention:         types.Int64Value(int64(spec.RetentionDuration.Duration)),
+			RetentionUnit:     types.StringValue(string(spec.RetentionDuration.Unit)),
+			CascadingArchival: listOrNull(priorSpec.CascadingArchival, cascading),
+		}
+		if r := spec.ReplicationLocalRetentionDuration; r != nil {
+			model.LocalRetention = &slaRetentionModel{
+				Retention:     types.Int64Value(int64(r.Duration)),
+				RetentionUnit: types.StringValue(string(r.Unit)),
+			}
+		}
+		specs = append(specs, model)
+	}
+
+	return listOrNull(prior, specs)
+}
+
+// retentionLockModel returns the retention lock model of the SLA domain. The
+// compliance mode acknowledgment is required to be true for the COMPLIANCE
+// mode.
+func retentionLockModel(domain gqlsla.Domain, prior *slaRetentionLockModel) *slaRetentionLockModel {
+	if !domain.RetentionLock {
+		return nil
+	}
+
+	mode := domain.RetentionLockMode
+	if mode != gqlsla.Compliance && mode != gqlsla.Protection {
+		mode = gqlsla.NoLock
+	}
+	priorAcknowledgment := types.BoolNull()
+	if prior != nil {
+		priorAcknowledgment = prior.ComplianceModeAcknowledgment
+	}
+
+	return &slaRetentionLockModel{
+		Mode:                         types.StringValue(string(mode)),
+		ComplianceModeAcknowledgment: boolValueOrNull(priorAcknowledgment, mode == gqlsla.Compliance),
+	}
+}
+
+// domainRetentionLockMode returns the retention lock mode of the SLA domain,
+// NO_LOCK if the SLA domain isn't retention locked.
+func domainRetentionLockMode(domain gqlsla.Domain) gqlsla.RetentionLockMode {
+	if !domain.RetentionLock {
+		return gqlsla.NoLock
+	}
+	switch domain.RetentionLockMode {
+	case gqlsla.Compliance, gqlsla.Protection:
+		return domain.RetentionLockMode
+	default:
+		return gqlsla.NoLock
+	}
+}
+
+// retentionLockedAt returns when the SLA domain was retention locked. RSC
+// doesn't report when an SLA domain was locked, so the time the lock is first
+// read is kept.
+func retentionLockedAt(mode gqlsla.RetentionLockMode, prior types.String, now time.Time) types.String {
+	if mode == gqlsla.NoLock {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		return prior
+	}
+
+	return types.StringValue(now.UTC().Format(time.RFC3339))
+}
+
+// objectSpecificConfigModels sets the object specific configuration models of
+// the SLA domain.
+func objectSpecificConfigModels(domain gqlsla.Domain, prior slaDomainModel, model *slaDomainModel) {
+	configs := domain.ObjectSpecificConfigs
+	if c := configs.AWSDynamoDBConfig; c != nil {
+		priorKMSAlias := types.StringNull()
+		if prior.AWSDynamoDBConfig != nil {
+			priorKMSAlias = prior.AWSDynamoDBConfig.KMSAlias
+		}
+		model.AWSDynamoDBConfig = &slaAWSDynamoDBConfigModel{
+			KMSAlias: stringValueOrNull(priorKMSAlias, c.KMSAliasForPrimaryBackup),
+		}
+	}
+	if c := configs.AWSRDSConfig; c != nil {
+		model.AWSRDSConfig = logRetentionModel(c.LogRetention)
+	}
+	if c := configs.AzureBlobConfig; c != nil {
+		model.AzureBlobConfig = &slaAzureBlobConfigModel{
+			ArchivalLocationID: types.StringValue(c.BackupLocationID.String()),
+		}
+	}
+	model.AzureSQLDatabaseConfig = azureSQLConfigModel(configs.AzureSQLDatabaseDBConfig)
+	model.AzureSQLManagedInstanceConfig = azureSQLConfigModel(configs.AzureSQLManagedInstanceDBConfig)
+	if c := configs.VMwareVMConfig; c != nil {
+		p := slaVMwareVMConfigModel{LogRetention: types.Int64Value(0), SnapshotConsistency: types.StringNull()}
+		if prior.VMwareVMConfig != nil {
+			p = *prior.VMwareVMConfig
+		}
+		model.VMwareVMConfig = &slaVMwareVMConfigModel{
+			LogRetention:        int64ValueOrNull(p.LogRetention, c.LogRetentionSeconds),
+			SnapshotConsistency: p.SnapshotConsistency,
+		}
+	}
+	if c := configs.SapHanaConfig; c != nil {
+		var p slaSapHanaConfigModel
+		if prior.SapHanaConfig != nil {
+			p = *prior.SapHanaConfig
+		}
+		model.SapHanaConfig = &slaSapHanaConfigModel{}
+		model.SapHanaConfig.IncrementalFrequency, model.SapHanaConfig.IncrementalFrequencyUnit =
+			optionalDurationModel(p.IncrementalFrequency, c.IncrementalFrequency)
+		model.SapHanaConfig.LogRetention, model.SapHanaConfig.LogRetentionUnit =
+			optionalDurationModel(p.LogRetention, c.LogRetention)
+		model.SapHanaConfig.DifferentialFrequency, model.SapHanaConfig.DifferentialFrequencyUnit =
+			optionalDurationModel(p.DifferentialFrequency, c.DifferentialFrequency)
+		if s := c.StorageSnapshotConfig; s != nil && (s.Frequency.Duration > 0 || s.Retention.Duration > 0) {
+			model.SapHanaConfig.StorageSnapshotConfig = frequencyRetentionModel(s.Frequency, s.Retention)
+		}
+	}
+	if c := configs.DB2Config; c != nil {
+		var p slaDB2ConfigModel
+		if prior.DB2Config != nil {
+			p = *prior.DB2Config
+		}
+		model.DB2Config = &slaDB2ConfigModel{LogArchivalMethod: types.StringValue(string(gqlsla.Db2LogArchivalMethod1))}
+		model.DB2Config.IncrementalFrequency, model.DB2Config.IncrementalFrequencyUnit =
+			optionalDurationModel(p.IncrementalFrequency, c.IncrementalFrequency)
+		model.DB2Config.LogRetention, model.DB2Config.LogRetentionUnit =
+			optionalDurationModel(p.LogRetention, c.LogRetention)
+		model.DB2Config.DifferentialFrequency, model.DB2Config.DifferentialFrequencyUnit =
+			optionalDurationModel(p.DifferentialFrequency, c.DifferentialFrequency)
+		if c.LogArchivalMethod != "" {
+			model.DB2Config.LogArchivalMethod = types.StringValue(string(c.LogArchivalMethod))
+		}
+	}
+	if c := configs.MssqlConfig; c != nil {
+		model.MSSQLConfig = &slaMSSQLConfigModel{
+			Frequency:        types.Int64Value(int64(c.Frequency.Duration)),
+			FrequencyUnit:    types.StringValue(string(c.Frequency.Unit)),
+			LogRetention:     types.Int64Value(int64(c.LogRetention.Duration)),
+			LogRetentionUnit: types.StringValue(string(c.LogRetention.Unit)),
+		}
+	}
+	if c := configs.OracleConfig; c != nil {
+		var p slaOracleConfigModel
+		if prior.OracleConfig != nil {
+			p = *prior.OracleConfig
+		}
+		model.OracleConfig = oracleConfigModel(c, p)
+	}
+	if c := configs.MongoConfig; c != nil {
+		model.MongoConfig = frequencyRetentionModel(c.LogFrequency, c.LogRetention)
+	}
+	if c := configs.ManagedVolumeSlaConfig; c != nil {
+		model.ManagedVolumeConfig = logRetentionModel(c.LogRetention)
+	}
+	if c := configs.PostgresDbClusterSlaConfig; c != nil {
+		model.PostgresDBClusterConfig = logRetentionModel(c.LogRetention)
+	}
+	if c := configs.MysqldbSlaConfig; c != nil {
+		model.MySQLDBConfig = frequencyRetentionModel(c.LogFrequency, c.LogRetention)
+	}
+	if c := configs.InformixSlaConfig; c != nil {
+		var p slaInformixConfigModel
+		if prior.InformixConfig != nil {
+			p = *prior.InformixConfig
+		}
+		model.InformixConfig = &slaInformixConfigModel{}
+		model.InformixConfig.IncrementalFrequency, model.InformixConfig.IncrementalFrequencyUnit =
+			optionalDurationModel(p.IncrementalFrequency, c.IncrementalFrequency)
+		model.InformixConfig.IncrementalRetention, model.InformixConfig.IncrementalRetentionUnit =
+			optionalDurationModel(p.IncrementalRetention, c.IncrementalRetention)
+		model.InformixConfig.Frequency, model.InformixConfig.FrequencyUnit =
+			optionalDurationModel(p.Frequency, c.LogFrequency)
+		model.InformixConfig.Retention, model.InformixConfig.RetentionUnit =
+			optionalDurationModel(p.Retention, c.LogRetention)
+	}
+	if c := configs.GcpCloudSqlConfig; c != nil {
+		model.GCPCloudSQLConfig = logRetentionModel(c.LogRetention)
+	}
+	if c := configs.NcdSlaConfig; c != nil {
+		p := slaNCDConfigModel{
+			MinutelyBackupLocations:  types.ListNull(types.StringType),
+			HourlyBackupLocations:    types.ListNull(types.StringType),
+			DailyBackupLocations:     types.ListNull(types.StringType),
+			WeeklyBackupLocations:    types.ListNull(types.StringType),
+			MonthlyBackupLocations:   types.ListNull(types.StringType),
+			QuarterlyBackupLocations: types.ListNull(types.StringType),
+			YearlyBackupLocations:    types.ListNull(types.StringType),
+		}
+		if prior.NCDConfig != nil {
+			p = *prior.NCDConfig
+		}
+		model.NCDConfig = &slaNCDConfigModel{
+			MinutelyBackupLocations:  listFromUUIDs(p.MinutelyBackupLocations, c.MinutelyBackupLocations),
+			HourlyBackupLocations:    listFromUUIDs(p.HourlyBackupLocations, c.HourlyBackupLocations),
+			DailyBackupLocations:     listFromUUIDs(p.DailyBackupLocations, c.DailyBackupLocations),
+			WeeklyBackupLocations:    listFromUUIDs(p.WeeklyBackupLocations, c.WeeklyBackupLocations),
+			MonthlyBackupLocations:   listFromUUIDs(p.MonthlyBackupLocations, c.MonthlyBackupLocations),
+			QuarterlyBackupLocations: listFromUUIDs(p.QuarterlyBackupLocations, c.QuarterlyBackupLocations),
+			YearlyBackupLocations:    listFromUUIDs(p.YearlyBackupLocations, c.YearlyBackupLocations),
+		}
+	}
+}
+
+// workloadConfigModels sets the workload configurations not yet read by the
+// SDK on the model. Configurations with only empty values are null unless the
+// prior configuration is set.
+func workloadConfigModels(configs slaWorkloadConfigsReply, prior slaDomainModel, model *slaDomainModel) error {
+	model.ExchangeConfig = nil
+	if c := configs.ExchangeConfig; c != nil && (c.LogFrequency.Duration > 0 || c.LogRetention.Duration > 0) {
+		model.ExchangeConfig = frequencyRetentionModel(c.LogFrequency, c.LogRetention)
+	}
+
+	model.KubernetesConfig = nil
+	if c := configs.K8sConfig; c != nil &&
+		(prior.KubernetesConfig != nil || c.IncludeClusterScopedResources || c.IncludePVCs) {
+		p := slaKubernetesConfigModel{
+			IncludeClusterScopedResources: types.BoolNull(),
+			IncludePersistentVolumeClaims: types.BoolNull(),
+		}
+		if prior.KubernetesConfig != nil {
+			p = *prior.KubernetesConfig
+		}
+		model.KubernetesConfig = &slaKubernetesConfigModel{
+			IncludeClusterScopedResources: boolValueOrNull(p.IncludeClusterScopedResources,
+				c.IncludeClusterScopedResources),
+			IncludePersistentVolumeClaims: boolValueOrNull(p.IncludePersistentVolumeClaims, c.IncludePVCs),
+		}
+	}
+
+	model.Microsoft365Config = nil
+	if c := configs.O365Config; c != nil {
+		var p slaMicrosoft365ConfigModel
+		if prior.Microsoft365Config != nil {
+			p = *prior.Microsoft365Config
+		}
+		var tiering *slaArchivalTieringModel
+		if s := c.ArchivalTieringSpec; s != nil && (p.ArchivalTiering != nil || *s != (gqlsla.ArchivalTieringSpec{})) {
+			tiering = archivalTieringModel(p.ArchivalTiering, s.InstantTiering, s.MinAccessibleDurationInSeconds,
+				s.ColdStorageClass, s.TierExistingSnapshots)
+		}
+		windows, err := snapshotWindowModels(c.BackupWindows, p.BackupWindow)
+		if err != nil {
+			return err
+		}
+		if prior.Microsoft365Config != nil || tiering != nil || len(windows) > 0 {
+			model.Microsoft365Config = &slaMicrosoft365ConfigModel{ArchivalTiering: tiering, BackupWindow: windows}
+		}
+	}
+
+	if c := configs.VMwareVMConfig; c != nil {
+		p := slaVMwareVMConfigModel{LogRetention: types.Int64Null(), SnapshotConsistency: types.StringNull()}
+		if prior.VMwareVMConfig != nil {
+			p = *prior.VMwareVMConfig
+		}
+		switch {
+		case model.VMwareVMConfig != nil:
+			model.VMwareVMConfig.SnapshotConsistency = stringValueOrNull(p.SnapshotConsistency,
+				c.SnapshotConsistencyMandate)
+		case c.SnapshotConsistencyMandate != "":
+			model.VMwareVMConfig = &slaVMwareVMConfigModel{
+				LogRetention:        int64ValueOrNull(p.LogRetention, c.LogRetentionSeconds),
+				SnapshotConsistency: types.StringValue(c.SnapshotConsistencyMandate),
+			}
+		}
+	}
+
+	return nil
+}
+
+func logRetentionModel(logRetention gqlsla.RetentionDuration) *slaLogRetentionModel {
+	return &slaLogRetentionModel{
+		LogRetention:     types.Int64Value(int64(logRetention.Duration)),
+		LogRetentionUnit: types.StringValue(string(logRetention.Unit)),
+	}
+}
+
+func frequencyRetentionModel(frequency, retention gqlsla.RetentionDuration) *slaFrequencyRetentionModel {
+	return &slaFrequencyRetentionModel{
+		Frequency:     types.Int64Value(int64(frequency.Duration)),
+		FrequencyUnit: types.StringValue(string(frequency.Unit)),
+		Retention:     types.Int64Value(int64(retention.Duration)),
+		RetentionUnit: types.StringValue(string(retention.Unit)),
+	}
+}
+
+// optionalDurationModel returns the duration and unit of an optional duration.
+// The unit defaults to DAYS when the duration isn't set, matching the schema
+// default.
+func optionalDurationModel(prior types.Int64, duration gqlsla.RetentionDuration) (types.Int64, types.String) {
+	if duration.Duration <= 0 {
+		return int64ValueOrNull(prior, 0), types.StringValue(string(gqlsla.Days))
+	}
+
+	return types.Int64Value(int64(duration.Duration)), types.StringValue(string(duration.Unit))
+}
+
+func azureSQLConfigModel(config *gqlsla.AzureDBConfig) *slaAzureSQLConfigModel {
+	if config == nil {
+		return nil
+	}
+
+	model := &slaAzureSQLConfigModel{LogRetention: types.Int64Value(int64(config.LogRetentionInDays))}
+	if ltr := config.LTRConfig; ltr != nil {
+		model.LTRConfig = &slaLTRConfigModel{
+			WeeklyRetention:  ltrRetentionModel(ltr.WeeklyBackupRetention),
+			MonthlyRetention: ltrRetentionModel(ltr.MonthlyBackupRetention),
+		}
+		if yearly := ltr.YearlyBackupRetention; yearly != nil {
+			model.LTRConfig.YearlyRetention = &slaLTRYearlyRetentionModel{
+				Retention:     types.Int64Value(int64(yearly.Retention.Retention)),
+				RetentionUnit: types.StringValue(string(yearly.Retention.RetentionUnit)),
+				WeekOfYear:    types.Int64Value(int64(yearly.WeekOfYear)),
+			}
+		}
+	}
+
+	return model
+}
+
+func ltrRetentionModel(retention *gqlsla.AzureSQLLTRRetention) *slaRetentionModel {
+	if retention == nil {
+		return nil
+	}
+
+	return &slaRetentionModel{
+		Retention:     types.Int64Value(int64(retention.Retention)),
+		RetentionUnit: types.StringValue(string(retention.RetentionUnit)),
+	}
+}
+
+func oracleConfigModel(config *gqlsla.OracleConfig, prior slaOracleConfigModel) *slaOracleConfigModel {
+	model := &slaOracleConfigModel{
+		Frequency:                     types.Int64Value(int64(config.Frequency.Duration)),
+		FrequencyUnit:                 types.StringValue(string(config.Frequency.Unit)),
+		LogRetention:                  types.Int64Value(int64(config.LogRetention.Duration)),
+		LogRetentionUnit:              types.StringValue(string(config.LogRetention.Unit)),
+		HostLogRetention:              int64ValueOrNull(prior.HostLogRetention, 0),
+		HostLogRetentionUnit:          types.StringValue(string(gqlsla.Days)),
+		RetainArchiveLogsIndefinitely: boolValueOrNull(prior.RetainArchiveLogsIndefinitely, false),
+	}
+
+	switch config.HostLogRetention.Duration {
+	case -2:
+		// -2 is a CDM-specific sentinel meaning "retain all archived redo logs
+		// indefinitely".
+		model.RetainArchiveLogsIndefinitely = types.BoolValue(true)
+	case 0:
+		// Not set.
+	default:
+		model.HostLogRetention = types.Int64Value(int64(config.HostLogRetention.Duration))
+		model.HostLogRetentionUnit = types.StringValue(string(config.HostLogRetention.Unit))
+	}
+
+	return model
+}
+
+// listOrNull returns the list, or an empty list if the list is empty and the
+// prior list is known to be empty. A nil slice is a null value.
+func listOrNull[T any](prior, list []T) []T {
+	if len(list) == 0 && prior != nil {
+		return []T{}
+	}
+
+	return list
+}
+
+// stringValueOrNull returns the string value, or null if the value is empty and
+// the prior value is null.
+func stringValueOrNull(prior types.String, value string) types.String {
+	if value == "" && prior.IsNull() {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// int64ValueOrNull returns the int64 value, or null if the value is zero and
+// the prior value is null.
+func int64ValueOrNull(prior types.Int64, value int64) types.Int64 {
+	if value == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(value)
+}
+
+// boolValueOrNull returns the bool value, or null if the value is false and the
+// prior value is null.
+func boolValueOrNull(prior types.Bool, value bool) types.Bool {
+	if !value && prior.IsNull() {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(value)
+}
+
+// setValueOrNull returns a set of the values, or null if there are no values
+// and the prior set is null.
+func setValueOrNull(prior types.Set, values []string) types.Set {
+	if len(values) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	return setFromStrings(values)
+}
+
+func setFromStrings(values []string) types.Set {
+	elems := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elems = append(elems, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elems)
+}
+
+func stringsFromSet(set types.Set) []string {
+	var values []string
+	for _, elem := range set.Elements() {
+		if value, ok := elem.(types.String); ok {
+			values = append(values, value.ValueString())
+		}
+	}
+
+	return values
+}
+
+func retentionUnitsFromSet(set types.Set) []gqlsla.RetentionUnit {
+	var units []gqlsla.RetentionUnit
+	for _, value := range stringsFromSet(set) {
+		units = append(units, gqlsla.RetentionUnit(value))
+	}
+
+	return units
+}
+
+func listFromUUIDs(prior types.List, ids []uuid.UUID) types.List {
+	if len(ids) == 0 && prior.IsNull() {
+		return types.ListNull(types.StringType)
+	}
+
+	elems := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elems = append(elems, types.StringValue(id.String()))
+	}
+
+	return types.ListValueMust(types.StringType, elems)
+}
+
+func uuidsFromList(list types.List) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, elem := range list.Elements() {
+		value, ok := elem.(types.String)
+		if !ok {
+			continue
+		}
+		id, err := uuid.Parse(value.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse backup location ID: %s", err)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
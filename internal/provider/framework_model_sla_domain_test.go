@@ -0,0 +1,206 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+// TestOracleConfigRetainArchiveLogsIndefinitely verifies that setting
+// retain_archive_logs_indefinitely maps to the CDM sentinel of
+// HostLogRetention{Duration: -2, Unit: Minute}.
+func TestOracleConfigRetainArchiveLogsIndefinitely(t *testing.T) {
+	model := slaOracleConfigModel{
+		Frequency:                     types.Int64Value(1),
+		FrequencyUnit:                 types.StringValue(string(gqlsla.Days)),
+		LogRetention:                  types.Int64Value(7),
+		LogRetentionUnit:              types.StringValue(string(gqlsla.Days)),
+		HostLogRetention:              types.Int64Null(),
+		HostLogRetentionUnit:          types.StringValue(string(gqlsla.Days)),
+		RetainArchiveLogsIndefinitely: types.BoolValue(true),
+	}
+
+	oracleConfig := model.oracleConfig()
+	if oracleConfig == nil {
+		t.Fatal("expected non-nil oracle config")
+	}
+	if got, want := oracleConfig.HostLogRetention.Duration, -2; got != want {
+		t.Errorf("HostLogRetention.Duration = %d, want %d", got, want)
+	}
+	if got, want := oracleConfig.HostLogRetention.Unit, gqlsla.Minute; got != want {
+		t.Errorf("HostLogRetention.Unit = %q, want %q", got, want)
+	}
+}
+
+// TestOracleConfigModelRetainArchiveLogsIndefinitely verifies that the CDM
+// sentinel of HostLogRetention{Duration: -2} maps back to
+// retain_archive_logs_indefinitely=true with a null host_log_retention.
+func TestOracleConfigModelRetainArchiveLogsIndefinitely(t *testing.T) {
+	model := oracleConfigModel(&gqlsla.OracleConfig{
+		HostLogRetention: gqlsla.RetentionDuration{
+			Duration: -2,
+			Unit:     gqlsla.Minute,
+		},
+	}, slaOracleConfigModel{})
+
+	if got, want := model.RetainArchiveLogsIndefinitely, types.BoolValue(true); !got.Equal(want) {
+		t.Errorf("%s = %v, want %v", keyRetainArchiveLogsIndefinitely, got, want)
+	}
+	if !model.HostLogRetention.IsNull() {
+		t.Errorf("expected %s to be null, got %v", keyHostLogRetention, model.HostLogRetention)
+	}
+}
+
+// TestListOrNull verifies that an empty list read from RSC is null unless the
+// prior value is an empty list, so that optional lists don't cause perpetual
+// diffs.
+func TestListOrNull(t *testing.T) {
+	if got := listOrNull[int](nil, nil); got != nil {
+		t.Errorf("listOrNull(nil, nil) = %v, want nil", got)
+	}
+	if got := listOrNull(nil, []int{}); len(got) != 0 {
+		t.Errorf("listOrNull(nil, []) = %v, want empty", got)
+	}
+	if got := listOrNull([]int{}, nil); got == nil || len(got) != 0 {
+		t.Errorf("listOrNull([], nil) = %v, want empty non-nil", got)
+	}
+	if got := listOrNull(nil, []int{1}); len(got) != 1 {
+		t.Errorf("listOrNull(nil, [1]) = %v, want [1]", got)
+	}
+}
+
+// TestSLADomainWorkloadConfigsRoundTrip verifies that the workload
+// configurations sent to RSC read back to the same model.
+func TestSLADomainWorkloadConfigsRoundTrip(t *testing.T) {
+	plan := slaDomainModel{
+		ExchangeConfig: &slaFrequencyRetentionModel{
+			Frequency:     types.Int64Value(30),
+			FrequencyUnit: types.StringValue(string(gqlsla.Minute)),
+			Retention:     types.Int64Value(7),
+			RetentionUnit: types.StringValue(string(gqlsla.Days)),
+		},
+		KubernetesConfig: &slaKubernetesConfigModel{
+			IncludeClusterScopedResources: types.BoolValue(true),
+			IncludePersistentVolumeClaims: types.BoolNull(),
+		},
+		Microsoft365Config: &slaMicrosoft365ConfigModel{
+			ArchivalTiering: &slaArchivalTieringModel{
+				InstantTiering:                 types.BoolValue(true),
+				MinAccessibleDurationInSeconds: types.Int64Null(),
+				ColdStorageClass:               types.StringValue(string(gqlsla.ColdStorageClassAzureArchive)),
+				TierExistingSnapshots:          types.BoolValue(false),
+			},
+			BackupWindow: []slaSnapshotWindowModel{{
+				Duration: types.Int64Value(8),
+				StartAt:  types.StringValue("Mon, 22:00"),
+			}},
+		},
+		VMwareVMConfig: &slaVMwareVMConfigModel{
+			LogRetention:        types.Int64Null(),
+			SnapshotConsistency: types.StringValue(slaSnapshotConsistencyApp),
+		},
+	}
+
+	configs, err := plan.workloadConfigs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := configs.O365Config.BackupWindows[0].StartTime.DayOfWeek.Day; got != gqlsla.Monday {
+		t.Errorf("backup window day = %q, want %q", got, gqlsla.Monday)
+	}
+
+	// The SLA domain model read by the SDK.
+	model := slaDomainModel{
+		VMwareVMConfig: &slaVMwareVMConfigModel{
+			LogRetention:        types.Int64Null(),
+			SnapshotConsistency: plan.VMwareVMConfig.SnapshotConsistency,
+		},
+	}
+	err = workloadConfigModels(slaWorkloadConfigsReply{
+		ExchangeConfig: configs.ExchangeConfig,
+		K8sConfig:      configs.K8sConfig,
+		O365Config: &slaO365Config{
+			ArchivalTieringSpec: configs.O365Config.ArchivalTieringSpec,
+			BackupWindows:       configs.O365Config.BackupWindows,
+		},
+		VMwareVMConfig: configs.VMwareVMConfig,
+	}, plan, &model)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(model.ExchangeConfig, plan.ExchangeConfig) {
+		t.Errorf("%s = %+v, want %+v", keyExchangeConfig, model.ExchangeConfig, plan.ExchangeConfig)
+	}
+	if !reflect.DeepEqual(model.KubernetesConfig, plan.KubernetesConfig) {
+		t.Errorf("%s = %+v, want %+v", keyKubernetesConfig, model.KubernetesConfig, plan.KubernetesConfig)
+	}
+	if !reflect.DeepEqual(model.Microsoft365Config, plan.Microsoft365Config) {
+		t.Errorf("%s = %+v, want %+v", keyMicrosoft365Config, model.Microsoft365Config, plan.Microsoft365Config)
+	}
+	if !reflect.DeepEqual(model.VMwareVMConfig, plan.VMwareVMConfig) {
+		t.Errorf("%s = %+v, want %+v", keyVMwareVMConfig, model.VMwareVMConfig, plan.VMwareVMConfig)
+	}
+}
+
+// TestSLADomainWorkloadConfigsEmpty verifies that empty workload
+// configurations read from RSC are null when not configured, and that
+// configured workload configurations removed in RSC are read as null.
+func TestSLADomainWorkloadConfigsEmpty(t *testing.T) {
+	reply := slaWorkloadConfigsReply{
+		ExchangeConfig: &slaExchangeConfig{},
+		K8sConfig:      &slaK8sConfig{},
+		O365Config:     &slaO365Config{ArchivalTieringSpec: &gqlsla.ArchivalTieringSpec{}},
+		VMwareVMConfig: &slaVMwareVMConfig{},
+	}
+
+	var model slaDomainModel
+	if err := workloadConfigModels(reply, slaDomainModel{}, &model); err != nil {
+		t.Fatal(err)
+	}
+	if model.ExchangeConfig != nil || model.KubernetesConfig != nil || model.Microsoft365Config != nil ||
+		model.VMwareVMConfig != nil {
+		t.Errorf("expected null workload configurations, got %+v", model)
+	}
+
+	// A configured Kubernetes configuration is kept, with the configured
+	// values.
+	prior := slaDomainModel{
+		ExchangeConfig: &slaFrequencyRetentionModel{},
+		KubernetesConfig: &slaKubernetesConfigModel{
+			IncludeClusterScopedResources: types.BoolValue(false),
+			IncludePersistentVolumeClaims: types.BoolNull(),
+		},
+	}
+	if err := workloadConfigModels(reply, prior, &model); err != nil {
+		t.Fatal(err)
+	}
+	if model.ExchangeConfig != nil {
+		t.Errorf("expected null %s, got %+v", keyExchangeConfig, model.ExchangeConfig)
+	}
+	if !reflect.DeepEqual(model.KubernetesConfig, prior.KubernetesConfig) {
+		t.Errorf("%s = %+v, want %+v", keyKubernetesConfig, model.KubernetesConfig, prior.KubernetesConfig)
+	}
+}
@@ -127,6 +127,9 @@ func (p *FrameworkProvider) Resources(ctx context.Context) []func() resource.Res
 		newAwsAccountManagedStackResource,
 		newCustomRoleResource,
 		newRoleAssignmentResource,
+		newSLADomainResource,
+		newSLAPauseResource,
+		newSnapshotRetentionResource,
 		newSSOGroupResource,
 		newUserResource,
 	}
@@ -138,11 +141,15 @@ func (p *FrameworkProvider) DataSources(ctx context.Context) []func() datasource
 	return []func() datasource.DataSource{
 		newAwsPermissionGroupsDataSource,
 		newAzurePermissionGroupsDataSource,
+		newExocomputeImageBundleDataSource,
 		newFeatureFlagDataSource,
 		newIdentityProviderDataSource,
 		newObjectsDataSource,
 		newRoleDataSource,
 		newRoleTemplateDataSource,
+		newSLAComplianceDataSource,
+		newSLADomainEstimateDataSource,
+		newSLADomainTemplateDataSource,
 		newSSOGroupDataSource,
 		newUserDataSource,
 	}
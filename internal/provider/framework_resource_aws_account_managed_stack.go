@@ -23,6 +23,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -30,6 +31,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -37,6 +40,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/aws"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlaws "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
 )
 
 // managedStackOnboardTimeout bounds the trigger -> poll-until-connected ->
@@ -53,6 +58,13 @@ The account, its features and its regions were all defined by the
 account ID and the deployed stack ARN. Wire the stack ARN in so onboarding runs
 only after the stack exists.
 
+When ´wait_for_completion´ is ´false´, the resource returns as soon as RSC has
+been asked to poll the status of the CloudFormation stack. The ´task_id´ field
+can then be used with the ´polaris_task_wait´ resource, using the
+´AWS_CLOUD_ACCOUNT´ task type, to wait for the features to connect. RSC requires
+the features to be connected before the onboarding can be completed, so the
+onboarding is completed by the next apply, see the ´onboarded´ field.
+
 -> **Note:** Destroying this resource disables the account's features in RSC
    and waits for the disable to complete (Cloud Discovery is disabled last). Set
    ´delete_snapshots_on_destroy´ to also delete the account's snapshots.
@@ -61,6 +73,7 @@ only after the stack exists.
 var (
 	_ resource.Resource                = &awsAccountManagedStackResource{}
 	_ resource.ResourceWithImportState = &awsAccountManagedStackResource{}
+	_ resource.ResourceWithModifyPlan  = &awsAccountManagedStackResource{}
 )
 
 type awsAccountManagedStackResource struct {
@@ -73,6 +86,9 @@ type awsAccountManagedStackModel struct {
 	StackARN                 types.String `tfsdk:"stack_arn"`
 	PermissionsVersion       types.String `tfsdk:"permissions_version"`
 	DeleteSnapshotsOnDestroy types.Bool   `tfsdk:"delete_snapshots_on_destroy"`
+	Onboarded                types.Bool   `tfsdk:"onboarded"`
+	TaskID                   types.String `tfsdk:"task_id"`
+	WaitForCompletion        types.Bool   `tfsdk:"wait_for_completion"`
 }
 
 func newAwsAccountManagedStackResource() resource.Resource {
@@ -123,7 +139,8 @@ func (r *awsAccountManagedStackResource) Schema(ctx context.Context, _ resource.
 				Required: true,
 				Description: "Permission-set version from the `polaris_aws_account_managed` resource. When it " +
 					"changes, RSC has raised a permission version and the CloudFormation stack has been redeployed; " +
-					"the resource then re-completes onboarding (notifies RSC and waits for the features to reconnect).",
+					"the resource then re-completes onboarding (notifies RSC and waits for the features to reconnect, " +
+					"unless `wait_for_completion` is false).",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -133,6 +150,31 @@ func (r *awsAccountManagedStackResource) Schema(ctx context.Context, _ resource.
 				Description: "If true, the account's snapshots are deleted when the resource is destroyed. " +
 					"Defaults to false. Applied when the account's features are disabled during destroy.",
 			},
+			keyOnboarded: schema.BoolAttribute{
+				Computed: true,
+				Description: "True when the RSC-managed onboarding has been completed. False when the resource was " +
+					"created without waiting for completion, the onboarding is then completed by the next apply.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyTaskID: schema.StringAttribute{
+				Computed: true,
+				Description: "ID of the RSC AWS cloud account to wait for. Only set when onboarding, or a permissions " +
+					"update, is started without waiting for completion. Use with the `polaris_task_wait` resource and " +
+					"the `AWS_CLOUD_ACCOUNT` task type to wait for the features to connect.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyWaitForCompletion: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				Description: "Flag to determine if Terraform should wait for the features to connect. When `false`, " +
+					"Terraform returns as soon as RSC has been asked to poll the CloudFormation stack status. Default " +
+					"value is `true`.",
+			},
 		},
 	}
 }
@@ -166,13 +208,24 @@ func (r *awsAccountManagedStackResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	// Bound the trigger -> poll -> complete sequence.
-	onboardCtx, cancel := context.WithTimeout(ctx, managedStackOnboardTimeout)
-	defer cancel()
+	if plan.WaitForCompletion.ValueBool() {
+		// Bound the trigger -> poll -> complete sequence.
+		onboardCtx, cancel := context.WithTimeout(ctx, managedStackOnboardTimeout)
+		defer cancel()
 
-	if err := aws.Wrap(polarisClient).AddManagedAccountFinalize(onboardCtx, accountID); err != nil {
-		res.Diagnostics.AddError("Failed to complete RSC-managed AWS onboarding", err.Error())
-		return
+		if err := aws.Wrap(polarisClient).AddManagedAccountFinalize(onboardCtx, accountID); err != nil {
+			res.Diagnostics.AddError("Failed to complete RSC-managed AWS onboarding", err.Error())
+			return
+		}
+		plan.Onboarded = types.BoolValue(true)
+		plan.TaskID = types.StringNull()
+	} else {
+		if err := startManagedAccountOnboarding(ctx, polarisClient.GQL, accountID); err != nil {
+			res.Diagnostics.AddError("Failed to start RSC-managed AWS onboarding", err.Error())
+			return
+		}
+		plan.Onboarded = types.BoolValue(false)
+		plan.TaskID = types.StringValue(accountID.String())
 	}
 
 	plan.ID = plan.AccountID
@@ -208,6 +261,15 @@ func (r *awsAccountManagedStackResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	// Resources created by earlier versions of the provider, and imported
+	// resources, have been onboarded.
+	if state.Onboarded.IsNull() {
+		state.Onboarded = types.BoolValue(true)
+	}
+	if state.WaitForCompletion.IsNull() {
+		state.WaitForCompletion = types.BoolValue(true)
+	}
+
 	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
 }
 
@@ -225,29 +287,52 @@ func (r *awsAccountManagedStackResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	// account_id and stack_arn force replacement, so the only in-place change is
-	// permissions_version. A change means RSC raised a permission version and the
-	// CloudFormation stack has been redeployed with the updated permissions -
-	// notify RSC and wait for the features to reconnect.
-	if !plan.PermissionsVersion.Equal(state.PermissionsVersion) {
-		polarisClient, err := r.client.polaris()
-		if err != nil {
-			res.Diagnostics.AddError("RSC client error", err.Error())
-			return
-		}
-		accountID, err := uuid.Parse(plan.AccountID.ValueString())
-		if err != nil {
-			res.Diagnostics.AddError("Invalid account ID", err.Error())
-			return
-		}
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+	accountID, err := uuid.Parse(plan.AccountID.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError("Invalid account ID", err.Error())
+		return
+	}
 
+	// An onboarding started without waiting for completion is completed once
+	// the features have connected.
+	if !state.Onboarded.IsNull() && !state.Onboarded.ValueBool() {
 		onboardCtx, cancel := context.WithTimeout(ctx, managedStackOnboardTimeout)
 		defer cancel()
 
-		if err := aws.Wrap(polarisClient).UpdateManagedAccountFinalize(onboardCtx, accountID); err != nil {
-			res.Diagnostics.AddError("Failed to complete RSC-managed AWS permissions update", err.Error())
+		if err := aws.Wrap(polarisClient).AddManagedAccountFinalize(onboardCtx, accountID); err != nil {
+			res.Diagnostics.AddError("Failed to complete RSC-managed AWS onboarding", err.Error())
 			return
 		}
+		plan.Onboarded = types.BoolValue(true)
+		plan.TaskID = types.StringNull()
+	}
+
+	// account_id and stack_arn force replacement, so the only in-place change is
+	// permissions_version. A change means RSC raised a permission version and the
+	// CloudFormation stack has been redeployed with the updated permissions -
+	// notify RSC and wait for the features to reconnect.
+	if !plan.PermissionsVersion.Equal(state.PermissionsVersion) {
+		if plan.WaitForCompletion.ValueBool() {
+			onboardCtx, cancel := context.WithTimeout(ctx, managedStackOnboardTimeout)
+			defer cancel()
+
+			if err := aws.Wrap(polarisClient).UpdateManagedAccountFinalize(onboardCtx, accountID); err != nil {
+				res.Diagnostics.AddError("Failed to complete RSC-managed AWS permissions update", err.Error())
+				return
+			}
+			plan.TaskID = types.StringNull()
+		} else {
+			if err := aws.Wrap(polarisClient).PermissionsUpdated(ctx, accountID, nil); err != nil {
+				res.Diagnostics.AddError("Failed to notify RSC of updated permissions", err.Error())
+				return
+			}
+			plan.TaskID = types.StringValue(accountID.String())
+		}
 	}
 
 	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
@@ -285,8 +370,64 @@ func (r *awsAccountManagedStackResource) Delete(ctx context.Context, req resourc
 	}
 }
 
+// ModifyPlan plans the completion of an onboarding started without waiting for
+// completion and the task ID of a permissions update.
+func (r *awsAccountManagedStackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	tflog.Trace(ctx, "awsAccountManagedStackResource.ModifyPlan")
+
+	// Nothing to do on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan awsAccountManagedStackModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	var state awsAccountManagedStackModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Onboarded.IsNull() && !state.Onboarded.ValueBool() {
+		plan.Onboarded = types.BoolValue(true)
+		plan.TaskID = types.StringNull()
+	}
+	if !plan.PermissionsVersion.Equal(state.PermissionsVersion) {
+		plan.TaskID = types.StringUnknown()
+	}
+
+	res.Diagnostics.Append(res.Plan.Set(ctx, &plan)...)
+}
+
 func (r *awsAccountManagedStackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
 	tflog.Trace(ctx, "awsAccountManagedStackResource.ImportState")
 	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
 	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(keyAccountID), req.ID)...)
 }
+
+// startManagedAccountOnboarding starts the final (post-stack) phase of the
+// RSC-managed AWS onboarding flow by asking RSC to poll the CloudFormation
+// stack status. Like the SDK, a failure to trigger the polling is only logged,
+// since RSC also reconciles the status on its own.
+func startManagedAccountOnboarding(ctx context.Context, gql *graphql.Client, accountID uuid.UUID) error {
+	account, err := aws.WrapGQL(gql).AccountByID(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("failed to get account: %s", err)
+	}
+
+	features := make([]core.Feature, 0, len(account.Features))
+	for _, feature := range account.Features {
+		features = append(features, core.Feature{Name: feature.Name})
+	}
+	if err := gqlaws.Wrap(gql).TriggerCftStatusPolling(ctx, accountID, features); err != nil {
+		tflog.Warn(ctx, "failed to trigger CloudFormation status polling", map[string]any{
+			"account_id": accountID.String(),
+			"error":      err.Error(),
+		})
+	}
+
+	return nil
+}
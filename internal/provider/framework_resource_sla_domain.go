@@ -0,0 +1,1931 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	gqlaws "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/aws"
+	gqlazure "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/azure"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+const frameworkResourceSLADomainDescription = `
+The ´polaris_sla_domain´ resource is used to manage RSC global SLA Domains. SLA
+Domain defines how you want to take snapshots of objects like virtual machines,
+databases, SaaS apps and cloud objects. An SLA Domain can define frequency,
+retention, archival and replication.
+
+-> Enabling Instant Archive can increase bandwidth usage and archival storage
+   requirements.
+
+-> The hourly retention for snapshots of cloud-native workloads must be a
+   multiple of 24.
+
+-> For workloads backed up on a Rubrik cluster, snapshots are scheduled using
+   the time zone of that Rubrik cluster. For workloads backed up in the cloud,
+   snapshots are scheduled using the UTC time zone.
+
+-> The ´apply_changes_to_existing_snapshots´ and
+   ´apply_changes_to_non_policy_snapshots´ fields are write-only and require
+   Terraform 1.11 or later.
+
+-> When ´clone_from_id´ is specified, the schedules, archival, replication,
+   snapshot windows and object specific configurations not specified in the
+   configuration are copied from the SLA Domain with the ID when planning. Changes
+   to the cloned SLA Domain are propagated on the next apply, while the fields
+   specified in the configuration override the cloned values. The name,
+   description, object types and retention lock are never cloned.
+
+-> Changes not allowed by the retention lock of an SLA Domain are detected when
+   planning. With a ´COMPLIANCE´ mode lock, reducing the retention of a snapshot
+   schedule or the local retention, removing an archival location, changing the
+   retention lock mode, and replacing or deleting the SLA Domain fail when
+   planning. With a ´GOVERNANCE´ mode lock, the same changes produce warnings,
+   since they require the privilege to modify retention locked SLA Domains.
+
+---
+
+### Frequency
+
+This defines when and how often snapshots are taken. This could be interval-based (days, hours, minutes) or calendar-based (a day of each month).
+
+### Retention
+
+This defines how long the snapshot is kept on the Rubrik cluster.
+
+### Archival
+Before You Start: To archive snapshots, make sure you’ve added archival locations.
+
+To avoid early deletion fees, retain snapshots in cool tier archival locations for at least 30 days.
+
+---
+# Object types
+
+The object type restrictions below, e.g. archival and replication support, are
+validated when planning, so an invalid SLA Domain fails before it's applied.
+
+## Active Directory
+Active Directory protection supports a minimum of 4 hours SLA.
+
+## Azure SQL Databases
+Archival is mandatory and the backups will be instantly archived. Frequency and Retention apply to archived snapshots of the Azure SQL database.
+Continuous backups for point-in-time recovery retentions is configured in ´azure_sql_database_config´.
+
+## Azure SQL Managed Instance
+Archival and Replication are not supported by Azure SQL Managed Instance.
+Log backup for Azure SQL MI is configured in ´azure_sql_managed_instance_config´.
+
+## Azure Blob Storage
+Archival and Replication are not supported by Azure Blob Storage.
+Backup location for scheduled snapshots is configured in ´azure_blob_config´.
+
+## AWS RDS
+Archival is only supported for PostgrSQL and Aurora PostgreSQL databases.
+Continuous backups for point-in-time recovery retention is configured in ´aws_rds_config´. If you don't specify a continuous backup, AWS provides 1 day of continuous backup by default for Aurora databases, which you can change but you can’t disable.
+
+## AWS S3
+Archival and Replication are not supported by AWS S3. SLA Domains protecting AWS S3 cannot protect other object types.
+Backup location(s) are configured in ´backup_location´.
+
+## AWS DynamoDB
+Replication is not supported by AWS DynamoDB.
+Primary Backup Encryption KMS Key and Continuous backups for point-in-time recovery are configured in ´aws_dynamodb_config´. Continuous backups will be automatically enabled for your DynamoDB tables.
+Disabling continuous backups or changing the retention period in your AWS console may lead to higher storage and consumption costs. To avoid this, keep continuous backups enabled in your AWS console.
+
+## GCE Instance/Disk
+Replication is not supported by GCE Instance/Disk.
+
+## Okta
+Archival and Replication are not supported by Okta.
+
+## Microsoft 365
+Archival and Replication are not supported by Microsoft 365.
+M365 protection supports a minimum of 8 hours SLA (12 hours or more recomended).
+Backup windows and archival tiering of Microsoft 365 are configured in ´microsoft_365_config´.
+
+## Microsoft Exchange
+Log backups of Microsoft Exchange databases are configured in ´exchange_config´.
+
+## Kubernetes
+Backups of cluster scoped resources and persistent volume claims of Kubernetes namespaces are configured in
+´kubernetes_config´.
+
+## OLVM
+Archival is not supported by OLVM.
+
+## VMware vSphere
+Log backups and the snapshot consistency of VMware vSphere virtual machines are configured in ´vmware_vm_config´.
+
+The ´microsoft_365_config´, ´exchange_config´ and ´kubernetes_config´ fields and the ´snapshot_consistency´ field
+of ´vmware_vm_config´ are only read from RSC when configured, they are not read when importing an SLA domain.
+`
+
+var (
+	_ resource.Resource                     = &slaDomainResource{}
+	_ resource.ResourceWithConfigValidators = &slaDomainResource{}
+	_ resource.ResourceWithImportState      = &slaDomainResource{}
+	_ resource.ResourceWithModifyPlan       = &slaDomainResource{}
+	_ resource.ResourceWithUpgradeState     = &slaDomainResource{}
+)
+
+// slaDomainDeleteTimeout is the time to wait for the objects assigned to the
+// SLA domain to be unassigned before deleting the SLA domain.
+const slaDomainDeleteTimeout = 10 * time.Minute
+
+type slaDomainResource struct {
+	client *client
+}
+
+func newSLADomainResource() resource.Resource {
+	return &slaDomainResource{}
+}
+
+func (r *slaDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySLADomain
+}
+
+func (r *slaDomainResource) Schema(ctx context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Schema")
+
+	res.Schema = slaDomainSchema()
+}
+
+// slaDomainSchema returns the schema of the SLA domain resource. The schema is
+// also used to upgrade SDKv2 state.
+func slaDomainSchema() schema.Schema {
+	s := slaDomainBaseSchema()
+
+	// Fields which can be cloned are also computed, so that ModifyPlan can
+	// plan the cloned values. Only nested attributes can be cloned, this is
+	// verified by the unit tests.
+	for _, key := range slaDomainCloneKeys {
+		switch attr := s.Attributes[key].(type) {
+		case schema.SingleNestedAttribute:
+			attr.Computed = true
+			s.Attributes[key] = attr
+		case schema.ListNestedAttribute:
+			attr.Computed = true
+			s.Attributes[key] = attr
+		}
+	}
+
+	return s
+}
+
+// slaDomainBaseSchema returns the schema of the SLA domain resource without
+// the cloning support.
+func slaDomainBaseSchema() schema.Schema {
+	allUnits := gqlsla.AllRetentionUnitsAsStrings()
+	frequencyUnits := []string{
+		string(gqlsla.Minute),
+		string(gqlsla.Hours),
+		string(gqlsla.Days),
+		string(gqlsla.Weeks),
+		string(gqlsla.Months),
+		string(gqlsla.Quarters),
+		string(gqlsla.Years),
+	}
+	replicationUnits := []string{
+		string(gqlsla.Days),
+		string(gqlsla.Weeks),
+		string(gqlsla.Months),
+		string(gqlsla.Quarters),
+		string(gqlsla.Years),
+	}
+	months := []string{
+		string(gqlsla.January),
+		string(gqlsla.February),
+		string(gqlsla.March),
+		string(gqlsla.April),
+		string(gqlsla.May),
+		string(gqlsla.June),
+		string(gqlsla.July),
+		string(gqlsla.August),
+		string(gqlsla.September),
+		string(gqlsla.October),
+		string(gqlsla.November),
+		string(gqlsla.December),
+	}
+
+	return schema.Schema{
+		Description: description(frameworkResourceSLADomainDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "SLA Domain ID (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyApplyChangesToExistingSnapshots: schema.BoolAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Apply changes to existing snapshots when updating the SLA domain.",
+			},
+			keyApplyChangesToNonPolicySnapshots: schema.BoolAttribute{
+				Optional:    true,
+				WriteOnly:   true,
+				Description: "Apply changes to non-policy snapshots when updating the SLA domain.",
+			},
+			keyArchival: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyArchivalLocationID: schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "Archival location ID (UUID).",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.String{
+								isUUID(),
+							},
+						},
+						keyThreshold: schema.Int64Attribute{
+							Optional: true,
+							Computed: true,
+							Default:  int64default.StaticInt64(0),
+							Description: "Threshold specifies the time before archiving the snapshots at the " +
+								"managing location. The archival location retains the snapshots according to the SLA " +
+								"Domain schedule.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						keyThresholdUnit: schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(string(gqlsla.Days)),
+							Description: "Threshold unit specifies the unit of `threshold`. Possible values are " +
+								"`DAYS`, `WEEKS`, `MONTHS` and `YEARS`. Default value is `DAYS`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(allUnits...),
+							},
+						},
+						keyArchivalLocationToClusterMapping: schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									keyClusterID: schema.StringAttribute{
+										Optional:    true,
+										Computed:    true,
+										Description: "Cluster ID (UUID).",
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+										Validators: []validator.String{
+											isUUID(),
+										},
+									},
+									keyArchivalLocationID: schema.StringAttribute{
+										Required:    true,
+										Description: "Archival location ID (UUID).",
+										Validators: []validator.String{
+											isUUID(),
+										},
+									},
+									keyClusterName: schema.StringAttribute{
+										Computed:    true,
+										Description: "Cluster name.",
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									keyName: schema.StringAttribute{
+										Computed:    true,
+										Description: "Archival location name.",
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+							Optional: true,
+							Description: "Mapping between archival location and Rubrik cluster. Each mapping " +
+								"specifies which cluster should be used for archiving to a specific location.",
+						},
+						keyArchivalTiering: slaArchivalTieringAttribute(),
+						keyFrequency: schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Override which snapshot frequencies to archive. When not specified, " +
+								"frequencies are derived from the snapshot schedule and will not be visible " +
+								"in state. Use the polaris_sla_domain data source to see the effective " +
+								"frequencies. Possible values are `MINUTES`, `HOURS`, `DAYS`, `WEEKS`, " +
+								"`MONTHS`, `QUARTERS`, `YEARS`.",
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(stringvalidator.OneOf(frequencyUnits...)),
+							},
+						},
+					},
+				},
+				Optional: true,
+				Description: "Archive snapshots to the specified archival location. Note, if `instant_archive` is " +
+					"enabled, `threshold` and `threshold_unit` are ignored.",
+			},
+			keyAWSDynamoDBConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyKMSAlias: schema.StringAttribute{
+						Optional: true,
+						Description: "KMS alias for primary backup. Ensure the specified KMS key exists in the " +
+							"respective regions of the DynamoDB tables this SLA will be applied to. Avoid deleting " +
+							"it, as it will be used for data decryption during archival and recovery.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(regexp.MustCompile(`^alias\/[a-zA-Z0-9:/_-]+$`),
+								"KMS alias must be in the format `alias/<name>`"),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "AWS DynamoDB configuration.",
+			},
+			keyAWSRDSConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyLogRetention: schema.Int64Attribute{
+						Required:    true,
+						Description: "Log retention specifies for how long the backups are kept.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					keyLogRetentionUnit: schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(string(gqlsla.Days)),
+						Description: "Log retention unit specifies the unit of the `log_retention` field. " +
+							"Possible values are `DAYS`, `WEEKS`, `MONTHS` and `YEARS`. Default is `DAYS`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(gqlsla.Days),
+								string(gqlsla.Weeks),
+								string(gqlsla.Months),
+								string(gqlsla.Years),
+							),
+						},
+					},
+				},
+				Optional: true,
+				Description: "AWS RDS continuous backups for point-in-time recovery. If continuous backup isn't " +
+					"specified, AWS provides 1 day of continuous backup by default for Aurora databases, which can " +
+					"be changed but not disable.",
+			},
+			keyAzureBlobConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyArchivalLocationID: schema.StringAttribute{
+						Required:    true,
+						Description: "Archival location ID (UUID).",
+						Validators: []validator.String{
+							isUUID(),
+						},
+					},
+				},
+				Optional: true,
+				Description: "Azure Blob Storage backup location for scheduled snapshots. To avoid early deletion " +
+					"fees, retain snapshots in cool tier archival locations for at least 30 days.",
+			},
+			keyAzureSQLDatabaseConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyLogRetention: schema.Int64Attribute{
+						Required: true,
+						Description: "Log retention specifies for how long, in days, the continuous backups are " +
+							"kept.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 35),
+						},
+					},
+					keyLTRConfig: slaLTRConfigAttribute(),
+				},
+				Optional: true,
+				Description: "Azure SQL Database continuous backups for point-in-time recovery. Continuous " +
+					"backups are stored in the source database. A V1 (Azure-managed) SLA also specifies " +
+					"`ltr_config`; a V2 (Rubrik-managed) SLA omits it and specifies a backup location and " +
+					"snapshot schedule. Note, the changes will be applied during the next maintenance window.",
+			},
+			keyAzureSQLManagedInstanceConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyLogRetention: schema.Int64Attribute{
+						Required:    true,
+						Description: "Log retention specifies for how long, in days, the log backups are kept.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 35),
+						},
+					},
+					keyLTRConfig: slaLTRConfigAttribute(),
+				},
+				Optional: true,
+				Description: "Azure SQL MI log backups. A V1 (Azure-managed) SLA also specifies `ltr_config`; a " +
+					"V2 (Rubrik-managed) SLA omits it and specifies a backup location and snapshot schedule. " +
+					"Note, the changes will be applied during the next maintenance window.",
+			},
+			keyBackupLocation: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyArchivalGroupID: schema.StringAttribute{
+							Required:    true,
+							Description: "Archival group ID (UUID).",
+							Validators: []validator.String{
+								isUUID(),
+							},
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Backup locations for the SLA Domain.",
+			},
+			keyBackupType: schema.StringAttribute{
+				Computed: true,
+				Description: "Identifies which system manages the SLA's Azure SQL backups: `NATIVE` for a V1 " +
+					"(Azure-managed / long-term retention) SLA, or the Rubrik-managed value for a V2 SLA. Read-only.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyCloneFromID: schema.StringAttribute{
+				Optional: true,
+				Description: "SLA Domain ID (UUID) to clone. Schedules, archival, replication, snapshot windows and " +
+					"object specific configurations not specified are copied from the SLA Domain when planning.",
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+			keyDailySchedule: slaBasicScheduleAttribute("Take snapshots with frequency specified in days.",
+				"Frequency in days.", "Retention unit specifies the unit of the `retention` field. Possible "+
+					"values are `DAYS`, `WEEKS` and `MONTHS`. Default is `DAYS`.",
+				string(gqlsla.Days), string(gqlsla.Weeks), string(gqlsla.Months)),
+			keyDB2Config: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyIncrementalFrequency: slaOptionalDurationAttribute("Incremental backup frequency."),
+					keyIncrementalFrequencyUnit: slaUnitAttribute("Incremental frequency unit. Possible values are " +
+						"`DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyLogRetention: slaOptionalDurationAttribute("Log retention duration."),
+					keyLogRetentionUnit: slaUnitAttribute("Log retention unit. Possible values are `DAYS`, " +
+						"`WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyDifferentialFrequency: slaOptionalDurationAttribute("Differential backup frequency."),
+					keyDifferentialFrequencyUnit: slaUnitAttribute("Differential frequency unit. Possible values " +
+						"are `DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyLogArchivalMethod: schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(string(gqlsla.Db2LogArchivalMethod1)),
+						Description: "Log archival method. Possible values are `LOGARCHMETH1`, `LOGARCHMETH2`. " +
+							"Default is `LOGARCHMETH1`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf("LOGARCHMETH1", "LOGARCHMETH2"),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Db2 database configuration.",
+			},
+			keyDescription: schema.StringAttribute{
+				Optional:    true,
+				Description: "SLA Domain description.",
+				Validators: []validator.String{
+					isNotWhiteSpace(),
+				},
+			},
+			keyExchangeConfig: slaFrequencyRetentionAttribute("Microsoft Exchange configuration.",
+				"Log backup frequency.", "Log retention duration.", false),
+			keyFirstFullSnapshot: slaSnapshotWindowAttribute("Specifies the snapshot window where the first "+
+				"full snapshot will be taken. If not specified it will be at first opportunity.",
+				"Duration of snapshot window in hours.", "Start of the snapshot window. Should be given as "+
+					"`DAY, HH:MM`, e.g: `Mon, 15:30`.", true),
+			keyGCPCloudSQLConfig: slaLogRetentionAttribute("GCP Cloud SQL configuration.",
+				"Log retention duration."),
+			keyHourlySchedule: slaBasicScheduleAttribute("Take snapshots with frequency specified in hours.",
+				"Frequency in hours.", "Retention unit specifies the unit of the `retention` field. Possible "+
+					"values are `HOURS`, `DAYS`, `WEEKS` and `MONTHS`. Default value is `DAYS`.",
+				string(gqlsla.Hours), string(gqlsla.Days), string(gqlsla.Weeks), string(gqlsla.Months)),
+			keyInformixConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyIncrementalFrequency: slaOptionalDurationAttribute("Incremental backup frequency."),
+					keyIncrementalFrequencyUnit: slaUnitAttribute("Incremental frequency unit. Possible values are " +
+						"`DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyIncrementalRetention: slaOptionalDurationAttribute("Incremental backup retention duration."),
+					keyIncrementalRetentionUnit: slaUnitAttribute("Incremental retention unit. Possible values are " +
+						"`DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyFrequency: slaOptionalDurationAttribute("Log backup frequency."),
+					keyFrequencyUnit: slaUnitAttribute("Frequency unit. Possible values are `DAYS`, `WEEKS`, " +
+						"`MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyRetention: slaOptionalDurationAttribute("Log retention duration."),
+					keyRetentionUnit: slaUnitAttribute("Retention unit. Possible values are `DAYS`, `WEEKS`, " +
+						"`MONTHS`, `YEARS`. Default is `DAYS`."),
+				},
+				Optional:    true,
+				Description: "Informix database configuration.",
+			},
+			keyKubernetesConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyIncludeClusterScopedResources: schema.BoolAttribute{
+						Optional: true,
+						Description: "Include the cluster scoped resources used by the protected namespaces, e.g. " +
+							"custom resource definitions and storage classes, in the snapshots.",
+					},
+					keyIncludePersistentVolumeClaims: schema.BoolAttribute{
+						Optional: true,
+						Description: "Include the data of the persistent volume claims of the protected " +
+							"namespaces in the snapshots. When false, only the namespace resources are protected.",
+					},
+				},
+				Optional:    true,
+				Description: "Kubernetes namespace configuration.",
+			},
+			keyLocalRetention: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyRetention: schema.Int64Attribute{
+						Required:    true,
+						Description: "Retention specifies for how long the snapshots are kept.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					keyRetentionUnit: schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString(string(gqlsla.Days)),
+						Description: "Retention unit specifies the unit of `retention`. Possible values are " +
+							"`MINUTE`, `HOURS`, `DAYS`, `WEEKS`, `MONTHS`, `QUARTERS` and `YEARS`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(frequencyUnits...),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Local retention specifies for how long the snapshots are kept on the Rubrik cluster.",
+			},
+			keyLockedAt: schema.StringAttribute{
+				Computed: true,
+				Description: "RFC3339 timestamp of when the SLA Domain was retention locked. RSC doesn't report when " +
+					"an SLA Domain was locked, for SLA Domains locked outside of Terraform this is when the lock " +
+					"was first read. Null if the SLA Domain isn't retention locked.",
+			},
+			keyManagedVolumeConfig: slaLogRetentionAttribute("Managed Volume configuration.",
+				"Log retention duration."),
+			keyMicrosoft365Config: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyArchivalTiering: slaArchivalTieringAttribute(),
+					keyBackupWindow: slaSnapshotWindowAttribute("Backup windows in which the Microsoft 365 "+
+						"backups are taken.", "Duration of the backup window in hours.", "Start of the backup "+
+						"window. Should be given as `HH:MM`, e.g: `15:30`.", false),
+				},
+				Optional:    true,
+				Description: "Microsoft 365 configuration.",
+			},
+			keyMinuteSchedule: slaBasicScheduleAttribute("Take snapshots with frequency specified in minutes.",
+				"Frequency in minutes.", "Retention unit specifies the unit of the `retention` field. Possible "+
+					"values are `HOURS`, `DAYS` and `WEEKS`. Default value is `DAYS`.",
+				string(gqlsla.Hours), string(gqlsla.Days), string(gqlsla.Weeks)),
+			keyMongoConfig: slaFrequencyRetentionAttribute("MongoDB database configuration.", "Log backup frequency.",
+				"Log retention duration.", false),
+			keyMonthlySchedule: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyDayOfMonth: schema.StringAttribute{
+						Required:    true,
+						Description: "Day of month. Possible values are `FIRST_DAY`, `FIFTEENTH` and `LAST_DAY`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(gqlsla.FirstDay, string(gqlsla.FifteenthDay), gqlsla.LastDay),
+						},
+					},
+					keyFrequency:     slaFrequencyAttribute("Frequency in months."),
+					keyRetention:     slaRetentionAttribute(),
+					keyRetentionUnit: slaRequiredRetentionUnitAttribute(frequencyUnits),
+				},
+				Optional:    true,
+				Description: "Take snapshots with frequency specified in months.",
+			},
+			keyMSSQLConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyFrequency: slaFrequencyAttribute("Log backup frequency."),
+					keyFrequencyUnit: slaUnitAttribute("Frequency unit. Possible values are `DAYS`, `WEEKS`, " +
+						"`MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyLogRetention: schema.Int64Attribute{
+						Required:    true,
+						Description: "Log retention duration.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					keyLogRetentionUnit: slaUnitAttribute("Log retention unit. Possible values are `DAYS`, " +
+						"`WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+				},
+				Optional:    true,
+				Description: "SQL Server database configuration.",
+			},
+			keyMySQLDBConfig: slaFrequencyRetentionAttribute("MySQL database configuration.",
+				"Log backup frequency.", "Log retention duration.", false),
+			keyName: schema.StringAttribute{
+				Required:    true,
+				Description: "SLA Domain name.",
+				Validators: []validator.String{
+					isNotWhiteSpace(),
+				},
+			},
+			keyNCDConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyMinutelyBackupLocations:  slaBackupLocationsAttribute("per-minute"),
+					keyHourlyBackupLocations:    slaBackupLocationsAttribute("hourly"),
+					keyDailyBackupLocations:     slaBackupLocationsAttribute("daily"),
+					keyWeeklyBackupLocations:    slaBackupLocationsAttribute("weekly"),
+					keyMonthlyBackupLocations:   slaBackupLocationsAttribute("monthly"),
+					keyQuarterlyBackupLocations: slaBackupLocationsAttribute("quarterly"),
+					keyYearlyBackupLocations:    slaBackupLocationsAttribute("yearly"),
+				},
+				Optional:    true,
+				Description: "NAS Cloud Direct configuration.",
+			},
+			keyObjectTypes: schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Object types which can be protected by the SLA Domain. Possible values are " +
+					"`ACTIVE_DIRECTORY_OBJECT_TYPE`, `ATLASSIAN_JIRA_OBJECT_TYPE`, `AWS_DYNAMODB_OBJECT_TYPE`, `AWS_EC2_EBS_OBJECT_TYPE`, `AWS_RDS_OBJECT_TYPE`, `AWS_S3_OBJECT_TYPE`, " +
+					"`AZURE_AD_OBJECT_TYPE`, `AZURE_BLOB_OBJECT_TYPE`, `AZURE_DEVOPS_OBJECT_TYPE`, `AZURE_OBJECT_TYPE`, `AZURE_SQL_DATABASE_OBJECT_TYPE`, `AZURE_SQL_MANAGED_INSTANCE_OBJECT_TYPE`, " +
+					"`CASSANDRA_OBJECT_TYPE`, `D365_OBJECT_TYPE`, `DB2_OBJECT_TYPE`, `EXCHANGE_OBJECT_TYPE`, `FILESET_OBJECT_TYPE`, `GCP_CLOUD_SQL_OBJECT_TYPE`, `GCP_OBJECT_TYPE`, " +
+					"`GOOGLE_WORKSPACE_OBJECT_TYPE`, `HYPERV_OBJECT_TYPE`, `INFORMIX_INSTANCE_OBJECT_TYPE`, `K8S_OBJECT_TYPE`, `KUPR_OBJECT_TYPE`, " +
+					"`M365_BACKUP_STORAGE_OBJECT_TYPE`, `MANAGED_VOLUME_OBJECT_TYPE`, `MONGO_OBJECT_TYPE`, `MONGODB_OBJECT_TYPE`, `MSSQL_OBJECT_TYPE`, `MYSQLDB_OBJECT_TYPE`, " +
+					"`NAS_OBJECT_TYPE`, `NCD_OBJECT_TYPE`, `NUTANIX_OBJECT_TYPE`, `O365_OBJECT_TYPE`, `OKTA_OBJECT_TYPE`, `OLVM_OBJECT_TYPE`, `OPENSTACK_OBJECT_TYPE`, " +
+					"`ORACLE_OBJECT_TYPE`, `POSTGRES_DB_CLUSTER_OBJECT_TYPE`, `PROXMOX_OBJECT_TYPE`, `SALESFORCE_OBJECT_TYPE`, `SAP_HANA_OBJECT_TYPE`, " +
+					"`SNAPMIRROR_CLOUD_OBJECT_TYPE`, `VCD_OBJECT_TYPE`, `VOLUME_GROUP_OBJECT_TYPE`, and `VSPHERE_OBJECT_TYPE`. " +
+					"Note, `AZURE_SQL_DATABASE_OBJECT_TYPE` cannot be provided at the same time as other object types.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(gqlsla.AllObjectTypesAsStrings()...)),
+				},
+			},
+			keyOracleConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyFrequency: slaFrequencyAttribute("Log backup frequency."),
+					keyFrequencyUnit: slaUnitAttribute("Frequency unit. Possible values are `DAYS`, `WEEKS`, " +
+						"`MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyLogRetention: schema.Int64Attribute{
+						Required:    true,
+						Description: "Log retention duration.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					keyLogRetentionUnit: slaUnitAttribute("Log retention unit. Possible values are `DAYS`, " +
+						"`WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyHostLogRetention: slaOptionalDurationAttribute("Host log retention duration for archived " +
+						"redo logs."),
+					keyHostLogRetentionUnit: slaUnitAttribute("Host log retention unit. Possible values are " +
+						"`MINUTES`, `HOURS`, `DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyRetainArchiveLogsIndefinitely: schema.BoolAttribute{
+						Optional: true,
+						Description: "When true, Oracle archive logs are retained indefinitely on the host and " +
+							"never deleted. Mutually exclusive with `host_log_retention`.",
+						Validators: []validator.Bool{
+							boolvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName(keyHostLogRetention)),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Oracle database configuration.",
+			},
+			keyPaused: schema.BoolAttribute{
+				Optional: true,
+				Description: "Pause snapshot scheduling of the SLA Domain on all Rubrik clusters the SLA Domain " +
+					"has been synced to. When not specified, the pause state is not managed. Only applies to " +
+					"SLA Domains protecting objects on Rubrik clusters. An SLA Domain which hasn't been synced " +
+					"to any Rubrik cluster is paused once it has been synced and the resource is applied again.",
+			},
+			keyPostgresDBClusterConfig: slaLogRetentionAttribute("Postgres DB Cluster configuration.",
+				"Log retention duration for Write-Ahead Logging (WAL) logs."),
+			keyQuarterlySchedule: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyDayOfQuarter: schema.StringAttribute{
+						Required:    true,
+						Description: "Day of quarter. Possible values are `FIRST_DAY` and `LAST_DAY`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(gqlsla.FirstDay, gqlsla.LastDay),
+						},
+					},
+					keyFrequency: slaFrequencyAttribute("Frequency in quarters."),
+					keyQuarterStartMonth: schema.StringAttribute{
+						Required: true,
+						Description: "Quarter start month. Possible values are `JANUARY`, `FEBRUARY`, " +
+							"`MARCH`, `APRIL`, `MAY`, `JUNE`, `JULY`, `AUGUST`, `SEPTEMBER`, `OCTOBER`, " +
+							"`NOVEMBER` and `DECEMBER`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(months...),
+						},
+					},
+					keyRetention:     slaRetentionAttribute(),
+					keyRetentionUnit: slaRequiredRetentionUnitAttribute(frequencyUnits),
+				},
+				Optional:    true,
+				Description: "Take snapshots with frequency specified in quarters.",
+			},
+			keyReplicationSpec: schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyAWSRegion: schema.StringAttribute{
+							Optional: true,
+							Description: "AWS region to replicate to. Should be specified in the standard AWS " +
+								"style, e.g. `us-west-2`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(gqlaws.AllRegionNames()...),
+							},
+						},
+						keyAWSCrossAccount: schema.StringAttribute{
+							Optional: true,
+							Description: "Replication target (RSC cloud account ID) for cross account replication. " +
+								"Omit for same account replication.",
+						},
+						keyAzureRegion: schema.StringAttribute{
+							Optional: true,
+							Description: "Azure region to replicate to. Should be specified in the standard " +
+								"Azure style, e.g. `eastus`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(gqlazure.AllRegionNames()...),
+							},
+						},
+						keyReplicationPair: schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									keySourceCluster: schema.StringAttribute{
+										Required:    true,
+										Description: "Source cluster ID (UUID).",
+										Validators: []validator.String{
+											isUUID(),
+										},
+									},
+									keyTargetCluster: schema.StringAttribute{
+										Required:    true,
+										Description: "Target cluster ID (UUID).",
+										Validators: []validator.String{
+											isUUID(),
+										},
+									},
+								},
+							},
+							Optional:    true,
+							Description: "Replication pairs specifying source and target clusters.",
+						},
+						keyRetention: slaRetentionAttribute(),
+						keyRetentionUnit: schema.StringAttribute{
+							Required: true,
+							Description: "Retention unit specifies the unit of `retention`. Possible values are " +
+								"`DAYS`, `WEEKS`, `MONTHS`, `QUARTERS` and `YEARS`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf(replicationUnits...),
+							},
+						},
+						keyLocalRetention: schema.SingleNestedAttribute{
+							Attributes: map[string]schema.Attribute{
+								keyRetention: schema.Int64Attribute{
+									Required: true,
+									Description: "Local retention on replication target specifies for how long " +
+										"the snapshots are kept on the replication target before being archived.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+								keyRetentionUnit: schema.StringAttribute{
+									Required: true,
+									Description: "Local retention unit. Possible values are `DAYS`, `WEEKS`, " +
+										"`MONTHS`, `QUARTERS` and `YEARS`.",
+									Validators: []validator.String{
+										stringvalidator.OneOf(replicationUnits...),
+									},
+								},
+							},
+							Optional:    true,
+							Description: "Local retention on replication target.",
+						},
+						keyCascadingArchival: schema.ListNestedAttribute{
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									keyArchivalLocationID: schema.StringAttribute{
+										Required:    true,
+										Description: "Archival location ID (UUID) for cascading archival.",
+										Validators: []validator.String{
+											isUUID(),
+										},
+									},
+									keyArchivalThreshold: schema.Int64Attribute{
+										Optional:    true,
+										Description: "Archival threshold specifies when to archive replicated snapshots.",
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									keyArchivalThresholdUnit: schema.StringAttribute{
+										Optional: true,
+										Description: "Archival threshold unit. Possible values are " +
+											"`DAYS`, `WEEKS`, `MONTHS`, `QUARTERS` and `YEARS`.",
+										Validators: []validator.String{
+											stringvalidator.OneOf(replicationUnits...),
+										},
+									},
+									keyArchivalTiering: slaArchivalTieringAttribute(),
+									keyFrequency: schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Frequencies for cascading archival. Possible values are " +
+											"`MINUTE`, `HOURS`, `DAYS`, `WEEKS`, `MONTHS`, `QUARTERS`, `YEARS`.",
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(stringvalidator.OneOf(frequencyUnits...)),
+										},
+									},
+								},
+							},
+							Optional:    true,
+							Description: "Cascading archival specifications for replication.",
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Replication specification for the SLA Domain.",
+			},
+			keyRetentionLock: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyMode: schema.StringAttribute{
+						Required:    true,
+						Description: "Retention lock mode. Possible values are `COMPLIANCE` and `GOVERNANCE`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(string(gqlsla.Compliance), string(gqlsla.Protection)),
+						},
+					},
+					keyRetentionLockComplianceAcknowledgment: schema.BoolAttribute{
+						Optional: true,
+						Description: "Acknowledgment that snapshots protected under compliance mode cannot be deleted " +
+							"before the scheduled expiry date. This field must be set to `true` when using `COMPLIANCE` mode. " +
+							"Compliance mode is recommended to meet regulations and governance mode is recommended to only " +
+							"protect data. Default value is `false`.\n\n" +
+							"!> **Warning:** Snapshots protected under compliance mode cannot be deleted before the scheduled expiry date.",
+					},
+				},
+				Optional: true,
+				Description: "Enable retention lock. Retention lock prevents data from being accidentally or " +
+					"maliciously modified or deleted during the retention period",
+			},
+			keyRetentionLockMode: schema.StringAttribute{
+				Computed: true,
+				Description: "Retention lock mode of the SLA Domain. Possible values are `NO_LOCK`, `GOVERNANCE` " +
+					"and `COMPLIANCE`.",
+			},
+			keySapHanaConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyIncrementalFrequency: slaOptionalDurationAttribute("Incremental backup frequency."),
+					keyIncrementalFrequencyUnit: slaUnitAttribute("Incremental frequency unit. Possible values are " +
+						"`DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyLogRetention: slaOptionalDurationAttribute("Log retention duration."),
+					keyLogRetentionUnit: slaUnitAttribute("Log retention unit. Possible values are `DAYS`, " +
+						"`WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyDifferentialFrequency: slaOptionalDurationAttribute("Differential backup frequency."),
+					keyDifferentialFrequencyUnit: slaUnitAttribute("Differential frequency unit. Possible values " +
+						"are `DAYS`, `WEEKS`, `MONTHS`, `YEARS`. Default is `DAYS`."),
+					keyStorageSnapshotConfig: slaFrequencyRetentionAttribute("SAP HANA storage snapshot configuration.",
+						"Storage snapshot frequency.", "Storage snapshot retention.", true),
+				},
+				Optional:    true,
+				Description: "SAP HANA database configuration.",
+			},
+			keySnapshotWindow: slaSnapshotWindowAttribute("Specifies an optional snapshot window.",
+				"Duration of the snapshot window in hours.", "Start of the snapshot window. Should be given as "+
+					"`HH:MM`, e.g: `15:30`.", false),
+			keyVMwareVMConfig: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyLogRetention: schema.Int64Attribute{
+						Optional:    true,
+						Description: "Log retention specifies for how long, in seconds, the log backups are kept.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					keySnapshotConsistency: schema.StringAttribute{
+						Optional: true,
+						Description: "Snapshot consistency of the virtual machines. Possible values are " +
+							"`CRASH_CONSISTENT`, `FILE_SYSTEM_CONSISTENT` and `APP_CONSISTENT`. Application " +
+							"consistent snapshots require VMware Tools to be installed in the virtual machines.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(slaSnapshotConsistencies...),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "VMware vSphere VM configuration.",
+				Validators: []validator.Object{
+					objectvalidator.AtLeastOneOf(
+						path.MatchRelative().AtName(keyLogRetention),
+						path.MatchRelative().AtName(keySnapshotConsistency),
+					),
+				},
+			},
+			keyWeeklySchedule: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyDayOfWeek: schema.StringAttribute{
+						Optional: true,
+						Description: "Day of week. Possible values are `MONDAY`, `TUESDAY`, `WEDNESDAY`, " +
+							"`THURSDAY`, `FRIDAY`, `SATURDAY` and `SUNDAY`. " +
+							"Note: For M365 Backup Storage SLAs, this field should be omitted.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(gqlsla.Monday),
+								string(gqlsla.Tuesday),
+								string(gqlsla.Wednesday),
+								string(gqlsla.Thursday),
+								string(gqlsla.Friday),
+								string(gqlsla.Saturday),
+								string(gqlsla.Sunday),
+							),
+						},
+					},
+					keyFrequency:     slaFrequencyAttribute("Frequency in weeks."),
+					keyRetention:     slaRetentionAttribute(),
+					keyRetentionUnit: slaRequiredRetentionUnitAttribute(frequencyUnits),
+				},
+				Optional:    true,
+				Description: "Take snapshots with frequency specified in weeks.",
+			},
+			keyYearlySchedule: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyDayOfYear: schema.StringAttribute{
+						Required:    true,
+						Description: "Day of year. Possible values are `FIRST_DAY` and `LAST_DAY`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(gqlsla.FirstDay, gqlsla.LastDay),
+						},
+					},
+					keyFrequency:     slaFrequencyAttribute("Frequency (years)."),
+					keyRetention:     slaRetentionAttribute(),
+					keyRetentionUnit: slaRequiredRetentionUnitAttribute(frequencyUnits),
+					keyYearStartMonth: schema.StringAttribute{
+						Required: true,
+						Description: "Year start month. Possible values are `JANUARY`, `FEBRUARY`, " +
+							"`MARCH`, `APRIL`, `MAY`, `JUNE`, `JULY`, `AUGUST`, `SEPTEMBER`, `OCTOBER`, " +
+							"`NOVEMBER` and `DECEMBER`.",
+						Validators: []validator.String{
+							stringvalidator.OneOf(months...),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Take snapshots with frequency specified in years. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.Object{
+					// The yearly schedule is unknown until ModifyPlan has
+					// planned the cloned value, which requires replacement
+					// when the cloned value changes.
+					objectplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.ObjectRequest, res *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+						res.RequiresReplace = !req.PlanValue.IsUnknown()
+					}, "", ""),
+				},
+			},
+		},
+		Version: 1,
+	}
+}
+
+// slaBasicScheduleAttribute returns the schema for the daily, hourly and
+// minute schedules, which only differ in the supported retention units.
+func slaBasicScheduleAttribute(description, frequencyDescription, retentionUnitDescription string, retentionUnits ...string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyFrequency: slaFrequencyAttribute(frequencyDescription),
+			keyRetention: slaRetentionAttribute(),
+			keyRetentionUnit: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(gqlsla.Days)),
+				Description: retentionUnitDescription,
+				Validators: []validator.String{
+					stringvalidator.OneOf(retentionUnits...),
+				},
+			},
+		},
+		Optional:    true,
+		Description: description,
+	}
+}
+
+func slaFrequencyAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required:    true,
+		Description: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func slaRetentionAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required:    true,
+		Description: "Retention specifies for how long the snapshots are kept.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+func slaRequiredRetentionUnitAttribute(retentionUnits []string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required: true,
+		Description: "Retention unit specifies the unit of `retention`. Possible values are " +
+			"`MINUTE`, `HOURS`, `DAYS`, `WEEKS`, `MONTHS`, `QUARTERS` and `YEARS`.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(retentionUnits...),
+		},
+	}
+}
+
+// slaOptionalDurationAttribute returns the schema for an optional duration of
+// a workload configuration. The unit is given by slaUnitAttribute.
+func slaOptionalDurationAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:    true,
+		Description: description,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(1),
+		},
+	}
+}
+
+// slaUnitAttribute returns the schema for the unit of a workload configuration
+// duration, defaulting to DAYS.
+func slaUnitAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString(string(gqlsla.Days)),
+		Description: description,
+		Validators: []validator.String{
+			stringvalidator.OneOf(gqlsla.AllRetentionUnitsAsStrings()...),
+		},
+	}
+}
+
+// slaLogRetentionAttribute returns the schema for a workload configuration
+// holding only a log retention.
+func slaLogRetentionAttribute(description, logRetentionDescription string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyLogRetention: schema.Int64Attribute{
+				Required:    true,
+				Description: logRetentionDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			keyLogRetentionUnit: slaUnitAttribute("Log retention unit. Possible values are `DAYS`, `WEEKS`, " +
+				"`MONTHS`, `YEARS`. Default is `DAYS`."),
+		},
+		Optional:    true,
+		Description: description,
+	}
+}
+
+// slaFrequencyRetentionAttribute returns the schema for a workload
+// configuration holding a frequency and a retention.
+func slaFrequencyRetentionAttribute(description, frequencyDescription, retentionDescription string, storageSnapshot bool) schema.SingleNestedAttribute {
+	retentionUnitDescription := "Retention unit. Possible values are `DAYS`, `WEEKS`, `MONTHS`, `YEARS`. " +
+		"Default is `DAYS`."
+	if storageSnapshot {
+		frequencyDescription = "Storage snapshot frequency."
+	}
+
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyFrequency: slaFrequencyAttribute(frequencyDescription),
+			keyFrequencyUnit: slaUnitAttribute("Frequency unit. Possible values are `DAYS`, `WEEKS`, `MONTHS`, " +
+				"`YEARS`. Default is `DAYS`."),
+			keyRetention: schema.Int64Attribute{
+				Required:    true,
+				Description: retentionDescription,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			keyRetentionUnit: slaUnitAttribute(retentionUnitDescription),
+		},
+		Optional:    true,
+		Description: description,
+	}
+}
+
+// slaSnapshotWindowAttribute returns the schema for the snapshot window and
+// the first full snapshot window.
+func slaSnapshotWindowAttribute(description, durationDescription, startAtDescription string, withDay bool) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				keyDuration: schema.Int64Attribute{
+					Required:    true,
+					Description: durationDescription,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+				keyStartAt: schema.StringAttribute{
+					Required:    true,
+					Description: startAtDescription,
+					// Snapshot windows with day of week are accepted by the API
+					// but not used by RSC causing inaccurate diffs if allowed.
+					Validators: []validator.String{
+						isStartAt(withDay),
+					},
+				},
+			},
+		},
+		Optional:    true,
+		Description: description,
+	}
+}
+
+func slaBackupLocationsAttribute(schedule string) schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Target location UUIDs for " + schedule + " schedule backups.",
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(isUUID()),
+		},
+	}
+}
+
+func slaArchivalTieringAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyInstantTiering: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Enable instant tiering to cold storage.",
+			},
+			keyMinAccessibleDurationInSeconds: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Minimum duration in seconds that data must remain accessible before tiering.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			keyColdStorageClass: schema.StringAttribute{
+				Optional: true,
+				Description: "Cold storage class for tiering. Possible values are " +
+					"`AZURE_ARCHIVE`, `AWS_GLACIER`, `AWS_GLACIER_DEEP_ARCHIVE`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(gqlsla.ColdStorageClassAzureArchive),
+						string(gqlsla.ColdStorageClassAWSGlacier),
+						string(gqlsla.ColdStorageClassAWSGlacierDeepArchive),
+					),
+				},
+			},
+			keyTierExistingSnapshots: schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to tier existing snapshots to cold storage.",
+			},
+		},
+		Optional:    true,
+		Description: "Archival tiering specification for cold storage.",
+	}
+}
+
+// slaLTRConfigAttribute returns the schema for the Azure SQL long-term
+// retention configuration.
+func slaLTRConfigAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyWeeklyRetention:  slaLTRRetentionAttribute("weekly"),
+			keyMonthlyRetention: slaLTRRetentionAttribute("monthly"),
+			keyYearlyRetention: schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					keyRetention:     slaLTRRetentionValueAttribute(),
+					keyRetentionUnit: slaLTRRetentionUnitAttribute(),
+					keyWeekOfYear: schema.Int64Attribute{
+						Required:    true,
+						Description: "The week of the year (1-52) to retain as the yearly backup.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 52),
+						},
+					},
+				},
+				Optional:    true,
+				Description: "The yearly Azure SQL long-term retention.",
+			},
+		},
+		Optional: true,
+		Description: "Long-term retention (LTR) configuration for a V1 (Azure-managed) Azure SQL SLA. When " +
+			"set, the SLA manages Azure native LTR backups and must not specify a Rubrik backup location or " +
+			"snapshot schedule. When omitted, the SLA is a V2 (Rubrik-managed) SLA.",
+	}
+}
+
+// slaLTRRetentionAttribute returns the schema for a single (weekly or monthly)
+// Azure SQL LTR retention.
+func slaLTRRetentionAttribute(period string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			keyRetention:     slaLTRRetentionValueAttribute(),
+			keyRetentionUnit: slaLTRRetentionUnitAttribute(),
+		},
+		Optional:    true,
+		Description: "The " + period + " Azure SQL long-term retention.",
+	}
+}
+
+// slaLTRRetentionValueAttribute returns the schema for an LTR retention value.
+// Azure accepts 0, or a value equivalent to between 7 and 3650 days; the exact
+// bound depends on the unit and is enforced by RSC/Azure.
+func slaLTRRetentionValueAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Required:    true,
+		Description: "Retention value in the configured retention unit. Azure accepts 0, or 7 to 3650 days.",
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+	}
+}
+
+func slaLTRRetentionUnitAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:    true,
+		Description: "Unit for the retention value. One of DAYS, WEEKS, MONTHS or YEARS.",
+		Validators: []validator.String{
+			stringvalidator.OneOf(
+				string(gqlsla.Days),
+				string(gqlsla.Weeks),
+				string(gqlsla.Months),
+				string(gqlsla.Years),
+			),
+		},
+	}
+}
+
+func (r *slaDomainResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	tflog.Trace(ctx, "slaDomainResource.ConfigValidators")
+
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot(keyDailySchedule),
+			path.MatchRoot(keyHourlySchedule),
+			path.MatchRoot(keyMinuteSchedule),
+			path.MatchRoot(keyMonthlySchedule),
+			path.MatchRoot(keyQuarterlySchedule),
+			path.MatchRoot(keyWeeklySchedule),
+			path.MatchRoot(keyYearlySchedule),
+			path.MatchRoot(keyAWSRDSConfig),                  // For AWS RDS, snapshot frequency is optional.
+			path.MatchRoot(keyAzureSQLDatabaseConfig),        // V1 (Azure-managed) Azure SQL DB SLAs may omit the schedule.
+			path.MatchRoot(keyAzureSQLManagedInstanceConfig), // V1 (Azure-managed) Azure SQL MI SLAs may omit the schedule.
+			path.MatchRoot(keyCloneFromID),                   // Schedules may be cloned.
+		),
+	}
+}
+
+func (r *slaDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client)
+}
+
+// ModifyPlan plans the cloned fields and the retention lock, checks the changes
+// against the retention lock, validates the SLA domain against the
+// capabilities of the object types protected by it, and rejects changing the
+// backup service of an existing Azure SQL SLA domain.
+func (r *slaDomainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	tflog.Trace(ctx, "slaDomainResource.ModifyPlan")
+
+	// Only the retention lock is checked on destroy.
+	if req.Plan.Raw.IsNull() {
+		validateRetentionLockDestroy(ctx, req, res)
+		return
+	}
+
+	r.planClone(ctx, req, res)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	planRetentionLock(ctx, req, res)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	config, ok, diags := slaDomainCapabilityConfigFromPlan(ctx, res.Plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if ok {
+		if err := validateSLAObjectTypeCapabilities(config); err != nil {
+			res.Diagnostics.AddError("Invalid SLA Domain", err.Error())
+			return
+		}
+	}
+
+	// On create any backup service is allowed.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	for _, key := range []string{keyAzureSQLDatabaseConfig, keyAzureSQLManagedInstanceConfig} {
+		var stateConfig, planConfig types.Object
+		res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(key), &stateConfig)...)
+		res.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(key), &planConfig)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+
+		// Config added or removed wholesale is not an in-place service flip.
+		if stateConfig.IsNull() || planConfig.IsNull() || planConfig.IsUnknown() {
+			continue
+		}
+		stateLTR, stateOK := stateConfig.Attributes()[keyLTRConfig]
+		planLTR, planOK := planConfig.Attributes()[keyLTRConfig]
+		if !stateOK || !planOK || planLTR.IsUnknown() {
+			continue
+		}
+		if stateLTR.IsNull() != planLTR.IsNull() {
+			res.Diagnostics.AddAttributeError(path.Root(key), "Invalid Azure SQL configuration",
+				"cannot change the backup service of an existing Azure SQL SLA Domain between Azure-managed "+
+					"(V1, with ltr_config) and Rubrik-managed (V2, without ltr_config); create a new SLA Domain "+
+					"to use a different backup service")
+			return
+		}
+	}
+}
+
+func (r *slaDomainResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Create")
+
+	var plan slaDomainModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	params, err := slaDomainParams(ctx, polarisClient, plan)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain", err.Error())
+		return
+	}
+	workload, err := plan.workloadConfigs()
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain", err.Error())
+		return
+	}
+
+	id, err := createSLADomain(ctx, polarisClient, params, workload)
+	if err != nil {
+		res.Diagnostics.AddError("Failed to create SLA Domain", err.Error())
+		return
+	}
+
+	// Save ID to state before read-back so Terraform can track the resource
+	// even if the read fails.
+	plan.ID = types.StringValue(id.String())
+	err = syncSLADomainPaused(ctx, polarisClient.GQL, id, &plan)
+	if errors.Is(err, errSLADomainNotSynced) {
+		res.Diagnostics.AddWarning("SLA Domain not paused", err.Error())
+		err = nil
+	}
+	if err != nil {
+		setSLADomainComputedNull(&plan)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		res.Diagnostics.AddError("Failed to pause SLA Domain", err.Error())
+		return
+	}
+	domain, err := sla.Wrap(polarisClient).DomainByID(ctx, id)
+	if err != nil {
+		setSLADomainComputedNull(&plan)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		res.Diagnostics.AddWarning("Failed to read SLA Domain after create",
+			fmt.Sprintf("The SLA Domain was created successfully but the computed fields could not be populated: %s", err.Error()))
+		return
+	}
+
+	res.Diagnostics.Append(setSLADomainComputed(&plan, domain)...)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+func (r *slaDomainResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Read")
+
+	var state slaDomainModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	id, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain ID", err.Error())
+		return
+	}
+
+	domain, err := sla.Wrap(polarisClient).DomainByID(ctx, id)
+	if errors.Is(err, graphql.ErrNotFound) {
+		res.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read SLA Domain", err.Error())
+		return
+	}
+
+	model, err := slaDomainModelFromDomain(domain, state)
+	if err != nil {
+		res.Diagnostics.AddError("Failed to read SLA Domain", err.Error())
+		return
+	}
+
+	// The workload configurations not read by the SDK are only read when
+	// managed, to avoid an additional request for every SLA domain.
+	if state.hasWorkloadConfigs() {
+		configs, err := slaDomainWorkloadConfigs(ctx, polarisClient.GQL, id)
+		if err != nil {
+			res.Diagnostics.AddError("Failed to read SLA Domain", err.Error())
+			return
+		}
+		if err := workloadConfigModels(configs, state, &model); err != nil {
+			res.Diagnostics.AddError("Failed to read SLA Domain", err.Error())
+			return
+		}
+	}
+
+	// The pause state is only read when managed. The pause state of an SLA
+	// domain which hasn't been synced to any Rubrik cluster is kept, since
+	// it's applied once the SLA domain has been synced.
+	if !state.Paused.IsNull() {
+		status, err := slaDomainPauseStatus(ctx, polarisClient.GQL, id)
+		switch {
+		case err != nil:
+			res.Diagnostics.AddWarning("Failed to read SLA Domain pause state", err.Error())
+		case len(status.ClusterIDs) > 0:
+			model.Paused = types.BoolValue(status.paused(nil))
+		}
+	}
+	res.Diagnostics.Append(res.State.Set(ctx, &model)...)
+}
+
+func (r *slaDomainResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Update")
+
+	var plan slaDomainModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Write-only attributes are only available in the configuration.
+	var applyToExisting, applyToNonPolicy types.Bool
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyApplyChangesToExistingSnapshots), &applyToExisting)...)
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyApplyChangesToNonPolicySnapshots), &applyToNonPolicy)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	id, err := uuid.Parse(plan.ID.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain ID", err.Error())
+		return
+	}
+
+	params, err := slaDomainParams(ctx, polarisClient, plan)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain", err.Error())
+		return
+	}
+	workload, err := plan.workloadConfigs()
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain", err.Error())
+		return
+	}
+
+	// When updating a data center archival, RSC requires the group ID to be
+	// set to nil.
+	for i, spec := range params.ArchivalSpecs {
+		if len(spec.ArchivalLocationToClusterMapping) > 0 {
+			params.ArchivalSpecs[i].GroupID = uuid.Nil
+		}
+	}
+
+	if err := updateSLADomain(ctx, polarisClient, gqlsla.UpdateDomainParams{
+		ID:                              id,
+		ShouldApplyToExistingSnapshots:  &gqlsla.BoolValue{Value: applyToExisting.ValueBool()},
+		ShouldApplyToNonPolicySnapshots: &gqlsla.BoolValue{Value: applyToExisting.ValueBool() && applyToNonPolicy.ValueBool()},
+		CreateDomainParams:              params,
+	}, workload); err != nil {
+		res.Diagnostics.AddError("Failed to update SLA Domain", err.Error())
+		return
+	}
+	err = syncSLADomainPaused(ctx, polarisClient.GQL, id, &plan)
+	if errors.Is(err, errSLADomainNotSynced) {
+		res.Diagnostics.AddWarning("SLA Domain not paused", err.Error())
+		err = nil
+	}
+	if err != nil {
+		setSLADomainComputedNull(&plan)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		res.Diagnostics.AddError("Failed to pause SLA Domain", err.Error())
+		return
+	}
+
+	domain, err := sla.Wrap(polarisClient).DomainByID(ctx, id)
+	if err != nil {
+		setSLADomainComputedNull(&plan)
+		res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+		res.Diagnostics.AddWarning("Failed to read SLA Domain after update",
+			fmt.Sprintf("The SLA Domain was updated successfully but the computed fields could not be refreshed: %s", err.Error()))
+		return
+	}
+
+	res.Diagnostics.Append(setSLADomainComputed(&plan, domain)...)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+func (r *slaDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	tflog.Trace(ctx, "slaDomainResource.Delete")
+
+	var state slaDomainModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	id, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA Domain ID", err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, slaDomainDeleteTimeout)
+	defer cancel()
+
+	// Wait for the SLA domain service to report zero assigned objects before
+	// deleting. This handles eventual consistency between the hierarchy
+	// service (which processes unassignments) and the SLA domain service
+	// (which enforces the "no assigned objects" precondition on delete).
+	for {
+		count, err := sla.Wrap(polarisClient).DomainObjectCount(ctx, id)
+		if errors.Is(err, graphql.ErrNotFound) {
+			return
+		}
+		if err != nil {
+			res.Diagnostics.AddError("Failed to count SLA Domain objects", err.Error())
+			return
+		}
+		if count == 0 {
+			break
+		}
+
+		tflog.Debug(ctx, "SLA domain still has assigned objects, waiting before delete", map[string]any{
+			"sla_id":       id.String(),
+			"object_count": count,
+		})
+
+		select {
+		case <-ctx.Done():
+			res.Diagnostics.AddError("Failed to delete SLA Domain", ctx.Err().Error())
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+
+	if err := sla.Wrap(polarisClient).DeleteDomain(ctx, id); err != nil {
+		res.Diagnostics.AddError("Failed to delete SLA Domain", err.Error())
+	}
+}
+
+// ImportState imports an SLA domain by ID (UUID) or name. If the import ID is
+// a valid UUID, the SLA domain is looked up by ID. Otherwise, the SLA domain
+// is looked up by name.
+func (r *slaDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	tflog.Trace(ctx, "slaDomainResource.ImportState")
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		// If it's not a UUID, treat it as a name and look up the SLA domain.
+		domain, err := sla.Wrap(polarisClient).DomainByName(ctx, req.ID)
+		if err != nil {
+			res.Diagnostics.AddError("Failed to import SLA Domain",
+				fmt.Sprintf("failed to find SLA domain by name %q: %s", req.ID, err))
+			return
+		}
+		id = domain.ID
+	} else if _, err := sla.Wrap(polarisClient).DomainByID(ctx, id); err != nil {
+		res.Diagnostics.AddError("Failed to import SLA Domain",
+			fmt.Sprintf("failed to find SLA domain by ID %q: %s", req.ID, err))
+		return
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root(keyID), id.String())...)
+}
+
+// slaDomainParams returns the parameters used to create or update the SLA
+// domain.
+func slaDomainParams(ctx context.Context, polarisClient *polaris.Client, plan slaDomainModel) (gqlsla.CreateDomainParams, error) {
+	schedule := plan.snapshotSchedule()
+	archivalSpecs, err := plan.archivalSpecs(schedule)
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	configs, err := plan.objectSpecificConfigs()
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	if configs.AzureBlobConfig, err = plan.azureBlobConfig(); err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	firstFullSnapshotWindows, err := backupWindows(plan.FirstFullSnapshot)
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	snapshotWindows, err := backupWindows(plan.SnapshotWindow)
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	replicationSpecs, err := plan.replicationSpecs()
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+
+	retentionLockMode := plan.retentionLockMode()
+	if retentionLockMode == gqlsla.Compliance && !plan.RetentionLock.ComplianceModeAcknowledgment.ValueBool() {
+		return gqlsla.CreateDomainParams{}, errors.New("compliance_mode_acknowledgment must be set to true " +
+			"when using COMPLIANCE mode. This acknowledges that snapshots protected under compliance mode " +
+			"cannot be deleted before the scheduled expiry date. Compliance mode is recommended to meet " +
+			"regulations and governance mode is recommended to only protect data.")
+	}
+
+	// AWS S3 is supported in two modes. The old mode uses a single backup
+	// location with object specific configuration. The new mode uses multiple
+	// backup locations.
+	mbl, err := core.Wrap(polarisClient.GQL).FeatureFlag(ctx, "CNP_AWS_S3_MULTIPLE_BACKUP_LOCATIONS_ENABLED")
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+	var backupLocations []gqlsla.BackupLocationSpec
+	if mbl.Enabled {
+		if backupLocations, err = plan.backupLocations(); err != nil {
+			return gqlsla.CreateDomainParams{}, err
+		}
+	} else {
+		if configs.AWSS3Config, err = plan.awsS3Config(); err != nil {
+			return gqlsla.CreateDomainParams{}, err
+		}
+	}
+
+	// The CNP_AZURE_SQL_SLA_REVAMP feature introduces the V1/V2 Azure SQL SLA
+	// model (ltr_config, and backup_location for SQL). When it is not enabled
+	// for the account, the provider keeps the legacy Azure SQL behavior so
+	// existing configurations are not broken.
+	azureSQLRevamp, err := core.Wrap(polarisClient.GQL).FeatureFlag(ctx, "CNP_AZURE_SQL_SLA_REVAMP")
+	if err != nil {
+		return gqlsla.CreateDomainParams{}, err
+	}
+
+	// Azure SQL V2 (Rubrik-managed) SLAs store their backup location in the
+	// SLA-level backup location specs, the same mechanism used by AWS S3
+	// multiple backup locations. V1 (Azure-managed) SLAs carry an LTR config
+	// and no backup location.
+	azureSQLConfig := configs.AzureSQLDatabaseDBConfig
+	azureSQLMIConfig := configs.AzureSQLManagedInstanceDBConfig
+	if azureSQLRevamp.Enabled && len(backupLocations) == 0 {
+		if (azureSQLConfig != nil && azureSQLConfig.LTRConfig == nil) ||
+			(azureSQLMIConfig != nil && azureSQLMIConfig.LTRConfig == nil) {
+			if backupLocations, err = plan.backupLocations(); err != nil {
+				return gqlsla.CreateDomainParams{}, err
+			}
+		}
+	}
+
+	// Per object type validation. The object type capabilities, e.g. archival
+	// and replication support, are validated by ModifyPlan.
+	objectTypes := plan.objectTypes()
+	for _, objectType := range objectTypes {
+		switch objectType {
+		case gqlsla.ObjectAzureSQLDatabase:
+			if err := validateAzureSQLDatabaseObjectType(azureSQLRevamp.Enabled, objectTypes, azureSQLConfig,
+				schedule, backupLocations, archivalSpecs, replicationSpecs); err != nil {
+				return gqlsla.CreateDomainParams{}, err
+			}
+		case gqlsla.ObjectAzureSQLManagedInstance:
+			if err := validateAzureSQLManagedInstanceObjectType(azureSQLRevamp.Enabled, objectTypes, azureSQLMIConfig,
+				azureSQLConfig, schedule, backupLocations, archivalSpecs, replicationSpecs); err != nil {
+				return gqlsla.CreateDomainParams{}, err
+			}
+		case gqlsla.ObjectAzureBlob:
+			if configs.AzureBlobConfig == nil {
+				return gqlsla.CreateDomainParams{}, errors.New("Azure Blob object type requires Azure Blob configuration")
+			}
+		case gqlsla.ObjectAWSS3:
+			if mbl.Enabled && len(backupLocations) == 0 {
+				return gqlsla.CreateDomainParams{}, errors.New("AWS S3 object type requires at least one backup location")
+			}
+			if !mbl.Enabled && configs.AWSS3Config == nil {
+				return gqlsla.CreateDomainParams{}, errors.New("AWS S3 object type requires AWS S3 configuration")
+			}
+		}
+	}
+
+	return gqlsla.CreateDomainParams{
+		ArchivalSpecs:          archivalSpecs,
+		BackupLocationSpecs:    backupLocations,
+		BackupWindows:          snapshotWindows,
+		Description:            plan.Description.ValueString(),
+		FirstFullBackupWindows: firstFullSnapshotWindows,
+		LocalRetentionLimit:    plan.localRetention(),
+		Name:                   plan.Name.ValueString(),
+		ObjectSpecificConfigs:  &configs,
+		ObjectTypes:            objectTypes,
+		ReplicationSpecs:       replicationSpecs,
+		RetentionLock:          retentionLockMode != "" && retentionLockMode != gqlsla.NoLock,
+		RetentionLockMode:      retentionLockMode,
+		SnapshotSchedule:       schedule,
+	}, nil
+}
+
+// setSLADomainComputed sets the computed attributes of the planned SLA domain
+// model from the SLA domain read back from RSC. Only values unknown in the
+// plan are set, so the configured values are kept as planned. Archival
+// specifications are matched by position.
+func setSLADomainComputed(plan *slaDomainModel, domain gqlsla.Domain) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	model, err := slaDomainModelFromDomain(domain, *plan)
+	if err != nil {
+		diags.AddWarning("Failed to read SLA Domain computed fields", err.Error())
+		setSLADomainComputedNull(plan)
+		return diags
+	}
+
+	plan.ID = model.ID
+	plan.BackupType = model.BackupType
+	if plan.RetentionLockMode.IsUnknown() {
+		plan.RetentionLockMode = model.RetentionLockMode
+	}
+	if plan.LockedAt.IsUnknown() {
+		plan.LockedAt = model.LockedAt
+	}
+	for i := range plan.Archival {
+		archival := &plan.Archival[i]
+		if i >= len(model.Archival) {
+			break
+		}
+		if archival.ArchivalLocationID.IsUnknown() {
+			archival.ArchivalLocationID = model.Archival[i].ArchivalLocationID
+		}
+		for j := range archival.ArchivalLocationToClusterMapping {
+			mapping := &archival.ArchivalLocationToClusterMapping[j]
+			if j >= len(model.Archival[i].ArchivalLocationToClusterMapping) {
+				break
+			}
+			readMapping := model.Archival[i].ArchivalLocationToClusterMapping[j]
+			if mapping.ClusterID.IsUnknown() {
+				mapping.ClusterID = readMapping.ClusterID
+			}
+			if mapping.ClusterName.IsUnknown() {
+				mapping.ClusterName = readMapping.ClusterName
+			}
+			if mapping.Name.IsUnknown() {
+				mapping.Name = readMapping.Name
+			}
+		}
+	}
+	setSLADomainComputedNull(plan)
+
+	return diags
+}
+
+// setSLADomainComputedNull sets the computed attributes of the planned SLA
+// domain model which are still unknown to null.
+func setSLADomainComputedNull(plan *slaDomainModel) {
+	if plan.BackupType.IsUnknown() {
+		plan.BackupType = types.StringNull()
+	}
+	if plan.RetentionLockMode.IsUnknown() {
+		plan.RetentionLockMode = types.StringNull()
+	}
+	if plan.LockedAt.IsUnknown() {
+		plan.LockedAt = types.StringNull()
+	}
+	for i := range plan.Archival {
+		archival := &plan.Archival[i]
+		if archival.ArchivalLocationID.IsUnknown() {
+			archival.ArchivalLocationID = types.StringNull()
+		}
+		for j := range archival.ArchivalLocationToClusterMapping {
+			mapping := &archival.ArchivalLocationToClusterMapping[j]
+			if mapping.ClusterID.IsUnknown() {
+				mapping.ClusterID = types.StringNull()
+			}
+			if mapping.ClusterName.IsUnknown() {
+				mapping.ClusterName = types.StringNull()
+			}
+			if mapping.Name.IsUnknown() {
+				mapping.Name = types.StringNull()
+			}
+		}
+	}
+}
+
+// syncSLADomainPaused pauses or resumes the SLA domain to match the planned
+// pause state. If the pause state isn't configured, the pause state isn't
+// read or changed. Returns errSLADomainNotSynced, keeping the planned pause
+// state, if pausing an SLA domain which hasn't been synced to any Rubrik
+// cluster. For other errors, the pause state of the model is set to the
+// current pause state.
+func syncSLADomainPaused(ctx context.Context, gql *graphql.Client, id uuid.UUID, plan *slaDomainModel) error {
+	if plan.Paused.IsNull() || plan.Paused.IsUnknown() {
+		return nil
+	}
+
+	status, err := slaDomainPauseStatus(ctx, gql, id)
+	if err != nil {
+		return err
+	}
+
+	paused := status.paused(nil)
+	if plan.Paused.ValueBool() == paused {
+		return nil
+	}
+	if len(status.ClusterIDs) == 0 {
+		return fmt.Errorf("SLA domain %s: %w", id, errSLADomainNotSynced)
+	}
+	if err := setSLADomainPaused(ctx, gql, id, status.ClusterIDs, plan.Paused.ValueBool()); err != nil {
+		plan.Paused = types.BoolValue(paused)
+		return err
+	}
+
+	return nil
+}
@@ -70,18 +70,18 @@ resource "polaris_sla_domain" "azure_sql_v1" {
 	description  = "V1 Azure-managed Azure SQL Database SLA"
 	object_types = ["AZURE_SQL_DATABASE_OBJECT_TYPE"]
 
-	azure_sql_database_config {
+	azure_sql_database_config = {
 		log_retention = 7
-		ltr_config {
-			weekly_retention {
+		ltr_config = {
+			weekly_retention = {
 				retention      = {{ .Resource.WeeklyRetention }}
 				retention_unit = "WEEKS"
 			}
-			monthly_retention {
+			monthly_retention = {
 				retention      = 12
 				retention_unit = "MONTHS"
 			}
-			yearly_retention {
+			yearly_retention = {
 				retention      = 7
 				retention_unit = "YEARS"
 				week_of_year   = 1
@@ -104,20 +104,20 @@ resource "polaris_sla_domain" "azure_sql_db_mi" {
 	description  = "V1 Azure SQL Database and Managed Instance SLA"
 	object_types = ["AZURE_SQL_DATABASE_OBJECT_TYPE", "AZURE_SQL_MANAGED_INSTANCE_OBJECT_TYPE"]
 
-	azure_sql_database_config {
+	azure_sql_database_config = {
 		log_retention = 7
-		ltr_config {
-			weekly_retention {
+		ltr_config = {
+			weekly_retention = {
 				retention      = 1
 				retention_unit = "WEEKS"
 			}
 		}
 	}
 
-	azure_sql_managed_instance_config {
+	azure_sql_managed_instance_config = {
 		log_retention = 7
-		ltr_config {
-			weekly_retention {
+		ltr_config = {
+			weekly_retention = {
 				retention      = 1
 				retention_unit = "WEEKS"
 			}
@@ -136,19 +136,19 @@ resource "polaris_sla_domain" "azure_sql_v2" {
 	description  = "V2 Rubrik-managed Azure SQL Database SLA"
 	object_types = ["AZURE_SQL_DATABASE_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 1
 		retention      = 1
 		retention_unit = "DAYS"
 	}
 
-	azure_sql_database_config {
+	azure_sql_database_config = {
 		log_retention = 7
 	}
 
-	backup_location {
+	backup_location = [{
 		archival_group_id = "{{ .Resource.BackupLocationGroupID }}"
-	}
+	}]
 }
 
 data "polaris_sla_domain" "azure_sql_v2" {
@@ -175,21 +175,21 @@ func TestAccPolarisSLADomain_azureSqlV1(t *testing.T) {
 	const ds = "data.polaris_sla_domain.azure_sql_v1"
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: create,
 			Check: resource.ComposeTestCheckFunc(
 				resource.TestCheckResourceAttr(res, "object_types.#", "1"),
 				resource.TestCheckResourceAttr(res, "backup_type", "NATIVE"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.log_retention", "7"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.weekly_retention.0.retention", "4"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.weekly_retention.0.retention_unit", "WEEKS"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.monthly_retention.0.retention", "12"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.yearly_retention.0.retention", "7"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.yearly_retention.0.week_of_year", "1"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.log_retention", "7"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.weekly_retention.retention", "4"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.weekly_retention.retention_unit", "WEEKS"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.monthly_retention.retention", "12"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.yearly_retention.retention", "7"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.yearly_retention.week_of_year", "1"),
 				// A V1 SLA has no Rubrik snapshot schedule.
-				resource.TestCheckResourceAttr(res, "daily_schedule.#", "0"),
-				resource.TestCheckResourceAttr(res, "hourly_schedule.#", "0"),
+				resource.TestCheckNoResourceAttr(res, "daily_schedule"),
+				resource.TestCheckNoResourceAttr(res, "hourly_schedule"),
 				// The data source returns the same values.
 				resource.TestCheckResourceAttr(ds, "backup_type", "NATIVE"),
 				resource.TestCheckResourceAttr(ds, "azure_sql_database_config.0.ltr_config.0.weekly_retention.0.retention", "4"),
@@ -197,7 +197,7 @@ func TestAccPolarisSLADomain_azureSqlV1(t *testing.T) {
 		}, {
 			Config: update,
 			Check: resource.ComposeTestCheckFunc(
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.weekly_retention.0.retention", "6"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.weekly_retention.retention", "6"),
 				resource.TestCheckResourceAttr(res, "backup_type", "NATIVE"),
 			),
 		}},
@@ -217,14 +217,14 @@ func TestAccPolarisSLADomain_azureSqlDbMi(t *testing.T) {
 	const res = "polaris_sla_domain.azure_sql_db_mi"
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: config,
 			Check: resource.ComposeTestCheckFunc(
 				resource.TestCheckResourceAttr(res, "object_types.#", "2"),
 				resource.TestCheckResourceAttr(res, "backup_type", "NATIVE"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.0.weekly_retention.0.retention", "1"),
-				resource.TestCheckResourceAttr(res, "azure_sql_managed_instance_config.0.ltr_config.0.weekly_retention.0.retention", "1"),
+				resource.TestCheckResourceAttr(res, "azure_sql_database_config.ltr_config.weekly_retention.retention", "1"),
+				resource.TestCheckResourceAttr(res, "azure_sql_managed_instance_config.ltr_config.weekly_retention.retention", "1"),
 			),
 		}},
 	})
@@ -251,14 +251,14 @@ func TestAccPolarisSLADomain_azureSqlV2(t *testing.T) {
 	const ds = "data.polaris_sla_domain.azure_sql_v2"
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: config,
 			Check: resource.ComposeTestCheckFunc(
 				resource.TestCheckResourceAttr(res, "backup_type", "RUBRIK"),
-				resource.TestCheckResourceAttr(res, "azure_sql_database_config.0.ltr_config.#", "0"),
+				resource.TestCheckNoResourceAttr(res, "azure_sql_database_config.ltr_config"),
 				resource.TestCheckResourceAttr(res, "backup_location.0.archival_group_id", groupID),
-				resource.TestCheckResourceAttr(res, "hourly_schedule.0.frequency", "1"),
+				resource.TestCheckResourceAttr(res, "hourly_schedule.frequency", "1"),
 				resource.TestCheckResourceAttr(ds, "backup_type", "RUBRIK"),
 			),
 		}},
@@ -0,0 +1,201 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+// slaDomainCloneKeys holds the fields of the SLA domain resource which are
+// copied from the SLA domain specified by clone_from_id. The name,
+// description, object types and retention lock are never cloned, retention
+// lock must always be explicitly configured.
+var slaDomainCloneKeys = []string{
+	keyArchival,
+	keyAWSDynamoDBConfig,
+	keyAWSRDSConfig,
+	keyAzureBlobConfig,
+	keyAzureSQLDatabaseConfig,
+	keyAzureSQLManagedInstanceConfig,
+	keyBackupLocation,
+	keyDailySchedule,
+	keyDB2Config,
+	keyExchangeConfig,
+	keyFirstFullSnapshot,
+	keyGCPCloudSQLConfig,
+	keyHourlySchedule,
+	keyInformixConfig,
+	keyKubernetesConfig,
+	keyLocalRetention,
+	keyManagedVolumeConfig,
+	keyMicrosoft365Config,
+	keyMinuteSchedule,
+	keyMongoConfig,
+	keyMonthlySchedule,
+	keyMSSQLConfig,
+	keyMySQLDBConfig,
+	keyNCDConfig,
+	keyOracleConfig,
+	keyPostgresDBClusterConfig,
+	keyQuarterlySchedule,
+	keyReplicationSpec,
+	keySapHanaConfig,
+	keySnapshotWindow,
+	keyVMwareVMConfig,
+	keyWeeklySchedule,
+	keyYearlySchedule,
+}
+
+// planClone plans the fields which can be cloned but aren't specified in the
+// configuration. When clone_from_id is specified, the fields are planned from
+// the SLA domain with the ID, otherwise the fields are planned as null. The
+// fields are computed, so without this the prior state would be kept when a
+// field is removed from the configuration.
+func (r *slaDomainResource) planClone(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	var configValues, planValues map[string]tftypes.Value
+	if err := req.Config.Raw.As(&configValues); err != nil {
+		res.Diagnostics.AddError("Failed to read configuration", err.Error())
+		return
+	}
+	if err := res.Plan.Raw.As(&planValues); err != nil {
+		res.Diagnostics.AddError("Failed to read plan", err.Error())
+		return
+	}
+
+	var cloneFromID types.String
+	res.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyCloneFromID), &cloneFromID)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	var templateValues map[string]tftypes.Value
+	if !cloneFromID.IsNull() && !cloneFromID.IsUnknown() {
+		polarisClient, err := r.client.polaris()
+		if err != nil {
+			res.Diagnostics.AddError("RSC client error", err.Error())
+			return
+		}
+		id, err := uuid.Parse(cloneFromID.ValueString())
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root(keyCloneFromID), "Invalid SLA Domain ID", err.Error())
+			return
+		}
+		domain, err := sla.Wrap(polarisClient).DomainByID(ctx, id)
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root(keyCloneFromID), "Failed to read SLA Domain to clone",
+				err.Error())
+			return
+		}
+		workload, err := slaDomainWorkloadConfigs(ctx, polarisClient.GQL, id)
+		if err != nil {
+			res.Diagnostics.AddAttributeError(path.Root(keyCloneFromID), "Failed to read SLA Domain to clone",
+				err.Error())
+			return
+		}
+		var diags diag.Diagnostics
+		templateValues, diags = slaDomainTemplateValues(ctx, domain, workload)
+		res.Diagnostics.Append(diags...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var stateValues map[string]tftypes.Value
+	if !req.State.Raw.IsNull() {
+		if err := req.State.Raw.As(&stateValues); err != nil {
+			res.Diagnostics.AddError("Failed to read state", err.Error())
+			return
+		}
+	}
+
+	for _, key := range slaDomainCloneKeys {
+		if !configValues[key].IsNull() {
+			continue
+		}
+
+		typ := planValues[key].Type()
+		switch {
+		case cloneFromID.IsUnknown():
+			planValues[key] = tftypes.NewValue(typ, tftypes.UnknownValue)
+		case cloneFromID.IsNull():
+			planValues[key] = tftypes.NewValue(typ, nil)
+		default:
+			planValues[key] = templateValues[key]
+		}
+
+		// Changing the yearly schedule requires replacement, the attribute
+		// plan modifier skips values which are unknown until planned here.
+		if key == keyYearlySchedule && stateValues != nil && planValues[key].IsKnown() &&
+			!planValues[key].Equal(stateValues[key]) {
+			res.RequiresReplace = append(res.RequiresReplace, path.Root(key))
+		}
+	}
+
+	res.Plan.Raw = tftypes.NewValue(res.Plan.Raw.Type(), planValues)
+}
+
+// slaDomainTemplateValues returns the values of the SLA domain, including the
+// workload configurations not yet read by the SDK, in the shape of the SLA
+// domain resource schema, keyed by field name.
+func slaDomainTemplateValues(ctx context.Context, domain gqlsla.Domain, workload slaWorkloadConfigsReply) (map[string]tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model, err := slaDomainModelFromDomain(domain, slaDomainModel{})
+	if err != nil {
+		diags.AddError("Failed to read SLA Domain", err.Error())
+		return nil, diags
+	}
+	if err := workloadConfigModels(workload, slaDomainModel{}, &model); err != nil {
+		diags.AddError("Failed to read SLA Domain", err.Error())
+		return nil, diags
+	}
+	// The pause state isn't part of the template.
+	model.Paused = types.BoolNull()
+
+	s := slaDomainSchema()
+	state := tfsdk.State{
+		Schema: s,
+		Raw:    tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+	}
+	diags.Append(state.Set(ctx, &model)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var values map[string]tftypes.Value
+	if err := state.Raw.As(&values); err != nil {
+		diags.AddError("Failed to read SLA Domain", fmt.Sprintf("failed to convert SLA Domain: %s", err))
+		return nil, diags
+	}
+
+	return values, diags
+}
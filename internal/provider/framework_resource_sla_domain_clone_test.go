@@ -0,0 +1,232 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+func slaDomainRawValue(t *testing.T, model slaDomainModel) tftypes.Value {
+	t.Helper()
+
+	ctx := context.Background()
+	s := slaDomainSchema()
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("failed to set model: %v", diags)
+	}
+
+	return state.Raw
+}
+
+func testSLADomainModel() slaDomainModel {
+	return slaDomainModel{
+		Name:        types.StringValue("test"),
+		ObjectTypes: setFromStrings([]string{string(gqlsla.ObjectAWSEC2EBS)}),
+		DailySchedule: &slaBasicScheduleModel{
+			Frequency:     types.Int64Value(1),
+			Retention:     types.Int64Value(7),
+			RetentionUnit: types.StringValue(string(gqlsla.Days)),
+		},
+	}
+}
+
+// TestSLADomainCloneKeys verifies that the fields which can be cloned are
+// nested attributes of the schema, which are made computed so that the cloned
+// values can be planned.
+func TestSLADomainCloneKeys(t *testing.T) {
+	base := slaDomainBaseSchema()
+	s := slaDomainSchema()
+	for _, key := range slaDomainCloneKeys {
+		switch base.Attributes[key].(type) {
+		case schema.SingleNestedAttribute, schema.ListNestedAttribute:
+		default:
+			t.Errorf("unsupported clone attribute %q: %T", key, base.Attributes[key])
+			continue
+		}
+		if !s.Attributes[key].IsComputed() {
+			t.Errorf("expected clone attribute %q to be computed", key)
+		}
+	}
+}
+
+// TestSLADomainPlanCloneWithoutCloneFromID verifies that fields which can be
+// cloned are planned as null when not configured and clone_from_id isn't
+// specified, so removing a field from the configuration isn't masked by the
+// field being computed.
+func TestSLADomainPlanCloneWithoutCloneFromID(t *testing.T) {
+	ctx := context.Background()
+	s := slaDomainSchema()
+
+	config := testSLADomainModel()
+	state := testSLADomainModel()
+	state.HourlySchedule = &slaBasicScheduleModel{
+		Frequency:     types.Int64Value(4),
+		Retention:     types.Int64Value(24),
+		RetentionUnit: types.StringValue(string(gqlsla.Hours)),
+	}
+	state.YearlySchedule = &slaYearlyScheduleModel{
+		DayOfYear:      types.StringValue(gqlsla.LastDay),
+		Frequency:      types.Int64Value(1),
+		Retention:      types.Int64Value(2),
+		RetentionUnit:  types.StringValue(string(gqlsla.Years)),
+		YearStartMonth: types.StringValue(string(gqlsla.January)),
+	}
+
+	req := resource.ModifyPlanRequest{
+		Config: tfsdk.Config{Schema: s, Raw: slaDomainRawValue(t, config)},
+		Plan:   tfsdk.Plan{Schema: s, Raw: slaDomainRawValue(t, state)},
+		State:  tfsdk.State{Schema: s, Raw: slaDomainRawValue(t, state)},
+	}
+	res := resource.ModifyPlanResponse{Plan: req.Plan}
+	(&slaDomainResource{}).planClone(ctx, req, &res)
+	if res.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", res.Diagnostics)
+	}
+
+	var plan slaDomainModel
+	if diags := res.Plan.Get(ctx, &plan); diags.HasError() {
+		t.Fatalf("failed to get plan: %v", diags)
+	}
+	if plan.DailySchedule == nil || plan.DailySchedule.Retention.ValueInt64() != 7 {
+		t.Errorf("expected the configured daily schedule to be kept, got %v", plan.DailySchedule)
+	}
+	if plan.HourlySchedule != nil {
+		t.Errorf("expected the hourly schedule to be planned as null, got %v", plan.HourlySchedule)
+	}
+	if plan.YearlySchedule != nil {
+		t.Errorf("expected the yearly schedule to be planned as null, got %v", plan.YearlySchedule)
+	}
+
+	if len(res.RequiresReplace) != 1 || !res.RequiresReplace[0].Equal(path.Root(keyYearlySchedule)) {
+		t.Errorf("expected removing the yearly schedule to require replacement, got %v", res.RequiresReplace)
+	}
+}
+
+// TestSLADomainTemplateValues verifies that an SLA domain read from RSC, with
+// the workload configurations, is converted to values in the shape of the
+// resource schema.
+func TestSLADomainTemplateValues(t *testing.T) {
+	ctx := context.Background()
+
+	domain := gqlsla.Domain{
+		Name:        "template",
+		ObjectTypes: []gqlsla.ObjectType{gqlsla.ObjectAWSEC2EBS},
+		SnapshotSchedule: gqlsla.SnapshotSchedule{
+			Daily: &gqlsla.DailySnapshotSchedule{
+				BasicSchedule: gqlsla.BasicSnapshotSchedule{
+					Frequency:     1,
+					Retention:     30,
+					RetentionUnit: gqlsla.Days,
+				},
+			},
+		},
+	}
+	workload := slaWorkloadConfigsReply{
+		ExchangeConfig: &slaExchangeConfig{
+			LogFrequency: gqlsla.RetentionDuration{Duration: 30, Unit: gqlsla.Minute},
+			LogRetention: gqlsla.RetentionDuration{Duration: 7, Unit: gqlsla.Days},
+		},
+		K8sConfig:      &slaK8sConfig{IncludePVCs: true},
+		VMwareVMConfig: &slaVMwareVMConfig{SnapshotConsistencyMandate: slaSnapshotConsistencyApp},
+	}
+	values, diags := slaDomainTemplateValues(ctx, domain, workload)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	for _, key := range slaDomainCloneKeys {
+		if _, ok := values[key]; !ok {
+			t.Errorf("expected a value for %s", key)
+		}
+	}
+	if values[keyDailySchedule].IsNull() {
+		t.Fatalf("expected the daily schedule to be set")
+	}
+	if !values[keyHourlySchedule].IsNull() {
+		t.Errorf("expected the hourly schedule to be null, got %v", values[keyHourlySchedule])
+	}
+	if !values[keyPaused].IsNull() {
+		t.Errorf("expected paused to be null, got %v", values[keyPaused])
+	}
+	for _, key := range []string{keyExchangeConfig, keyKubernetesConfig, keyVMwareVMConfig} {
+		if values[key].IsNull() {
+			t.Errorf("expected %s to be set", key)
+		}
+	}
+	if !values[keyMicrosoft365Config].IsNull() {
+		t.Errorf("expected %s to be null, got %v", keyMicrosoft365Config, values[keyMicrosoft365Config])
+	}
+
+	var vmware map[string]tftypes.Value
+	if err := values[keyVMwareVMConfig].As(&vmware); err != nil {
+		t.Fatal(err)
+	}
+	if !vmware[keySnapshotConsistency].Equal(tftypes.NewValue(tftypes.String, slaSnapshotConsistencyApp)) {
+		t.Errorf("expected a snapshot consistency of %s, got %v", slaSnapshotConsistencyApp,
+			vmware[keySnapshotConsistency])
+	}
+
+	var daily map[string]tftypes.Value
+	if err := values[keyDailySchedule].As(&daily); err != nil {
+		t.Fatal(err)
+	}
+	if !daily[keyRetention].Equal(tftypes.NewValue(tftypes.Number, 30)) {
+		t.Errorf("expected a daily retention of 30, got %v", daily[keyRetention])
+	}
+}
+
+// TestSLADomainTemplateObject verifies that the template object holds the
+// object types and the fields which can be cloned.
+func TestSLADomainTemplateObject(t *testing.T) {
+	ctx := context.Background()
+
+	domain := gqlsla.Domain{
+		Name:        "template",
+		ObjectTypes: []gqlsla.ObjectType{gqlsla.ObjectAWSEC2EBS},
+	}
+	template, diags := slaDomainTemplateObject(ctx, domain, slaWorkloadConfigsReply{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	attrs := template.Attributes()
+	if len(attrs) != len(slaDomainCloneKeys)+1 {
+		t.Errorf("expected %d fields, got %d", len(slaDomainCloneKeys)+1, len(attrs))
+	}
+	if got := attrs[keyObjectTypes].String(); got != `["AWS_EC2_EBS_OBJECT_TYPE"]` {
+		t.Errorf("unexpected object types: %s", got)
+	}
+	for _, key := range []string{keyName, keyDescription, keyRetentionLock, keyCloneFromID} {
+		if _, ok := attrs[key]; ok {
+			t.Errorf("expected %s not to be part of the template", key)
+		}
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// Acceptance test templates
+
+const slaDomainBasicTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain Basic"
+	description = "Basic SLA Domain for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
+
+	hourly_schedule = {
+		frequency      = 4
+		retention      = 24
+		retention_unit = "HOURS"
+	}
+
+	daily_schedule = {
+		frequency      = 1
+		retention      = 7
+		retention_unit = "DAYS"
+	}
+
+	weekly_schedule = {
+		day_of_week    = "MONDAY"
+		frequency      = 1
+		retention      = 4
+		retention_unit = "WEEKS"
+	}
+
+	monthly_schedule = {
+		day_of_month   = "FIRST_DAY"
+		frequency      = 1
+		retention      = 12
+		retention_unit = "MONTHS"
+	}
+}
+
+data "polaris_sla_domain" "default_by_id" {
+	id = polaris_sla_domain.default.id
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+const slaDomainWithArchivalTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain with Archival"
+	description = "SLA Domain with archival for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
+
+	hourly_schedule = {
+		frequency      = 4
+		retention      = 24
+		retention_unit = "HOURS"
+	}
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+const slaDomainWithSnapshotWindowTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain with Snapshot Window"
+	description = "SLA Domain with snapshot window for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
+
+	daily_schedule = {
+		frequency      = 1
+		retention      = 7
+		retention_unit = "DAYS"
+	}
+
+	snapshot_window = [{
+		start_at = "14:30"
+		duration = 2
+	}]
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+const slaDomainWithWeeklySnapshotWindowTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain with Weekly Snapshot Window"
+	description = "SLA Domain with weekly snapshot window for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
+
+	weekly_schedule = {
+		day_of_week    = "MONDAY"
+		frequency      = 1
+		retention      = 4
+		retention_unit = "WEEKS"
+	}
+
+	snapshot_window = [{
+		start_at = "09:00"
+		duration = 4
+	}]
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+const slaDomainUpdatedTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain Updated"
+	description = "Updated SLA Domain for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
+
+	hourly_schedule = {
+		frequency      = 6
+		retention      = 48
+		retention_unit = "HOURS"
+	}
+
+	daily_schedule = {
+		frequency      = 2
+		retention      = 14
+		retention_unit = "DAYS"
+	}
+}
+
+data "polaris_sla_domain" "default_by_id" {
+	id = polaris_sla_domain.default.id
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+const slaDomainMultipleObjectTypesTmpl = `
+provider "polaris" {
+	credentials = "{{ .Provider.Credentials }}"
+}
+
+resource "polaris_sla_domain" "default" {
+	name        = "Test SLA Domain Multiple Object Types"
+	description = "SLA Domain with multiple object types for testing"
+	object_types = ["AWS_EC2_EBS_OBJECT_TYPE", "AZURE_OBJECT_TYPE", "GCP_OBJECT_TYPE"]
+
+	hourly_schedule = {
+		frequency      = 4
+		retention      = 24
+		retention_unit = "HOURS"
+	}
+
+	daily_schedule = {
+		frequency      = 1
+		retention      = 7
+		retention_unit = "DAYS"
+	}
+}
+
+data "polaris_sla_domain" "default_by_id" {
+	id = polaris_sla_domain.default.id
+}
+
+data "polaris_sla_domain" "default_by_name" {
+	name = polaris_sla_domain.default.name
+}
+`
+
+// Acceptance test functions
+
+func TestAccPolarisSLADomain_basic(t *testing.T) {
+	config, _, err := loadRSCTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainBasic, err := makeTerraformConfig(config, slaDomainBasicTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainUpdated, err := makeTerraformConfig(config, slaDomainUpdatedTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: slaDomainBasic,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain Basic"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "Basic SLA Domain for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "object_types.#", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.frequency", "4"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention", "24"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention_unit", "HOURS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention", "7"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention_unit", "DAYS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.day_of_week", "MONDAY"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.retention", "4"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.retention_unit", "WEEKS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "monthly_schedule.day_of_month", "FIRST_DAY"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "monthly_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "monthly_schedule.retention", "12"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "monthly_schedule.retention_unit", "MONTHS"),
+				// Data source checks (by ID)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "name", "Test SLA Domain Basic"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "description", "Basic SLA Domain for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "object_types.#", "1"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "hourly_schedule.0.frequency", "4"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "daily_schedule.0.frequency", "1"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "weekly_schedule.0.day_of_week", "MONDAY"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "monthly_schedule.0.day_of_month", "FIRST_DAY"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain Basic"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "Basic SLA Domain for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "object_types.#", "1"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "hourly_schedule.0.frequency", "4"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "daily_schedule.0.frequency", "1"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "weekly_schedule.0.day_of_week", "MONDAY"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "monthly_schedule.0.day_of_month", "FIRST_DAY"),
+			),
+		}, {
+			Config: slaDomainUpdated,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain Updated"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "Updated SLA Domain for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.frequency", "6"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention", "48"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention_unit", "HOURS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.frequency", "2"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention", "14"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention_unit", "DAYS"),
+				// Data source checks (by ID)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "name", "Test SLA Domain Updated"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "description", "Updated SLA Domain for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "hourly_schedule.0.frequency", "6"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "daily_schedule.0.frequency", "2"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain Updated"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "Updated SLA Domain for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "hourly_schedule.0.frequency", "6"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "daily_schedule.0.frequency", "2"),
+			),
+		}},
+	})
+}
+
+func TestAccPolarisSLADomain_multipleObjectTypes(t *testing.T) {
+	config, _, err := loadRSCTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainMultipleObjectTypes, err := makeTerraformConfig(config, slaDomainMultipleObjectTypesTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: slaDomainMultipleObjectTypes,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain Multiple Object Types"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "SLA Domain with multiple object types for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "object_types.#", "3"),
+				resource.TestCheckTypeSetElemAttr("polaris_sla_domain.default", "object_types.*", "AWS_EC2_EBS_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("polaris_sla_domain.default", "object_types.*", "AZURE_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("polaris_sla_domain.default", "object_types.*", "GCP_OBJECT_TYPE"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.frequency", "4"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention", "24"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention_unit", "HOURS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention", "7"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention_unit", "DAYS"),
+				// Data source checks (by ID)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "name", "Test SLA Domain Multiple Object Types"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "description", "SLA Domain with multiple object types for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "object_types.#", "3"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_id", "object_types.*", "AWS_EC2_EBS_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_id", "object_types.*", "AZURE_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_id", "object_types.*", "GCP_OBJECT_TYPE"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "hourly_schedule.0.frequency", "4"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_id", "daily_schedule.0.frequency", "1"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain Multiple Object Types"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "SLA Domain with multiple object types for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "object_types.#", "3"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_name", "object_types.*", "AWS_EC2_EBS_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_name", "object_types.*", "AZURE_OBJECT_TYPE"),
+				resource.TestCheckTypeSetElemAttr("data.polaris_sla_domain.default_by_name", "object_types.*", "GCP_OBJECT_TYPE"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "hourly_schedule.0.frequency", "4"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "daily_schedule.0.frequency", "1"),
+			),
+		}},
+	})
+}
+
+func TestAccPolarisSLADomain_withArchival(t *testing.T) {
+	config, _, err := loadRSCTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainWithArchival, err := makeTerraformConfig(config, slaDomainWithArchivalTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: slaDomainWithArchival,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain with Archival"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "SLA Domain with archival for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.frequency", "4"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention", "24"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "hourly_schedule.retention_unit", "HOURS"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain with Archival"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "SLA Domain with archival for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "hourly_schedule.0.frequency", "4"),
+			),
+		}},
+	})
+}
+
+func TestAccPolarisSLADomain_withSnapshotWindow(t *testing.T) {
+	config, _, err := loadRSCTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainWithSnapshotWindow, err := makeTerraformConfig(config, slaDomainWithSnapshotWindowTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: slaDomainWithSnapshotWindow,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain with Snapshot Window"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "SLA Domain with snapshot window for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention", "7"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "daily_schedule.retention_unit", "DAYS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "snapshot_window.0.start_at", "14:30"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "snapshot_window.0.duration", "2"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain with Snapshot Window"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "SLA Domain with snapshot window for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "daily_schedule.0.frequency", "1"),
+			),
+		}},
+	})
+}
+
+func TestAccPolarisSLADomain_withWeeklySnapshotWindow(t *testing.T) {
+	config, _, err := loadRSCTestConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	slaDomainWithWeeklySnapshotWindow, err := makeTerraformConfig(config, slaDomainWithWeeklySnapshotWindowTmpl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
+		Steps: []resource.TestStep{{
+			Config: slaDomainWithWeeklySnapshotWindow,
+			Check: resource.ComposeTestCheckFunc(
+				// Resource checks
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "name", "Test SLA Domain with Weekly Snapshot Window"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "description", "SLA Domain with weekly snapshot window for testing"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.day_of_week", "MONDAY"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.frequency", "1"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.retention", "4"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "weekly_schedule.retention_unit", "WEEKS"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "snapshot_window.0.start_at", "09:00"),
+				resource.TestCheckResourceAttr("polaris_sla_domain.default", "snapshot_window.0.duration", "4"),
+				// Data source checks (by name)
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "name", "Test SLA Domain with Weekly Snapshot Window"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "description", "SLA Domain with weekly snapshot window for testing"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "weekly_schedule.0.day_of_week", "MONDAY"),
+				resource.TestCheckResourceAttr("data.polaris_sla_domain.default_by_name", "weekly_schedule.0.frequency", "1"),
+			),
+		}},
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// UpgradeState upgrades the state of the SLA domain resource to the latest
+// version. Note: each StateUpgrader must upgrade directly from its prior
+// version to the current version. The framework does not chain through
+// intermediate versions.
+func (r *slaDomainResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	tflog.Trace(ctx, "slaDomainResource.UpgradeState")
+
+	return map[int64]resource.StateUpgrader{
+		0: r.upgradeStateV0(),
+	}
+}
+
+// upgradeStateV0 upgrades v0 state, written by the SDKv2 implementation of
+// the resource, to v1. In v0 the nested configuration was stored as lists of
+// blocks and unset optional values as zero values. In v1 single nested blocks
+// are objects and unset optional values are null.
+func (r *slaDomainResource) upgradeStateV0() resource.StateUpgrader {
+	return resource.StateUpgrader{
+		// The prior schema is left out since the SDKv2 block structure can't be
+		// expressed with framework attributes, the raw JSON state is used
+		// instead.
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, res *resource.UpgradeStateResponse) {
+			tflog.Trace(ctx, "slaDomainResource.upgradeStateV0")
+
+			if req.RawState == nil {
+				res.Diagnostics.AddError("State upgrade failed", "missing raw state")
+				return
+			}
+
+			buf, err := upgradeSLADomainStateV0(req.RawState.JSON)
+			if err != nil {
+				res.Diagnostics.AddError("State upgrade failed", err.Error())
+				return
+			}
+			res.DynamicValue = &tfprotov6.DynamicValue{JSON: buf}
+		},
+	}
+}
+
+// upgradeSLADomainStateV0 converts the raw JSON v0 state to raw JSON v1
+// state.
+func upgradeSLADomainStateV0(buf []byte) ([]byte, error) {
+	var prior map[string]any
+	if err := json.Unmarshal(buf, &prior); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(upgradeSLADomainAttributesV0(slaDomainSchema().Attributes, prior))
+}
+
+// slaDomainZeroValueAttributesV0 holds the numeric attributes for which zero
+// is a valid configured value in v0. SDKv2 stores unset numeric values as
+// zero, but for these attributes a zero in v0 state can't be told apart from
+// a configured zero, so the zero is kept.
+var slaDomainZeroValueAttributesV0 = map[string]struct{}{
+	keyMinAccessibleDurationInSeconds: {},
+}
+
+// upgradeSLADomainAttributesV0 converts the v0 state values of the attributes
+// to their v1 representation. Values of attributes no longer in the schema,
+// e.g. timeouts, are dropped.
+func upgradeSLADomainAttributesV0(attrs map[string]schema.Attribute, prior map[string]any) map[string]any {
+	state := make(map[string]any, len(attrs))
+	for name, attr := range attrs {
+		state[name] = upgradeSLADomainValueV0(name, attr, prior[name])
+	}
+
+	return state
+}
+
+// upgradeSLADomainValueV0 converts the v0 state value of an attribute to its
+// v1 representation.
+func upgradeSLADomainValueV0(name string, attr schema.Attribute, value any) any {
+	// Write-only values are never stored in state.
+	if value == nil || attr.IsWriteOnly() {
+		return nil
+	}
+
+	switch attr := attr.(type) {
+	case schema.SingleNestedAttribute:
+		blocks, ok := value.([]any)
+		if !ok || len(blocks) == 0 {
+			return nil
+		}
+		block, ok := blocks[0].(map[string]any)
+		if !ok {
+			return nil
+		}
+		return upgradeSLADomainAttributesV0(attr.Attributes, block)
+	case schema.ListNestedAttribute:
+		blocks, ok := value.([]any)
+		if !ok || len(blocks) == 0 {
+			return nil
+		}
+		objects := make([]any, 0, len(blocks))
+		for _, block := range blocks {
+			if block, ok := block.(map[string]any); ok {
+				objects = append(objects, upgradeSLADomainAttributesV0(attr.NestedObject.Attributes, block))
+			}
+		}
+		return objects
+	case schema.ListAttribute, schema.SetAttribute:
+		if values, ok := value.([]any); !ok || len(values) == 0 {
+			return nil
+		}
+		return value
+	}
+
+	// SDKv2 stores unset optional values as zero values. Computed values are
+	// kept, except for empty strings, since they are refreshed on read. Zero
+	// numeric values are kept for attributes accepting a configured zero.
+	var zero bool
+	switch value := value.(type) {
+	case string:
+		zero = value == ""
+	case float64:
+		_, ok := slaDomainZeroValueAttributesV0[name]
+		zero = value == 0 && !attr.IsComputed() && !ok
+	case bool:
+		zero = !value && !attr.IsComputed()
+	}
+	if zero && (attr.IsOptional() || attr.IsComputed()) {
+		return nil
+	}
+
+	return value
+}
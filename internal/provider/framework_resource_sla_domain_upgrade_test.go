@@ -0,0 +1,169 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// TestSLADomainUpgradeStateV0 verifies that SDKv2 state, with blocks stored as
+// lists and unset values stored as zero values, is upgraded to state matching
+// the framework schema.
+func TestSLADomainUpgradeStateV0(t *testing.T) {
+	ctx := context.Background()
+
+	priorState := `{
+		"id": "3f2b1d4e-8c9a-4b6f-9e2d-1a2b3c4d5e6f",
+		"name": "gold",
+		"description": "",
+		"object_types": ["AWS_EC2_EBS_OBJECT_TYPE"],
+		"apply_changes_to_existing_snapshots": false,
+		"apply_changes_to_non_policy_snapshots": false,
+		"archival": [],
+		"aws_rds_config": [],
+		"backup_location": [],
+		"backup_type": "",
+		"daily_schedule": [{"frequency": 1, "retention": 7, "retention_unit": "DAYS"}],
+		"hourly_schedule": [],
+		"local_retention": [],
+		"oracle_config": [{
+			"frequency": 1,
+			"frequency_unit": "DAYS",
+			"log_retention": 7,
+			"log_retention_unit": "DAYS",
+			"host_log_retention": 0,
+			"host_log_retention_unit": "DAYS",
+			"retain_archive_logs_indefinitely": false
+		}],
+		"snapshot_window": [{"duration": 2, "start_at": "14:30"}],
+		"timeouts": null
+	}`
+
+	r := &slaDomainResource{}
+	req := resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(priorState)}}
+	var res resource.UpgradeStateResponse
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, req, &res)
+	if res.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", res.Diagnostics)
+	}
+	if res.DynamicValue == nil {
+		t.Fatal("expected upgraded state")
+	}
+
+	// The upgraded state must be valid for the current schema.
+	if _, err := res.DynamicValue.Unmarshal(slaDomainSchema().Type().TerraformType(ctx)); err != nil {
+		t.Fatalf("upgraded state doesn't match the schema: %v", err)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(res.DynamicValue.JSON, &state); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{keyDescription, keyApplyChangesToExistingSnapshots, keyArchival, keyAWSRDSConfig,
+		keyBackupType, keyHourlySchedule, keyLocalRetention} {
+		if value := state[key]; value != nil {
+			t.Errorf("expected %s to be null, got %v", key, value)
+		}
+	}
+	if _, ok := state["timeouts"]; ok {
+		t.Error("expected timeouts to be removed")
+	}
+
+	daily, ok := state[keyDailySchedule].(map[string]any)
+	if !ok {
+		t.Fatalf("expected %s to be an object, got %v", keyDailySchedule, state[keyDailySchedule])
+	}
+	if got := daily[keyRetentionUnit]; got != "DAYS" {
+		t.Errorf("%s.%s = %v, want DAYS", keyDailySchedule, keyRetentionUnit, got)
+	}
+
+	oracle, ok := state[keyOracleConfig].(map[string]any)
+	if !ok {
+		t.Fatalf("expected %s to be an object, got %v", keyOracleConfig, state[keyOracleConfig])
+	}
+	if value := oracle[keyHostLogRetention]; value != nil {
+		t.Errorf("expected %s.%s to be null, got %v", keyOracleConfig, keyHostLogRetention, value)
+	}
+	if value := oracle[keyRetainArchiveLogsIndefinitely]; value != nil {
+		t.Errorf("expected %s.%s to be null, got %v", keyOracleConfig, keyRetainArchiveLogsIndefinitely, value)
+	}
+
+	windows, ok := state[keySnapshotWindow].([]any)
+	if !ok || len(windows) != 1 {
+		t.Fatalf("expected %s to be a list with one element, got %v", keySnapshotWindow, state[keySnapshotWindow])
+	}
+}
+
+// TestSLADomainUpgradeStateV0ConfiguredZero verifies that a zero value is kept
+// for attributes accepting a configured zero, while unset zero values of other
+// attributes in the same block are converted to null.
+func TestSLADomainUpgradeStateV0ConfiguredZero(t *testing.T) {
+	priorState := `{
+		"id": "3f2b1d4e-8c9a-4b6f-9e2d-1a2b3c4d5e6f",
+		"name": "gold",
+		"object_types": ["VSPHERE_OBJECT_TYPE"],
+		"archival": [{
+			"archival_location_id": "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01",
+			"threshold": 0,
+			"threshold_unit": "DAYS",
+			"archival_tiering": [{
+				"instant_tiering": true,
+				"min_accessible_duration_in_seconds": 0,
+				"cold_storage_class": "AZURE_ARCHIVE",
+				"tier_existing_snapshots": false
+			}]
+		}],
+		"daily_schedule": [{"frequency": 1, "retention": 7, "retention_unit": "DAYS"}]
+	}`
+
+	buf, err := upgradeSLADomainStateV0([]byte(priorState))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var state map[string]any
+	if err := json.Unmarshal(buf, &state); err != nil {
+		t.Fatal(err)
+	}
+	archival, ok := state[keyArchival].([]any)
+	if !ok || len(archival) != 1 {
+		t.Fatalf("expected %s to be a list with one element, got %v", keyArchival, state[keyArchival])
+	}
+	spec := archival[0].(map[string]any)
+	if got := spec[keyThreshold]; got != float64(0) {
+		t.Errorf("%s.%s = %v, want 0", keyArchival, keyThreshold, got)
+	}
+	tiering, ok := spec[keyArchivalTiering].(map[string]any)
+	if !ok {
+		t.Fatalf("expected %s to be an object, got %v", keyArchivalTiering, spec[keyArchivalTiering])
+	}
+	if got := tiering[keyMinAccessibleDurationInSeconds]; got != float64(0) {
+		t.Errorf("%s.%s = %v, want 0", keyArchivalTiering, keyMinAccessibleDurationInSeconds, got)
+	}
+	if got := tiering[keyTierExistingSnapshots]; got != nil {
+		t.Errorf("expected %s.%s to be null, got %v", keyArchivalTiering, keyTierExistingSnapshots, got)
+	}
+}
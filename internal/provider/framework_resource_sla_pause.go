@@ -0,0 +1,446 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+const frameworkResourceSLAPauseDescription = `
+The ´polaris_sla_pause´ resource pauses snapshot scheduling, e.g. during a
+maintenance window. The pause is either scoped to SLA domains or to a Rubrik
+cluster:
+  * When ´sla_domain_ids´ is specified, the SLA domains are paused. If
+    ´cluster_id´ is also specified, the SLA domains are only paused on that
+    Rubrik cluster, otherwise they are paused on all Rubrik clusters they have
+    been synced to.
+  * When only ´cluster_id´ is specified, all protection on the Rubrik cluster
+    is paused.
+
+When ´resume_at´ is specified, the pause ends at that time. Terraform can't act
+on its own, so the resume is performed by the first apply after ´resume_at´. The
+´paused´ field shows the pause state in the plan: it's planned as ´false´ once
+´resume_at´ has passed. Destroying the resource resumes snapshot scheduling.
+If the SLA domains or the Rubrik cluster no longer exist in RSC, the resource
+is removed from the state.
+
+The resource can't be imported, the ID is generated by the provider when the
+resource is created.
+
+-> **Note:** Pausing only applies to SLA domains protecting objects on Rubrik
+   clusters. An SLA domain must have been synced to a Rubrik cluster before it
+   can be paused.
+`
+
+var (
+	_ resource.Resource                     = &slaPauseResource{}
+	_ resource.ResourceWithConfigValidators = &slaPauseResource{}
+	_ resource.ResourceWithModifyPlan       = &slaPauseResource{}
+)
+
+type slaPauseResource struct {
+	client *client
+}
+
+type slaPauseModel struct {
+	ID           types.String `tfsdk:"id"`
+	ClusterID    types.String `tfsdk:"cluster_id"`
+	Paused       types.Bool   `tfsdk:"paused"`
+	ResumeAt     types.String `tfsdk:"resume_at"`
+	SLADomainIDs types.Set    `tfsdk:"sla_domain_ids"`
+}
+
+func newSLAPauseResource() resource.Resource {
+	return &slaPauseResource{}
+}
+
+func (r *slaPauseResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySLAPause
+}
+
+func (r *slaPauseResource) Schema(ctx context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(frameworkResourceSLAPauseDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "SLA pause ID (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyClusterID: schema.StringAttribute{
+				Optional: true,
+				Description: "Rubrik cluster ID (UUID). When specified without `sla_domain_ids`, all protection " +
+					"on the Rubrik cluster is paused. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+			keyPaused: schema.BoolAttribute{
+				Computed: true,
+				Description: "True if snapshot scheduling is paused. Planned as `false` once `resume_at` has " +
+					"passed.",
+			},
+			keyResumeAt: schema.StringAttribute{
+				Optional: true,
+				Description: "RFC3339 timestamp when the pause ends. Snapshot scheduling is resumed by the first " +
+					"apply after the timestamp. When not specified, snapshot scheduling is paused until the " +
+					"resource is destroyed.",
+				Validators: []validator.String{
+					isRFC3339(),
+				},
+			},
+			keySLADomainIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "SLA domain IDs (UUIDs) to pause. Changing this forces a new resource to be created.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(isUUID()),
+				},
+			},
+		},
+	}
+}
+
+func (r *slaPauseResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	tflog.Trace(ctx, "slaPauseResource.ConfigValidators")
+
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot(keyClusterID),
+			path.MatchRoot(keySLADomainIDs),
+		),
+	}
+}
+
+func (r *slaPauseResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client)
+}
+
+// ModifyPlan plans the pause state from the resume_at timestamp, so that the
+// resume shows in the plan once the timestamp has passed.
+func (r *slaPauseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	tflog.Trace(ctx, "slaPauseResource.ModifyPlan")
+
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var resumeAt types.String
+	res.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(keyResumeAt), &resumeAt)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	paused, known := slaPausePlannedState(resumeAt, time.Now())
+	if !known {
+		res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root(keyPaused), types.BoolUnknown())...)
+		return
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root(keyPaused), types.BoolValue(paused))...)
+}
+
+func (r *slaPauseResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Create")
+
+	var plan slaPauseModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	scope, err := slaPauseScopeFromModel(ctx, plan)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA pause", err.Error())
+		return
+	}
+
+	paused, _ := slaPausePlannedState(plan.ResumeAt, time.Now())
+	if paused {
+		if err := scope.setPaused(ctx, polarisClient.GQL, true); err != nil {
+			res.Diagnostics.AddError("Failed to pause", err.Error())
+			return
+		}
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	plan.Paused = types.BoolValue(paused)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+func (r *slaPauseResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Read")
+
+	var state slaPauseModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	scope, err := slaPauseScopeFromModel(ctx, state)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA pause", err.Error())
+		return
+	}
+
+	paused, err := scope.paused(ctx, polarisClient.GQL)
+	if err != nil {
+		// RSC doesn't signal not found for the pause state, so the existence
+		// of the SLA domains and the cluster is checked after an error.
+		exists, existsErr := scope.exists(ctx, polarisClient)
+		if existsErr == nil && !exists {
+			res.State.RemoveResource(ctx)
+			return
+		}
+		res.Diagnostics.AddError("Failed to read pause state", err.Error())
+		return
+	}
+
+	state.Paused = types.BoolValue(paused)
+	res.Diagnostics.Append(res.State.Set(ctx, &state)...)
+}
+
+func (r *slaPauseResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Update")
+
+	var plan, state slaPauseModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	scope, err := slaPauseScopeFromModel(ctx, plan)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA pause", err.Error())
+		return
+	}
+
+	paused, _ := slaPausePlannedState(plan.ResumeAt, time.Now())
+	if paused != state.Paused.ValueBool() {
+		if err := scope.setPaused(ctx, polarisClient.GQL, paused); err != nil {
+			res.Diagnostics.AddError("Failed to "+pauseAction(paused), err.Error())
+			return
+		}
+	}
+
+	plan.Paused = types.BoolValue(paused)
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+func (r *slaPauseResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	tflog.Trace(ctx, "slaPauseResource.Delete")
+
+	var state slaPauseModel
+	res.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Nothing to resume.
+	if !state.Paused.ValueBool() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	scope, err := slaPauseScopeFromModel(ctx, state)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid SLA pause", err.Error())
+		return
+	}
+
+	if err := scope.setPaused(ctx, polarisClient.GQL, false); err != nil {
+		res.Diagnostics.AddError("Failed to resume", err.Error())
+	}
+}
+
+// slaPausePlannedState returns the planned pause state for the resume_at
+// timestamp at the specified time. Returns false for known if the timestamp
+// isn't known yet.
+func slaPausePlannedState(resumeAt types.String, now time.Time) (paused bool, known bool) {
+	if resumeAt.IsUnknown() {
+		return false, false
+	}
+	if resumeAt.IsNull() {
+		return true, true
+	}
+
+	// The timestamp has already been validated by the schema.
+	t, err := time.Parse(time.RFC3339, resumeAt.ValueString())
+	if err != nil {
+		return true, true
+	}
+
+	return now.Before(t), true
+}
+
+// slaPauseScope holds the scope of an SLA pause. When slaIDs is empty, the
+// scope is all protection on the cluster.
+type slaPauseScope struct {
+	clusterID uuid.UUID
+	slaIDs    []uuid.UUID
+}
+
+func slaPauseScopeFromModel(ctx context.Context, model slaPauseModel) (slaPauseScope, error) {
+	var scope slaPauseScope
+	if !model.ClusterID.IsNull() {
+		clusterID, err := uuid.Parse(model.ClusterID.ValueString())
+		if err != nil {
+			return slaPauseScope{}, err
+		}
+		scope.clusterID = clusterID
+	}
+
+	for _, id := range stringsFromSet(model.SLADomainIDs) {
+		slaID, err := uuid.Parse(id)
+		if err != nil {
+			return slaPauseScope{}, err
+		}
+		scope.slaIDs = append(scope.slaIDs, slaID)
+	}
+
+	return scope, nil
+}
+
+// clusterIDs returns the clusters the SLA domains are paused on, nil means all
+// clusters of the SLA domains.
+func (s slaPauseScope) clusterIDs() []uuid.UUID {
+	if s.clusterID == uuid.Nil {
+		return nil
+	}
+	return []uuid.UUID{s.clusterID}
+}
+
+// paused returns true if the scope is paused.
+func (s slaPauseScope) paused(ctx context.Context, gql *graphql.Client) (bool, error) {
+	if len(s.slaIDs) == 0 {
+		return clusterPaused(ctx, gql, s.clusterID)
+	}
+
+	for _, slaID := range s.slaIDs {
+		status, err := slaDomainPauseStatus(ctx, gql, slaID)
+		if err != nil {
+			return false, err
+		}
+		if !status.paused(s.clusterIDs()) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// exists returns true if the SLA domains and the cluster of the scope exist in
+// RSC.
+func (s slaPauseScope) exists(ctx context.Context, polarisClient *polaris.Client) (bool, error) {
+	for _, slaID := range s.slaIDs {
+		_, err := sla.Wrap(polarisClient).DomainByID(ctx, slaID)
+		if errors.Is(err, graphql.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if s.clusterID != uuid.Nil {
+		_, ok, err := registeredCluster(ctx, polarisClient, s.clusterID.String(), "")
+		if err != nil || !ok {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// setPaused pauses or resumes the scope.
+func (s slaPauseScope) setPaused(ctx context.Context, gql *graphql.Client, paused bool) error {
+	if len(s.slaIDs) == 0 {
+		return setClusterPaused(ctx, gql, s.clusterID, paused)
+	}
+
+	for _, slaID := range s.slaIDs {
+		if err := setSLADomainPaused(ctx, gql, slaID, s.clusterIDs(), paused); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+const frameworkResourceSnapshotRetentionDescription = `
+The ´polaris_snapshot_retention´ resource changes the retention of specific
+snapshots of a workload, e.g. to place snapshots on legal hold or to clean up
+snapshots. The snapshots can be looked up using the ´polaris_snapshot´ data
+source. The retention is one of:
+  * ´SLA´ - The snapshots are retained by the SLA domain specified by
+    ´sla_domain_id´.
+  * ´KEEP_FOREVER´ - The snapshots are retained forever.
+  * ´EXPIRE_NOW´ - The snapshots are expired immediately. The snapshots are
+    first set to be retained forever and then deleted.
+
+Expiring the last snapshot of a workload is rejected when planning, and again
+before the snapshots are expired. When planning, the snapshots expired by all
+´polaris_snapshot_retention´ resources targeting the same workload are counted
+together.
+
+-> **Note:** The retention of the snapshots isn't read back from RSC, changes
+   made outside of Terraform aren't detected. Destroying the resource doesn't
+   change the retention of the snapshots.
+
+!> **Warning:** Expired snapshots cannot be recovered.
+`
+
+var (
+	_ resource.Resource                   = &snapshotRetentionResource{}
+	_ resource.ResourceWithModifyPlan     = &snapshotRetentionResource{}
+	_ resource.ResourceWithValidateConfig = &snapshotRetentionResource{}
+)
+
+type snapshotRetentionResource struct {
+	client *client
+}
+
+type snapshotRetentionModel struct {
+	ID          types.String `tfsdk:"id"`
+	Retention   types.String `tfsdk:"retention"`
+	SLADomainID types.String `tfsdk:"sla_domain_id"`
+	SnapshotIDs types.Set    `tfsdk:"snapshot_ids"`
+	WorkloadID  types.String `tfsdk:"workload_id"`
+}
+
+func newSnapshotRetentionResource() resource.Resource {
+	return &snapshotRetentionResource{}
+}
+
+func (r *snapshotRetentionResource) Metadata(ctx context.Context, req resource.MetadataRequest, res *resource.MetadataResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Metadata")
+
+	res.TypeName = req.ProviderTypeName + "_" + keySnapshotRetention
+}
+
+func (r *snapshotRetentionResource) Schema(ctx context.Context, _ resource.SchemaRequest, res *resource.SchemaResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Schema")
+
+	res.Schema = schema.Schema{
+		Description: description(frameworkResourceSnapshotRetentionDescription),
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: "Snapshot retention ID (UUID).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			keyRetention: schema.StringAttribute{
+				Required: true,
+				Description: "Retention of the snapshots. Possible values are `SLA`, `KEEP_FOREVER` and " +
+					"`EXPIRE_NOW`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(snapshotRetentionSLA, snapshotRetentionKeepForever,
+						snapshotRetentionExpireNow),
+				},
+			},
+			keySLADomainID: schema.StringAttribute{
+				Optional: true,
+				Description: "SLA domain ID (UUID) retaining the snapshots. Required when `retention` is `SLA`, " +
+					"not allowed otherwise.",
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+			keySnapshotIDs: schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Snapshot IDs (UUIDs) of the workload. Changing this forces a new resource to be " +
+					"created.",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(isUUID()),
+				},
+			},
+			keyWorkloadID: schema.StringAttribute{
+				Required: true,
+				Description: "Workload ID (UUID) of the snapshots. Changing this forces a new resource to be " +
+					"created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					isUUID(),
+				},
+			},
+		},
+	}
+}
+
+func (r *snapshotRetentionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, res *resource.ValidateConfigResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.ValidateConfig")
+
+	var config snapshotRetentionModel
+	res.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if res.Diagnostics.HasError() || config.Retention.IsUnknown() {
+		return
+	}
+
+	if config.Retention.ValueString() == snapshotRetentionSLA && config.SLADomainID.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root(keySLADomainID), "Missing SLA domain ID",
+			"sla_domain_id must be specified when retention is SLA")
+	}
+	if config.Retention.ValueString() != snapshotRetentionSLA && !config.SLADomainID.IsNull() {
+		res.Diagnostics.AddAttributeError(path.Root(keySLADomainID), "Invalid SLA domain ID",
+			"sla_domain_id can only be specified when retention is SLA")
+	}
+}
+
+func (r *snapshotRetentionResource) Configure(ctx context.Context, req resource.ConfigureRequest, res *resource.ConfigureResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+	r.client = req.ProviderData.(*client)
+}
+
+// ModifyPlan rejects expiring the last snapshot of the workload and changing
+// the retention of snapshots which have already been expired.
+func (r *snapshotRetentionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.ModifyPlan")
+
+	// Nothing to do on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan snapshotRetentionModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// Replacing the resource plans a create with the new snapshots.
+	var state *snapshotRetentionModel
+	if !req.State.Raw.IsNull() && !snapshotRetentionReplaced(req.State, req.Plan) {
+		state = &snapshotRetentionModel{}
+		res.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if state != nil && state.Retention.ValueString() == snapshotRetentionExpireNow {
+		if !plan.Retention.IsUnknown() && plan.Retention.ValueString() != snapshotRetentionExpireNow {
+			res.Diagnostics.AddAttributeError(path.Root(keyRetention), "Snapshots expired",
+				"the snapshots have already been expired and their retention cannot be changed")
+		}
+		return
+	}
+
+	if plan.Retention.ValueString() != snapshotRetentionExpireNow || plan.WorkloadID.IsUnknown() ||
+		plan.SnapshotIDs.IsUnknown() {
+		return
+	}
+
+	workloadID, snapshotIDs, err := snapshotRetentionIDs(plan)
+	if err != nil {
+		res.Diagnostics.AddError("Invalid snapshot retention", err.Error())
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	total, err := workloadSnapshotCount(ctx, polarisClient.GQL, workloadID)
+	if err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keyWorkloadID), "Failed to read workload", err.Error())
+		return
+	}
+	expire := r.client.snapshotExpirations.plan(workloadID, snapshotIDs)
+	if err := validateSnapshotExpiration(workloadID, expire, total); err != nil {
+		res.Diagnostics.AddAttributeError(path.Root(keySnapshotIDs), "Cannot expire snapshots", err.Error())
+	}
+}
+
+func (r *snapshotRetentionResource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Create")
+
+	var plan snapshotRetentionModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	if err := applySnapshotRetention(ctx, polarisClient.GQL, &r.client.snapshotExpirations, plan); err != nil {
+		res.Diagnostics.AddError("Failed to change snapshot retention", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(uuid.NewString())
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the state as is, the retention of the snapshots isn't read back
+// from RSC.
+func (r *snapshotRetentionResource) Read(ctx context.Context, req resource.ReadRequest, res *resource.ReadResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Read")
+}
+
+func (r *snapshotRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Update")
+
+	var plan snapshotRetentionModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	polarisClient, err := r.client.polaris()
+	if err != nil {
+		res.Diagnostics.AddError("RSC client error", err.Error())
+		return
+	}
+
+	if err := applySnapshotRetention(ctx, polarisClient.GQL, &r.client.snapshotExpirations, plan); err != nil {
+		res.Diagnostics.AddError("Failed to change snapshot retention", err.Error())
+		return
+	}
+
+	res.Diagnostics.Append(res.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the resource from the state, the retention of the snapshots
+// is kept.
+func (r *snapshotRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	tflog.Trace(ctx, "snapshotRetentionResource.Delete")
+}
+
+// snapshotRetentionReplaced returns true if the planned change replaces the
+// snapshot retention, i.e. the workload or the snapshots are changed.
+func snapshotRetentionReplaced(state tfsdk.State, plan tfsdk.Plan) bool {
+	var stateValues, planValues map[string]tftypes.Value
+	if err := state.Raw.As(&stateValues); err != nil {
+		return false
+	}
+	if err := plan.Raw.As(&planValues); err != nil {
+		return false
+	}
+
+	return !stateValues[keyWorkloadID].Equal(planValues[keyWorkloadID]) ||
+		!stateValues[keySnapshotIDs].Equal(planValues[keySnapshotIDs])
+}
+
+// snapshotRetentionIDs returns the workload ID and the snapshot IDs of the
+// snapshot retention.
+func snapshotRetentionIDs(model snapshotRetentionModel) (uuid.UUID, []uuid.UUID, error) {
+	workloadID, err := uuid.Parse(model.WorkloadID.ValueString())
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("failed to parse workload ID: %s", err)
+	}
+
+	var snapshotIDs []uuid.UUID
+	for _, value := range stringsFromSet(model.SnapshotIDs) {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return uuid.Nil, nil, fmt.Errorf("failed to parse snapshot ID: %s", err)
+		}
+		snapshotIDs = append(snapshotIDs, id)
+	}
+
+	return workloadID, snapshotIDs, nil
+}
+
+// applySnapshotRetention changes the retention of the snapshots to the
+// retention of the model. Before the snapshots are expired, the number of
+// snapshots of the workload is checked again, since snapshots may have expired
+// since the plan was made.
+func applySnapshotRetention(ctx context.Context, gql *graphql.Client, expirations *snapshotExpirations, model snapshotRetentionModel) error {
+	workloadID, snapshotIDs, err := snapshotRetentionIDs(model)
+	if err != nil {
+		return err
+	}
+
+	switch model.Retention.ValueString() {
+	case snapshotRetentionSLA:
+		slaID, err := uuid.Parse(model.SLADomainID.ValueString())
+		if err != nil {
+			return fmt.Errorf("failed to parse SLA domain ID: %s", err)
+		}
+		return assignSnapshotRetention(ctx, gql, snapshotIDs, &slaID)
+	case snapshotRetentionKeepForever:
+		return assignSnapshotRetention(ctx, gql, snapshotIDs, nil)
+	case snapshotRetentionExpireNow:
+		return expirations.expire(ctx, gql, workloadID, snapshotIDs)
+	default:
+		return fmt.Errorf("invalid retention: %s", model.Retention.ValueString())
+	}
+}
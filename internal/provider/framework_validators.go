@@ -23,7 +23,10 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -82,6 +85,33 @@ func (v isNotWhiteSpaceValidator) ValidateString(_ context.Context, req validato
 	}
 }
 
+// isRFC3339 returns a validator that checks if a string value is a valid
+// RFC3339 timestamp.
+func isRFC3339() validator.String {
+	return isRFC3339Validator{}
+}
+
+type isRFC3339Validator struct{}
+
+func (v isRFC3339Validator) Description(_ context.Context) string {
+	return "value must be a valid RFC3339 timestamp"
+}
+
+func (v isRFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isRFC3339Validator) ValidateString(_ context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid RFC3339 Timestamp",
+			fmt.Sprintf("%q is not a valid RFC3339 timestamp: %s", req.ConfigValue.ValueString(), err))
+	}
+}
+
 // setMustContain returns a validator that checks a set of strings contains the
 // given value. A null or unknown set passes (nothing to validate yet).
 func setMustContain(value string) validator.Set {
@@ -120,3 +150,75 @@ func (v setMustContainValidator) ValidateSet(ctx context.Context, req validator.
 	res.Diagnostics.AddAttributeError(req.Path, "Missing required value",
 		fmt.Sprintf("%q must be included in the set", v.value))
 }
+
+// isStartAt returns a validator that checks if a string value is a valid
+// snapshot window start, e.g. "Mon, 15:30" or "15:30". The GQL type allows
+// specifying a day of week optionally, but different endpoints use the value in
+// different ways. E.g. "First full snapshot" requires the day of week, but
+// "Snapshot window" requires it to be omitted.
+func isStartAt(withDay bool) validator.String {
+	return isStartAtValidator{withDay: withDay}
+}
+
+type isStartAtValidator struct {
+	withDay bool
+}
+
+func (v isStartAtValidator) Description(_ context.Context) string {
+	if v.withDay {
+		return "value must be given as DAY, HH:MM"
+	}
+	return "value must be given as HH:MM"
+}
+
+func (v isStartAtValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v isStartAtValidator) ValidateString(_ context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parts := strings.Split(value, ", ")
+	var timeParts []string
+	switch len(parts) {
+	case 1:
+		// Day of week not specified.
+		if v.withDay {
+			res.Diagnostics.AddAttributeError(req.Path, "Invalid Value",
+				fmt.Sprintf("day of week required: %s", value))
+			return
+		}
+		timeParts = strings.Split(parts[0], ":")
+	case 2:
+		// Day of week specified.
+		if !v.withDay {
+			res.Diagnostics.AddAttributeError(req.Path, "Invalid Value",
+				fmt.Sprintf("day of week not allowed: %s", value))
+			return
+		}
+		if !slices.Contains([]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}, parts[0]) {
+			res.Diagnostics.AddAttributeError(req.Path, "Invalid Value",
+				fmt.Sprintf("invalid day of week: %s", value))
+			return
+		}
+		timeParts = strings.Split(parts[1], ":")
+	default:
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid Value", fmt.Sprintf("invalid format: %s", value))
+		return
+	}
+	if len(timeParts) != 2 {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid Value", fmt.Sprintf("invalid time format: %s", value))
+		return
+	}
+
+	if n, err := strconv.Atoi(timeParts[0]); err != nil || n < 0 || n > 23 {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid Value", fmt.Sprintf("invalid hour: %s", value))
+		return
+	}
+	if n, err := strconv.Atoi(timeParts[1]); err != nil || n < 0 || n > 59 {
+		res.Diagnostics.AddAttributeError(req.Path, "Invalid Value", fmt.Sprintf("invalid minute: %s", value))
+	}
+}
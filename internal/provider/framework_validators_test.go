@@ -141,3 +141,141 @@ func TestIsNotWhiteSpaceValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRFC3339Validator(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     basetypes.StringValue
+		expectErr bool
+	}{
+		{
+			name:      "ValidUTC",
+			value:     basetypes.NewStringValue("2026-10-18T20:00:00Z"),
+			expectErr: false,
+		},
+		{
+			name:      "ValidOffset",
+			value:     basetypes.NewStringValue("2026-10-18T20:00:00+02:00"),
+			expectErr: false,
+		},
+		{
+			name:      "MissingTimeZone",
+			value:     basetypes.NewStringValue("2026-10-18T20:00:00"),
+			expectErr: true,
+		},
+		{
+			name:      "DateOnly",
+			value:     basetypes.NewStringValue("2026-10-18"),
+			expectErr: true,
+		},
+		{
+			name:      "NullValue",
+			value:     basetypes.NewStringNull(),
+			expectErr: false,
+		},
+		{
+			name:      "UnknownValue",
+			value:     basetypes.NewStringUnknown(),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				ConfigValue: tc.value,
+			}
+			var res validator.StringResponse
+
+			isRFC3339Validator{}.ValidateString(context.Background(), req, &res)
+
+			if tc.expectErr && !res.Diagnostics.HasError() {
+				t.Errorf("expected error for %q, got none", tc.value)
+			}
+			if !tc.expectErr && res.Diagnostics.HasError() {
+				t.Errorf("expected no error for %q, got: %s", tc.value, res.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestIsStartAtValidator(t *testing.T) {
+	tests := []struct {
+		name      string
+		withDay   bool
+		value     basetypes.StringValue
+		expectErr bool
+	}{
+		{
+			name:      "TimeOnly",
+			value:     basetypes.NewStringValue("15:30"),
+			expectErr: false,
+		},
+		{
+			name:      "TimeOnlyWithDayRequired",
+			withDay:   true,
+			value:     basetypes.NewStringValue("15:30"),
+			expectErr: true,
+		},
+		{
+			name:      "DayAndTime",
+			withDay:   true,
+			value:     basetypes.NewStringValue("Mon, 15:30"),
+			expectErr: false,
+		},
+		{
+			name:      "DayAndTimeWithDayNotAllowed",
+			value:     basetypes.NewStringValue("Mon, 15:30"),
+			expectErr: true,
+		},
+		{
+			name:      "InvalidDay",
+			withDay:   true,
+			value:     basetypes.NewStringValue("Monday, 15:30"),
+			expectErr: true,
+		},
+		{
+			name:      "InvalidHour",
+			value:     basetypes.NewStringValue("24:00"),
+			expectErr: true,
+		},
+		{
+			name:      "InvalidMinute",
+			value:     basetypes.NewStringValue("12:60"),
+			expectErr: true,
+		},
+		{
+			name:      "InvalidFormat",
+			value:     basetypes.NewStringValue("1530"),
+			expectErr: true,
+		},
+		{
+			name:      "NullValue",
+			value:     basetypes.NewStringNull(),
+			expectErr: false,
+		},
+		{
+			name:      "UnknownValue",
+			value:     basetypes.NewStringUnknown(),
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				ConfigValue: tc.value,
+			}
+			var res validator.StringResponse
+
+			isStartAtValidator{withDay: tc.withDay}.ValidateString(context.Background(), req, &res)
+
+			if tc.expectErr && !res.Diagnostics.HasError() {
+				t.Errorf("expected error for %q, got none", tc.value)
+			}
+			if !tc.expectErr && res.Diagnostics.HasError() {
+				t.Errorf("expected no error for %q, got: %s", tc.value, res.Diagnostics.Errors())
+			}
+		})
+	}
+}
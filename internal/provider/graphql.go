@@ -0,0 +1,51 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// gqlRequest performs the GraphQL request and unmarshals the aliased result of
+// the query or mutation into result, which must be a pointer. The query should
+// follow the conventions of the SDK, i.e. the operation name should start with
+// SdkGolang and the result should be aliased to result. Only use this for RSC
+// APIs which are not yet wrapped by the SDK.
+func gqlRequest(ctx context.Context, gql *graphql.Client, query string, params, result any) error {
+	buf, err := gql.Request(ctx, query, params)
+	if err != nil {
+		return graphql.RequestError(query, err)
+	}
+
+	payload := struct {
+		Data struct {
+			Result any `json:"result"`
+		} `json:"data"`
+	}{}
+	payload.Data.Result = result
+	if err := json.Unmarshal(buf, &payload); err != nil {
+		return graphql.UnmarshalError(query, err)
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ const (
 	keyActiveUsers                                  = "active_users"
 	keyAction                                       = "action"
 	keyActions                                      = "actions"
+	keyAddress                                      = "address"
 	keyAfterTime                                    = "after_time"
 	keyAdminEmail                                   = "admin_email"
 	keyAdminPassword                                = "admin_password"
@@ -42,13 +43,18 @@ const (
 	keyArchivalLocationToClusterMapping             = "archival_location_to_cluster_mapping"
 	keyArchivalGroupID                              = "archival_group_id"
 	keyArchivalProxySettings                        = "archival_proxy_settings"
+	keyArchivalSnapshotLag                          = "archival_snapshot_lag"
+	keyArchivalState                                = "archival_state"
 	keyARN                                          = "arn"
 	keyAssignmentType                               = "assignment_type"
 	keyAssumeRole                                   = "assume_role"
+	keyAsyncWrite                                   = "async_write"
 	keyAttributeType                                = "attribute_type"
 	keyAuthDomainID                                 = "auth_domain_id"
+	keyAuthenticationType                           = "authentication_type"
 	keyAuthorizedGroups                             = "authorized_groups"
 	keyAvailabilityZone                             = "availability_zone"
+	keyAvailableCapacity                            = "available_capacity"
 	keyAws                                          = "aws"
 	keyAzure                                        = "azure"
 	keyBucketLabels                                 = "bucket_labels"
@@ -94,11 +100,16 @@ const (
 	keyQuarterlyBackupLocations                     = "quarterly_backup_locations"
 	keyWeeklyBackupLocations                        = "weekly_backup_locations"
 	keyYearlyBackupLocations                        = "yearly_backup_locations"
+	keyBandwidthThrottle                            = "bandwidth_throttle"
 	keyBucketName                                   = "bucket_name"
 	keyBucketTags                                   = "bucket_tags"
+	keyBundleVersion                                = "bundle_version"
 	keyBypassProxy                                  = "bypass_proxy"
+	keyCACertificate                                = "ca_certificate"
 	keyCDMProduct                                   = "cdm_product"
 	keyCDMVersion                                   = "cdm_version"
+	keyCertificate                                  = "certificate"
+	keyCertificateID                                = "certificate_id"
 	keyClaimAttributes                              = "claim_attributes"
 	keyCredentials                                  = "credentials"
 	keyCloud                                        = "cloud"
@@ -119,17 +130,26 @@ const (
 	keyClusterName                                  = "cluster_name"
 	keyClusterNodeIPAddress                         = "cluster_node_ip_address"
 	keyClusterNodes                                 = "cluster_nodes"
+	keyClusters                                     = "clusters"
 	keyClusterSecurityGroupID                       = "cluster_security_group_id"
 	keyClusterStatus                                = "cluster_status"
 	keyClusterTier                                  = "cluster_tier"
 	keyClusterVersion                               = "cluster_version"
+	keyColdPhysicalBytes                            = "cold_physical_bytes"
+	keyColdSnapshotCount                            = "cold_snapshot_count"
+	keyCommunityString                              = "community_string"
+	keyComplianceStatus                             = "compliance_status"
 	keyComputeProxySettings                         = "compute_proxy_settings"
 	keyConditions                                   = "conditions"
+	keyConfiguration                                = "configuration"
 	keyConnectionCommand                            = "connection_command"
 	keyConnectionCommandExecuted                    = "connection_command_executed"
+	keyConnectionState                              = "connection_state"
+	keyConnectionStates                             = "connection_states"
 	keyConnectionStatus                             = "connection_status"
 	keyConnectionString                             = "connection_string"
 	keyContainerName                                = "container_name"
+	keyCustomerManaged                              = "customer_managed"
 	keyCustomerManagedKey                           = "customer_managed_key"
 	keyCustomerManagedPolicies                      = "customer_managed_policies"
 	keyCustomLabels                                 = "custom_labels"
@@ -138,6 +158,8 @@ const (
 	keyCyberRecoveryDataScanning                    = "cyber_recovery_data_scanning"
 	keyDataAction                                   = "data_action"
 	keyDataActions                                  = "data_actions"
+	keyDataIP                                       = "data_ip"
+	keyDataNetwork                                  = "data_network"
 	keyDataScanning                                 = "data_scanning"
 	keyDate                                         = "date"
 	keyDailySchedule                                = "daily_schedule"
@@ -146,6 +168,8 @@ const (
 	keyDayOfWeek                                    = "day_of_week"
 	keyDayOfYear                                    = "day_of_year"
 	keyDefault                                      = "default"
+	keyDefaultLimit                                 = "default_limit"
+	keyDeleteProtection                             = "delete_protection"
 	keyDeleteSnapshotsOnDestroy                     = "delete_snapshots_on_destroy"
 	keyDiskEncryptionAtHost                         = "disk_encryption_at_host"
 	keyDescription                                  = "description"
@@ -157,20 +181,27 @@ const (
 	keyDSPM                                         = "dspm"
 	keyDuration                                     = "duration"
 	keyEC2RecoveryRolePath                          = "ec2_recovery_role_path"
+	keyEKSVersion                                   = "eks_version"
 	keyEmail                                        = "email"
 	keyEnabled                                      = "enabled"
 	keyEnableEncryption                             = "enable_encryption"
 	keyEnableImmutability                           = "enable_immutability"
 	keyEntityID                                     = "entity_id"
 	keyEntraGroupID                                 = "entra_group_id"
+	keyExchangeConfig                               = "exchange_config"
 	keyEncryptionPassword                           = "encryption_password"
+	keyEndpoint                                     = "endpoint"
 	keyEndpointSettings                             = "endpoint_settings"
+	keyEndTime                                      = "end_time"
 	keyExcludeAnomalous                             = "exclude_anomalous"
 	keyExcludeQuarantined                           = "exclude_quarantined"
 	keyExistingSnapshotRetention                    = "existing_snapshot_retention"
+	keyExistingSnapshots                            = "existing_snapshots"
 	keyExocompute                                   = "exocompute"
 	keyExocomputeID                                 = "exocompute_id"
+	keyExocomputeImageBundle                        = "exocompute_image_bundle"
 	keyExpiration                                   = "expiration"
+	keyExportDirectory                              = "export_directory"
 	keyExternalID                                   = "external_id"
 	keyFeature                                      = "feature"
 	keyFeatureFlag                                  = "feature_flag"
@@ -180,58 +211,98 @@ const (
 	keyFQDN                                         = "fqdn"
 	keyFrequency                                    = "frequency"
 	keyFrequencyUnit                                = "frequency_unit"
+	keyFromEmail                                    = "from_email"
+	keyGateway                                      = "gateway"
 	keyGcp                                          = "gcp"
+	keyGlobalSLADomainCount                         = "global_sla_domain_count"
 	keyGroupName                                    = "group_name"
 	keyHash                                         = "hash"
+	keyHasPrivateKey                                = "has_private_key"
 	keyHierarchy                                    = "hierarchy"
+	keyHost                                         = "host"
 	keyHostAccountID                                = "host_account_id"
 	keyHostCloudAccountID                           = "host_cloud_account_id"
+	keyHostname                                     = "hostname"
+	keyHostProject                                  = "host_project"
 	keyHourlySchedule                               = "hourly_schedule"
 	keyID                                           = "id"
 	keyIdentityProvider                             = "identity_provider"
 	keyIdentityProviderID                           = "identity_provider_id"
+	keyInComplianceCount                            = "in_compliance_count"
+	keyImages                                       = "images"
 	keyImmutabilitySettings                         = "immutability_settings"
+	keyIncludeClusterScopedResources                = "include_cluster_scoped_resources"
+	keyIncludePersistentVolumeClaims                = "include_persistent_volume_claims"
+	keyInstalledVersion                             = "installed_version"
 	keyInstanceProfile                              = "instance_profile"
 	keyInstanceProfileKeys                          = "instance_profile_keys"
 	keyInstanceProfileName                          = "instance_profile_name"
 	keyInstanceType                                 = "instance_type"
+	keyIPAddress                                    = "ip_address"
 	keyIPAddresses                                  = "ip_addresses"
+	keyIPMIIP                                       = "ipmi_ip"
+	keyIPMINetwork                                  = "ipmi_network"
 	keyIsAccountOwner                               = "is_account_owner"
 	keyAzResilient                                  = "az_resilient"
 	keyActive                                       = "active"
 	keyIsOrgAdmin                                   = "is_org_admin"
 	keyKey                                          = "key"
 	keyKeepClusterOnFailure                         = "keep_cluster_on_failure"
+	keyKeyID                                        = "key_id"
+	keyKeyType                                      = "key_type"
 	keyKind                                         = "kind"
 	keyKMSAlias                                     = "kms_alias"
 	keyKMSEndpoint                                  = "kms_endpoint"
 	keyKMSMasterKey                                 = "kms_master_key"
+	keyKubernetesConfig                             = "kubernetes_config"
 	keyKubernetesProtection                         = "kubernetes_protection"
+	keyLastSnapshot                                 = "last_snapshot"
+	keyLimit                                        = "limit"
 	keyLocalRetention                               = "local_retention"
+	keyLocalSnapshotCount                           = "local_snapshot_count"
 	keyLocation                                     = "location"
 	keyLocationTemplate                             = "location_template"
+	keyLockedAt                                     = "locked_at"
 	keyLockPeriod                                   = "lock_period"
+	keyLogicalBytes                                 = "logical_bytes"
 	keyLogRetention                                 = "log_retention"
 	keyLogRetentionUnit                             = "log_retention_unit"
+	keyMaintenanceWindow                            = "maintenance_window"
 	keyManagedPolicies                              = "managed_policies"
 	keyManagementGateway                            = "management_gateway"
+	keyManagementIP                                 = "management_ip"
 	keyManagementSubnetMask                         = "management_subnet_mask"
 	keyManifest                                     = "manifest"
+	keyMaxConcurrentUpgrades                        = "max_concurrent_upgrades"
 	keyMaxNodeCount                                 = "max_node_count"
+	keyMessage                                      = "message"
 	keyMetadataJSON                                 = "metadata_json"
+	keyMicrosoft365Config                           = "microsoft_365_config"
 	keyMinuteSchedule                               = "minute_schedule"
+	keyMissedSnapshots                              = "missed_snapshots"
 	keyMode                                         = "mode"
 	keyMonthlySchedule                              = "monthly_schedule"
 	keyName                                         = "name"
+	keyNetwork                                      = "network"
 	keyNetworkAccessType                            = "network_access_type"
+	keyNetworkConfig                                = "network_config"
 	keyNetworkResourceGroup                         = "network_resource_group"
 	keyNetworkSecurityGroup                         = "network_security_group"
 	keyNetworkSecurityResourceGroup                 = "network_security_resource_group"
 	keyNativeID                                     = "native_id"
+	keyNFSOptions                                   = "nfs_options"
+	keyNFSVersion                                   = "nfs_version"
+	keyNode                                         = "node"
 	keyNodeConfig                                   = "node_config"
+	keyNodeCount                                    = "node_count"
+	keyNodes                                        = "nodes"
 	keyNodeSecurityGroupID                          = "node_security_group_id"
+	keyNodeSizeGB                                   = "node_size_gb"
+	keyNodeStatus                                   = "node_status"
 	keyNotActions                                   = "not_actions"
 	keyNotDataActions                               = "not_data_actions"
+	keyNotYetRunCount                               = "not_yet_run_count"
+	keyNTPServer                                    = "ntp_server"
 	keyNTPServer1Name                               = "ntp_server1_name"
 	keyNTPServer1Key                                = "ntp_server1_key"
 	keyNTPServer1KeyID                              = "ntp_server1_key_id"
@@ -241,27 +312,32 @@ const (
 	keyNTPServer2KeyID                              = "ntp_server2_key_id"
 	keyNTPServer2KeyType                            = "ntp_server2_key_type"
 	keyNTPServers                                   = "ntp_servers"
+	keyNumberOfBuckets                              = "number_of_buckets"
 	keyNumNodes                                     = "num_nodes"
 	keyObjectID                                     = "object_id"
 	keyObjectIDs                                    = "object_ids"
 	keyObjectType                                   = "object_type"
 	keyObjectTypes                                  = "object_types"
 	keyObjects                                      = "objects"
+	keyOnboarded                                    = "onboarded"
 	keyOperation                                    = "operation"
 	keyOperations                                   = "operations"
 	keyOptionalConfig                               = "optional_config"
 	keyOrganizationName                             = "organization_name"
+	keyOutOfComplianceCount                         = "out_of_compliance_count"
 	keyOutpost                                      = "outpost"
 	keyOutpostAccountID                             = "outpost_account_id"
 	keyOutpostAccountProfile                        = "outpost_account_profile"
 	keyOverrideResourceLabels                       = "override_resource_labels"
 	keyOverrideResourceTags                         = "override_resource_tags"
 	keyPassword                                     = "password"
+	keyPaused                                       = "paused"
 	keyPermissionGroups                             = "permission_groups"
 	keyPermission                                   = "permission"
 	keyPermissions                                  = "permissions"
 	keyPermissionsHash                              = "permissions_hash"
 	keyPermissionsVersion                           = "permissions_version"
+	keyPhysicalBytes                                = "physical_bytes"
 	keyPodOverlayNetworkCIDR                        = "pod_overlay_network_cidr"
 	keyPodSubnetID                                  = "pod_subnet_id"
 	keyPolaris                                      = "polaris"
@@ -290,32 +366,64 @@ const (
 	keyPolarisCDMBootstrap                          = "polaris_cdm_bootstrap"
 	keyPolarisCDMBootstrapCCESAWS                   = "polaris_cdm_bootstrap_cces_aws"
 	keyPolarisCDMBootstrapCCESAzure                 = "polaris_cdm_bootstrap_cces_azure"
+	keyPolarisCDMBootstrapCCESGCP                   = "polaris_cdm_bootstrap_cces_gcp"
+	keyPolarisCDMCertificate                        = "polaris_cdm_certificate"
+	keyPolarisCDMCertificateBinding                 = "polaris_cdm_certificate_binding"
+	keyPolarisCDMClusterDNS                         = "polaris_cdm_cluster_dns"
+	keyPolarisCDMClusterNodes                       = "polaris_cdm_cluster_nodes"
+	keyPolarisCDMClusterNTP                         = "polaris_cdm_cluster_ntp"
+	keyPolarisCDMClusterProxy                       = "polaris_cdm_cluster_proxy"
+	keyPolarisCDMClusterSMTP                        = "polaris_cdm_cluster_smtp"
+	keyPolarisCDMClusterSNMP                        = "polaris_cdm_cluster_snmp"
+	keyPolarisCDMClusterSyslog                      = "polaris_cdm_cluster_syslog"
 	keyPolarisCDMRegistration                       = "polaris_cdm_registration"
+	keyPolarisCDMSLADomain                          = "polaris_cdm_sla_domain"
+	keyPolarisCluster                               = "polaris_cluster"
+	keyPolarisClusters                              = "polaris_clusters"
+	keyPolarisClusterUpgrade                        = "polaris_cluster_upgrade"
 	keyPolarisDataCenterArchivalLocation            = "polaris_data_center_archival_location"
 	keyPolarisDataCenterArchivalLocationAmazonS3    = "polaris_data_center_archival_location_amazon_s3"
+	keyPolarisDataCenterArchivalLocationAzureBlob   = "polaris_data_center_archival_location_azure_blob"
+	keyPolarisDataCenterArchivalLocationGCS         = "polaris_data_center_archival_location_gcs"
+	keyPolarisDataCenterArchivalLocationNFS         = "polaris_data_center_archival_location_nfs"
+	keyPolarisDataCenterArchivalLocationS3Compat    = "polaris_data_center_archival_location_s3_compatible"
 	keyPolarisDataCenterAWSAccount                  = "polaris_data_center_aws_account"
 	keyPolarisDataCenterAzureSubscription           = "polaris_data_center_azure_subscription"
 	keyPolarisDeployment                            = "polaris_deployment"
 	keyPolarisFeatures                              = "polaris_features"
 	keyPolarisGCPArchivalLocation                   = "polaris_gcp_archival_location"
+	keyPolarisGCPCloudCluster                       = "polaris_gcp_cloud_cluster"
 	keyPolarisGCPCustomLabels                       = "polaris_gcp_custom_labels"
 	keyPolarisGCPExocompute                         = "polaris_gcp_exocompute"
+	keyPolarisGCPExocomputeClusterAttachment        = "polaris_gcp_exocompute_cluster_attachment"
 	keyPolarisGCPPermissions                        = "polaris_gcp_permissions"
+	keyPolarisGCPPrivateContainerRegistry           = "polaris_gcp_private_container_registry"
 	keyPolarisGCPProject                            = "polaris_gcp_project"
 	keyPolarisGCPServiceAccount                     = "polaris_gcp_service_account"
 	keyPolarisObject                                = "polaris_object"
 	keyPolarisRefresh                               = "polaris_refresh"
 	keyPolarisManaged                               = "polaris_managed"
 	keyPolarisNCDArchivalLocation                   = "polaris_ncd_archival_location"
+	keyPolarisReplicationPair                       = "polaris_replication_pair"
+	keyPolarisReplicationTargetCluster              = "polaris_replication_target_cluster"
 	keyPolarisSnapshot                              = "polaris_snapshot"
+	keyPolarisSLAArchivalLocationMigration          = "polaris_sla_archival_location_migration"
 	keyPolarisSLADomain                             = "polaris_sla_domain"
 	keyPolarisSLADomainAssignment                   = "polaris_sla_domain_assignment"
+	keyPolarisSLADomainUpgrade                      = "polaris_sla_domain_upgrade"
 	keyPolarisSLASourceCluster                      = "polaris_sla_source_cluster"
 	keyPolarisTagRule                               = "polaris_tag_rule"
+	keyPolarisTaskWait                              = "polaris_task_wait"
 	keyPolicy                                       = "policy"
+	keyPort                                         = "port"
 	keyPortNumber                                   = "port_number"
+	keyPorts                                        = "ports"
+	keyPreviousVersion                              = "previous_version"
 	keyPrivateExocomputeDNSZoneID                   = "private_exocompute_dns_zone_id"
+	keyPrivateKey                                   = "private_key"
+	keyProductType                                  = "product_type"
 	keyProfile                                      = "profile"
+	keyProgress                                     = "progress"
 	keyProject                                      = "project"
 	keyProjectID                                    = "project_id"
 	keyProjectName                                  = "project_name"
@@ -325,13 +433,19 @@ const (
 	keyQuarterlySchedule                            = "quarterly_schedule"
 	keyQuarterStartMonth                            = "quarter_start_month"
 	keyRDSProtection                                = "rds_protection"
+	keyRecommendedVersion                           = "recommended_version"
 	keyRedundancy                                   = "redundancy"
 	keyRegion                                       = "region"
 	keyRegionalConfig                               = "regional_config"
 	keyRegions                                      = "regions"
+	keyRegistrationMethod                           = "registration_method"
 	keyRegistrationMode                             = "registration_mode"
+	keyRegistryURL                                  = "registry_url"
+	keyReplicatedPhysicalBytes                      = "replicated_physical_bytes"
+	keyReplicatedSnapshotCount                      = "replicated_snapshot_count"
 	keyReplicationPair                              = "replication_pair"
 	keyReplicationSpec                              = "replication_spec"
+	keyRepoURL                                      = "repo_url"
 	keyCascadingArchival                            = "cascading_archival"
 	keyArchivalThreshold                            = "archival_threshold"
 	keyArchivalThresholdUnit                        = "archival_threshold_unit"
@@ -349,8 +463,11 @@ const (
 	keyResourceGroupPrefix                          = "resource_group_prefix"
 	keyResourceGroupTags                            = "resource_group_tags"
 	keyResourceGroupRegion                          = "resource_group_region"
+	keyResumeAt                                     = "resume_at"
 	keyRetention                                    = "retention"
 	keyBackupType                                   = "backup_type"
+	keyBackupWindow                                 = "backup_window"
+	keyCloneFromID                                  = "clone_from_id"
 	keyLTRConfig                                    = "ltr_config"
 	keyMonthlyRetention                             = "monthly_retention"
 	keyWeekOfYear                                   = "week_of_year"
@@ -358,6 +475,7 @@ const (
 	keyYearlyRetention                              = "yearly_retention"
 	keyRetentionLock                                = "retention_lock"
 	keyRetentionLockComplianceAcknowledgment        = "compliance_mode_acknowledgment"
+	keyRetentionLockMode                            = "retention_lock_mode"
 	keyRetentionUnit                                = "retention_unit"
 	keyRetrievalTier                                = "retrieval_tier"
 	keyRingName                                     = "ring_name"
@@ -374,31 +492,56 @@ const (
 	keyRoleTemplateID                               = "role_template_id"
 	keyRSAKey                                       = "rsa_key"
 	keyS3Endpoint                                   = "s3_endpoint"
+	keySchedule                                     = "schedule"
 	keyScope                                        = "scope"
+	keyScopes                                       = "scopes"
 	keySDKAuth                                      = "sdk_auth"
 	keySecretKey                                    = "secret_key"
+	keySecurity                                     = "security"
 	keySecurityGroupID                              = "security_group_id"
 	keySecurityGroupIDs                             = "security_group_ids"
+	keyServer                                       = "server"
+	keyServiceAccounts                              = "service_accounts"
 	keyServices                                     = "services"
 	keySignInURL                                    = "sign_in_url"
 	keySigningCertificate                           = "signing_certificate"
 	keySignOutURL                                   = "sign_out_url"
 	keyServersAndApps                               = "servers_and_apps"
+	keySetupType                                    = "setup_type"
 	keySnappableType                                = "snappable_type"
+	keySnapshotConsistency                          = "snapshot_consistency"
 	keySQLDBProtection                              = "sql_db_protection"
 	keySQLMIProtection                              = "sql_mi_protection"
 	keySetupYAML                                    = "setup_yaml"
+	keySHA                                          = "sha"
+	keySLACompliance                                = "sla_compliance"
+	keySLADomain                                    = "sla_domain"
+	keySLADomainEstimate                            = "sla_domain_estimate"
 	keySLADomainID                                  = "sla_domain_id"
+	keySLADomainIDs                                 = "sla_domain_ids"
+	keySLADomainName                                = "sla_domain_name"
+	keySLADomainTemplate                            = "sla_domain_template"
+	keySLAPause                                     = "sla_pause"
+	keySnapshotCount                                = "snapshot_count"
+	keySnapshotIDs                                  = "snapshot_ids"
 	keySnapshotPrivateAccessDNSZoneID               = "snapshot_private_access_dns_zone_id"
+	keySnapshotRetention                            = "snapshot_retention"
 	keySnapshotWindow                               = "snapshot_window"
 	keySPInitiatedSignInURL                         = "sp_initiated_sign_in_url"
 	keySPInitiatedTestURL                           = "sp_initiated_test_url"
 	keySourceCluster                                = "source_cluster"
+	keySourceClusterID                              = "source_cluster_id"
+	keySourceClusterIDs                             = "source_cluster_ids"
+	keySourceClusterName                            = "source_cluster_name"
+	keySourceGateway                                = "source_gateway"
+	keySourceLocationID                             = "source_location_id"
+	keySourceURI                                    = "source_uri"
 	keySSOGroup                                     = "sso_group"
 	keySSOGroupID                                   = "sso_group_id"
 	keyStackARN                                     = "stack_arn"
 	keyStackName                                    = "stack_name"
 	keyStartAt                                      = "start_at"
+	keyStartTime                                    = "start_time"
 	keyStatements                                   = "statements"
 	keyStatus                                       = "status"
 	keyStorageAccountEndpointSuffix                 = "storage_account_endpoint_suffix"
@@ -411,6 +554,7 @@ const (
 	keySubnet                                       = "subnet"
 	keySubnetAzConfigs                              = "subnet_az_config"
 	keySubnetID                                     = "subnet_id"
+	keySubnetMask                                   = "subnet_mask"
 	keySubnetName                                   = "subnet_name"
 	keySubnets                                      = "subnets"
 	keySubscription                                 = "subscription"
@@ -421,6 +565,7 @@ const (
 	keySubscriptionNotActions                       = "subscription_not_actions"
 	keySubscriptionNotDataActions                   = "subscription_not_data_actions"
 	keySyncStatus                                   = "sync_status"
+	keySystemStatus                                 = "system_status"
 	keyTagAllValues                                 = "tag_all_values"
 	keyTag                                          = "tag"
 	keyTagMatchAll                                  = "match_all"
@@ -428,7 +573,17 @@ const (
 	keyTagValue                                     = "tag_value"
 	keyValues                                       = "values"
 	keyTargetCluster                                = "target_cluster"
+	keyTargetClusterAddress                         = "target_cluster_address"
+	keyTargetClusterID                              = "target_cluster_id"
+	keyTargetClusterName                            = "target_cluster_name"
+	keyTargetGateway                                = "target_gateway"
+	keyTargetLocationID                             = "target_location_id"
 	keyTargetType                                   = "target_type"
+	keyTargetURI                                    = "target_uri"
+	keyTaskChainID                                  = "task_chain_id"
+	keyTaskID                                       = "task_id"
+	keyTaskType                                     = "task_type"
+	keyTemplate                                     = "template"
 	keyTemplateURL                                  = "template_url"
 	keyTenantDomain                                 = "tenant_domain"
 	keyTenantID                                     = "tenant_id"
@@ -441,8 +596,16 @@ const (
 	keyTokenCacheDir                                = "token_cache_dir"
 	keyTokenCacheSecret                             = "token_cache_secret"
 	keyTokenRefresh                                 = "token_refresh"
+	keyTotalCapacity                                = "total_capacity"
+	keyTrapReceiver                                 = "trap_receiver"
 	keyTriggerHealthCheck                           = "trigger_health_check"
 	keyTrustPolicies                                = "trust_policies"
+	keyType                                         = "type"
+	keyTypes                                        = "types"
+	keyUnregisterOnDestroy                          = "unregister_on_destroy"
+	keyUpgradeAvailable                             = "upgrade_available"
+	keyUpgradeStatus                                = "upgrade_status"
+	keyUpgradeType                                  = "upgrade_type"
 	keyURL                                          = "url"
 	keyUserAssignedManagedIdentityClientID          = "user_assigned_managed_identity_client_id"
 	keyUserAssignedManagedIdentityName              = "user_assigned_managed_identity_name"
@@ -455,13 +618,19 @@ const (
 	keyUserID                                       = "user_id"
 	keyUsername                                     = "username"
 	keyUseCase                                      = "use_case"
+	keyUsedCapacity                                 = "used_capacity"
 	keyUsePlacementGroups                           = "use_placement_groups"
 	keyVMConfig                                     = "vm_config"
 	keyVMType                                       = "vm_type"
 	keyVMwareVMConfig                               = "vmware_vm_config"
 	keyUsers                                        = "users"
 	keyVaultName                                    = "vault_name"
+	keyValidateConnection                           = "validate_connection"
 	keyVersion                                      = "version"
+	keyVersionStatus                                = "version_status"
+	keyVLAN                                         = "vlan"
+	keyVLANID                                       = "vlan_id"
+	keyVLANIPs                                      = "vlan_ips"
 	keyVnet                                         = "vnet"
 	keyVnetResourceGroup                            = "vnet_resource_group"
 	keyVPCID                                        = "vpc_id"
@@ -475,4 +644,5 @@ const (
 	keyWeeklySchedule                               = "weekly_schedule"
 	keyYearlySchedule                               = "yearly_schedule"
 	keyYearStartMonth                               = "year_start_month"
+	keyZone                                         = "zone"
 )
@@ -72,40 +72,62 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			keyPolarisAWSAccount:                         resourceAwsAccount(),
-			keyPolarisAWSArchivalLocation:                resourceAwsArchivalLocation(),
-			keyPolarisAWSCloudCluster:                    resourceAwsCloudCluster(),
-			keyPolarisAWSCNPAccount:                      resourceAwsCnpAccount(),
-			keyPolarisAWSCNPAccountAttachments:           resourceAwsCnpAccountAttachments(),
-			keyPolarisAWSCNPAccountTrustPolicy:           resourceAwsCnpAccountTrustPolicy(),
-			keyPolarisAWSCustomTags:                      resourceAwsCustomTags(),
-			keyPolarisAWSExocompute:                      resourceAwsExocompute(),
-			keyPolarisAWSExocomputeClusterAttachment:     resourceAwsExocomputeClusterAttachment(),
-			keyPolarisAWSPrivateContainerRegistry:        resourceAwsPrivateContainerRegistry(),
-			keyPolarisAzureArchivalLocation:              resourceAzureArchivalLocation(),
-			keyPolarisAzureCloudCluster:                  resourceAzureCloudCluster(),
-			keyPolarisAzureCustomTags:                    resourceAzureCustomTags(),
-			keyPolarisAzureExocompute:                    resourceAzureExocompute(),
-			keyPolarisAzureExocomputeClusterAttachment:   resourceAzureExocomputeClusterAttachment(),
-			keyPolarisAzurePrivateContainerRegistry:      resourceAzurePrivateContainerRegistry(),
-			keyPolarisAzureServicePrincipal:              resourceAzureServicePrincipal(),
-			keyPolarisAzureSubscription:                  resourceAzureSubscription(),
-			keyPolarisCDMBootstrap:                       resourceCDMBootstrap(),
-			keyPolarisCDMBootstrapCCESAWS:                resourceCDMBootstrapCCESAWS(),
-			keyPolarisCDMBootstrapCCESAzure:              resourceCDMBootstrapCCESAzure(),
-			keyPolarisCDMRegistration:                    resourceCDMRegistration(),
-			keyPolarisDataCenterAWSAccount:               resourceDataCenterAWSAccount(),
-			keyPolarisDataCenterAzureSubscription:        resourceDataCenterAzureSubscription(),
-			keyPolarisDataCenterArchivalLocationAmazonS3: resourceDataCenterArchivalLocationAmazonS3(),
-			keyPolarisGCPArchivalLocation:                resourceGcpArchivalLocation(),
-			keyPolarisGCPCustomLabels:                    resourceGcpCustomLabels(),
-			keyPolarisGCPExocompute:                      resourceGcpExocompute(),
-			keyPolarisGCPProject:                         resourceGcpProject(),
-			keyPolarisGCPServiceAccount:                  resourceGcpServiceAccount(),
-			keyPolarisRefresh:                            resourceRefresh(),
-			keyPolarisSLADomain:                          resourceSLADomain(),
-			keyPolarisSLADomainAssignment:                resourceSLADomainAssignment(),
-			keyPolarisTagRule:                            resourceTagRule(),
+			keyPolarisAWSAccount:                          resourceAwsAccount(),
+			keyPolarisAWSArchivalLocation:                 resourceAwsArchivalLocation(),
+			keyPolarisAWSCloudCluster:                     resourceAwsCloudCluster(),
+			keyPolarisAWSCNPAccount:                       resourceAwsCnpAccount(),
+			keyPolarisAWSCNPAccountAttachments:            resourceAwsCnpAccountAttachments(),
+			keyPolarisAWSCNPAccountTrustPolicy:            resourceAwsCnpAccountTrustPolicy(),
+			keyPolarisAWSCustomTags:                       resourceAwsCustomTags(),
+			keyPolarisAWSExocompute:                       resourceAwsExocompute(),
+			keyPolarisAWSExocomputeClusterAttachment:      resourceAwsExocomputeClusterAttachment(),
+			keyPolarisAWSPrivateContainerRegistry:         resourceAwsPrivateContainerRegistry(),
+			keyPolarisAzureArchivalLocation:               resourceAzureArchivalLocation(),
+			keyPolarisAzureCloudCluster:                   resourceAzureCloudCluster(),
+			keyPolarisAzureCustomTags:                     resourceAzureCustomTags(),
+			keyPolarisAzureExocompute:                     resourceAzureExocompute(),
+			keyPolarisAzureExocomputeClusterAttachment:    resourceAzureExocomputeClusterAttachment(),
+			keyPolarisAzurePrivateContainerRegistry:       resourceAzurePrivateContainerRegistry(),
+			keyPolarisAzureServicePrincipal:               resourceAzureServicePrincipal(),
+			keyPolarisAzureSubscription:                   resourceAzureSubscription(),
+			keyPolarisCDMBootstrap:                        resourceCDMBootstrap(),
+			keyPolarisCDMBootstrapCCESAWS:                 resourceCDMBootstrapCCESAWS(),
+			keyPolarisCDMBootstrapCCESAzure:               resourceCDMBootstrapCCESAzure(),
+			keyPolarisCDMBootstrapCCESGCP:                 resourceCDMBootstrapCCESGCP(),
+			keyPolarisCDMCertificate:                      resourceCDMCertificate(),
+			keyPolarisCDMCertificateBinding:               resourceCDMCertificateBinding(),
+			keyPolarisCDMClusterDNS:                       resourceCDMClusterDNS(),
+			keyPolarisCDMClusterNodes:                     resourceCDMClusterNodes(),
+			keyPolarisCDMClusterNTP:                       resourceCDMClusterNTP(),
+			keyPolarisCDMClusterProxy:                     resourceCDMClusterProxy(),
+			keyPolarisCDMClusterSMTP:                      resourceCDMClusterSMTP(),
+			keyPolarisCDMClusterSNMP:                      resourceCDMClusterSNMP(),
+			keyPolarisCDMClusterSyslog:                    resourceCDMClusterSyslog(),
+			keyPolarisCDMRegistration:                     resourceCDMRegistration(),
+			keyPolarisCDMSLADomain:                        resourceCDMSLADomain(),
+			keyPolarisClusterUpgrade:                      resourceClusterUpgrade(),
+			keyPolarisDataCenterAWSAccount:                resourceDataCenterAWSAccount(),
+			keyPolarisDataCenterAzureSubscription:         resourceDataCenterAzureSubscription(),
+			keyPolarisDataCenterArchivalLocationAmazonS3:  resourceDataCenterArchivalLocationAmazonS3(),
+			keyPolarisDataCenterArchivalLocationAzureBlob: resourceDataCenterArchivalLocationAzureBlob(),
+			keyPolarisDataCenterArchivalLocationGCS:       resourceDataCenterArchivalLocationGCS(),
+			keyPolarisDataCenterArchivalLocationNFS:       resourceDataCenterArchivalLocationNFS(),
+			keyPolarisDataCenterArchivalLocationS3Compat:  resourceDataCenterArchivalLocationS3Compatible(),
+			keyPolarisGCPArchivalLocation:                 resourceGcpArchivalLocation(),
+			keyPolarisGCPCloudCluster:                     resourceGcpCloudCluster(),
+			keyPolarisGCPCustomLabels:                     resourceGcpCustomLabels(),
+			keyPolarisGCPExocompute:                       resourceGcpExocompute(),
+			keyPolarisGCPExocomputeClusterAttachment:      resourceGcpExocomputeClusterAttachment(),
+			keyPolarisGCPPrivateContainerRegistry:         resourceGcpPrivateContainerRegistry(),
+			keyPolarisGCPProject:                          resourceGcpProject(),
+			keyPolarisGCPServiceAccount:                   resourceGcpServiceAccount(),
+			keyPolarisRefresh:                             resourceRefresh(),
+			keyPolarisReplicationPair:                     resourceReplicationPair(),
+			keyPolarisSLAArchivalLocationMigration:        resourceSLAArchivalLocationMigration(),
+			keyPolarisSLADomainAssignment:                 resourceSLADomainAssignment(),
+			keyPolarisSLADomainUpgrade:                    resourceSLADomainUpgrade(),
+			keyPolarisTagRule:                             resourceTagRule(),
+			keyPolarisTaskWait:                            resourceTaskWait(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -117,6 +139,8 @@ func Provider() *schema.Provider {
 			keyPolarisAzureArchivalLocation:       dataSourceAzureArchivalLocation(),
 			keyPolarisAzurePermissions:            dataSourceAzurePermissions(),
 			keyPolarisAzureSubscription:           dataSourceAzureSubscription(),
+			keyPolarisCluster:                     dataSourceCluster(),
+			keyPolarisClusters:                    dataSourceClusters(),
 			keyPolarisDataCenterArchivalLocation:  dataSourceDataCenterArchivalLocation(),
 			keyPolarisDataCenterAWSAccount:        dataSourceDataCenterAWSAccount(),
 			keyPolarisDataCenterAzureSubscription: dataSourceDataCenterAzureSubscription(),
@@ -127,6 +151,7 @@ func Provider() *schema.Provider {
 			keyPolarisGCPProject:                  dataSourceGcpProject(),
 			keyPolarisObject:                      dataSourceObject(),
 			keyPolarisNCDArchivalLocation:         dataSourceNCDArchivalLocation(),
+			keyPolarisReplicationTargetCluster:    dataSourceReplicationTargetCluster(),
 			keyPolarisSnapshot:                    dataSourceSnapshot(),
 			keyPolarisSLADomain:                   dataSourceSLADomain(),
 			keyPolarisSLASourceCluster:            dataSourceSLASourceCluster(),
@@ -157,6 +182,10 @@ type client struct {
 	logger        log.Logger
 	polarisClient *polaris.Client
 	polarisErr    error
+
+	// snapshotExpirations tracks the snapshots expired by the snapshot
+	// retention resources.
+	snapshotExpirations snapshotExpirations
 }
 
 func newClient(ctx context.Context, credentials string, cacheParams polaris.CacheParams) (*client, error) {
@@ -0,0 +1,124 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// Cloud native archival location states.
+const (
+	archivalStateActive = "ACTIVE"
+	archivalStatePaused = "PAUSED"
+)
+
+// Cloud native archival location connection statuses.
+const (
+	connectionStatusConnected = "CONNECTED"
+	connectionStatusPaused    = "PAUSED"
+)
+
+// pauseTargetQuery is the GraphQL mutation used to pause an archival location.
+const pauseTargetQuery = `mutation SdkGolangPauseTarget($id: String!) {
+    result: pauseTarget(input: {
+        id: $id
+    }) {
+        locationId
+    }
+}`
+
+// resumeTargetQuery is the GraphQL mutation used to resume a paused archival
+// location.
+const resumeTargetQuery = `mutation SdkGolangResumeTarget($id: String!) {
+    result: resumeTarget(input: {
+        id: $id
+    }) {
+        locationId
+    }
+}`
+
+// archivalStateFromConnectionStatus returns the archival state of an archival
+// location with the specified connection status.
+func archivalStateFromConnectionStatus(connectionStatus string) string {
+	if connectionStatus == connectionStatusPaused {
+		return archivalStatePaused
+	}
+
+	return archivalStateActive
+}
+
+// setArchivalLocationState pauses or resumes the archival location with the
+// specified ID, depending on the archival state.
+func setArchivalLocationState(ctx context.Context, gql *graphql.Client, locationID uuid.UUID, state string) error {
+	query := resumeTargetQuery
+	if state == archivalStatePaused {
+		query = pauseTargetQuery
+	}
+
+	var result struct {
+		LocationID string `json:"locationId"`
+	}
+	err := gqlRequest(ctx, gql, query, struct {
+		ID string `json:"id"`
+	}{ID: locationID.String()}, &result)
+	if err != nil {
+		return fmt.Errorf("failed to set archival location %s state to %s: %s", locationID, state, err)
+	}
+
+	return nil
+}
+
+// waitForArchivalLocationConnection polls the connection status of the
+// archival location until it's connected. If the archival location isn't
+// connected before the context expires, an error holding the last connection
+// status is returned.
+func waitForArchivalLocationConnection(ctx context.Context, locationID uuid.UUID, connectionStatus func(ctx context.Context) (string, error)) error {
+	var status string
+	for {
+		var err error
+		status, err = connectionStatus(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get connection status of archival location %s: %s", locationID, err)
+		}
+		if status == connectionStatusConnected {
+			return nil
+		}
+
+		tflog.Debug(ctx, "waiting for archival location connection", map[string]any{
+			"location_id":       locationID.String(),
+			"connection_status": status,
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("archival location %s failed the connection test, connection status is %s: verify "+
+				"that the cloud account has been onboarded with the CLOUD_NATIVE_ARCHIVAL feature and that the "+
+				"permissions required by the feature have been granted", locationID, status)
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestArchivalStateFromConnectionStatus(t *testing.T) {
+	tests := []struct {
+		connectionStatus string
+		want             string
+	}{
+		{connectionStatus: connectionStatusConnected, want: archivalStateActive},
+		{connectionStatus: connectionStatusPaused, want: archivalStatePaused},
+		{connectionStatus: "DISCONNECTED", want: archivalStateActive},
+		{connectionStatus: "", want: archivalStateActive},
+	}
+
+	for _, tc := range tests {
+		if got := archivalStateFromConnectionStatus(tc.connectionStatus); got != tc.want {
+			t.Errorf("connection status %q: expected %q, got %q", tc.connectionStatus, tc.want, got)
+		}
+	}
+}
+
+func TestSetArchivalLocationState(t *testing.T) {
+	locationID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+
+	tests := []struct {
+		name          string
+		state         string
+		err           error
+		wantOperation string
+		wantErr       string
+	}{
+		{name: "Pause", state: archivalStatePaused, wantOperation: "SdkGolangPauseTarget"},
+		{name: "Resume", state: archivalStateActive, wantOperation: "SdkGolangResumeTarget"},
+		{
+			name:          "Error",
+			state:         archivalStatePaused,
+			err:           errors.New("location is busy"),
+			wantOperation: "SdkGolangPauseTarget",
+			wantErr:       "failed to set archival location " + locationID.String() + " state to PAUSED",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return map[string]any{"locationId": locationID.String()}, nil
+			})
+
+			err := setArchivalLocationState(context.Background(), c.polarisClient.GQL, locationID, tc.state)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) || !strings.Contains(err.Error(), tc.err.Error()) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			reqs := srv.Requests()
+			if len(reqs) != 1 {
+				t.Fatalf("expected 1 request, got %d", len(reqs))
+			}
+			if op := reqs[0].Operation; op != tc.wantOperation {
+				t.Errorf("expected operation %q, got %q", tc.wantOperation, op)
+			}
+			if id := reqs[0].Variables["id"]; id != locationID.String() {
+				t.Errorf("expected id variable %q, got %v", locationID, id)
+			}
+		})
+	}
+}
+
+func TestWaitForArchivalLocationConnection(t *testing.T) {
+	locationID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+
+	t.Run("Connected", func(t *testing.T) {
+		err := waitForArchivalLocationConnection(context.Background(), locationID, func(ctx context.Context) (string, error) {
+			return connectionStatusConnected, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("StatusError", func(t *testing.T) {
+		err := waitForArchivalLocationConnection(context.Background(), locationID, func(ctx context.Context) (string, error) {
+			return "", errors.New("not found")
+		})
+		if err == nil || !strings.Contains(err.Error(), "failed to get connection status") {
+			t.Fatalf("expected connection status error, got %v", err)
+		}
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err := waitForArchivalLocationConnection(ctx, locationID, func(ctx context.Context) (string, error) {
+			return "DISCONNECTED", nil
+		})
+		if err == nil || !strings.Contains(err.Error(), "connection status is DISCONNECTED") {
+			t.Fatalf("expected connection test error, got %v", err)
+		}
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -53,6 +54,17 @@ to be specified:
   * ´SPECIFIC_REGION´ - Storing snapshots in another region can increase total data
     transfer charges. The ´region´ field specifies the region.
 
+Archiving to the archival location can be paused by setting ´archival_state´ to
+´PAUSED´, and resumed by setting it back to ´ACTIVE´. When ´validate_connection´
+is true, the connection to the archival location is tested when it's created.
+If the connection status doesn't become ´CONNECTED´ before the create timeout
+expires, the apply fails and the archival location is marked as tainted. The
+default create timeout is 10 minutes and can be overridden with a ´timeouts´
+block.
+
+To move the archival of an SLA domain from one archival location to another,
+use the ´polaris_sla_archival_location_migration´ resource.
+
 -> **Note:** The AWS bucket holding the archived data is not created until the first
    protected object is archived.
 `
@@ -64,6 +76,10 @@ func resourceAwsArchivalLocation() *schema.Resource {
 		UpdateContext: awsUpdateArchivalLocation,
 		DeleteContext: awsDeleteArchivalLocation,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Description: description(resourceAWSArchivalLocationDescription),
 		Schema: map[string]*schema.Schema{
 			keyID: {
@@ -94,6 +110,14 @@ func resourceAwsArchivalLocation() *schema.Resource {
 				Optional:    true,
 				Description: "AWS bucket tags. Each tag will be added to the bucket created by RSC.",
 			},
+			keyArchivalState: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  archivalStateActive,
+				Description: "State of the cloud native archival location. Possible values are `ACTIVE` and `PAUSED`. " +
+					"RSC doesn't archive snapshots to a paused archival location. Default value is `ACTIVE`.",
+				ValidateFunc: validation.StringInSlice([]string{archivalStateActive, archivalStatePaused}, false),
+			},
 			keyConnectionStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -139,6 +163,14 @@ func resourceAwsArchivalLocation() *schema.Resource {
 					"GLACIER_FLEXIBLE_RETRIEVAL",
 				}, false),
 			},
+			keyValidateConnection: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the connection to the cloud native archival location is tested when the " +
+					"archival location is created. The apply fails if the connection status of the archival location " +
+					"doesn't become `CONNECTED` before the create timeout expires. Default value is `false`.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -174,6 +206,30 @@ func awsCreateArchivalLocation(ctx context.Context, d *schema.ResourceData, m an
 	}
 
 	d.SetId(targetMappingID.String())
+
+	// Test the connection to the archival location. If the test fails, the
+	// archival location is left in place and marked as tainted.
+	if d.Get(keyValidateConnection).(bool) {
+		err := waitForArchivalLocationConnection(ctx, targetMappingID, func(ctx context.Context) (string, error) {
+			targetMapping, err := archival.Wrap(client).AWSTargetMappingByID(ctx, targetMappingID)
+			if err != nil {
+				return "", err
+			}
+			return targetMapping.ConnectionStatus.Status, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Pause the archival location, archival locations are created in the
+	// active state.
+	if state := d.Get(keyArchivalState).(string); state == archivalStatePaused {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, state); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	awsReadArchivalLocation(ctx, d, m)
 	return nil
 }
@@ -209,6 +265,9 @@ func awsReadArchivalLocation(ctx context.Context, d *schema.ResourceData, m any)
 	if err := d.Set(keyAccountID, targetTemplate.CloudAccount.ID.String()); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(keyArchivalState, archivalStateFromConnectionStatus(targetMapping.ConnectionStatus.Status)); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set(keyConnectionStatus, targetMapping.ConnectionStatus.Status); err != nil {
 		return diag.FromErr(err)
 	}
@@ -262,6 +321,13 @@ func awsUpdateArchivalLocation(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
+	// Pause or resume the archival location.
+	if d.HasChange(keyArchivalState) {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, d.Get(keyArchivalState).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -61,6 +61,16 @@ number of nodes, instance types, and network configuration.
    (active SLAs, global SLAs, or RCV locations), the deletion will fail and you must
    resolve these conditions first. Use the 'force_cluster_delete_on_destroy' option
    to force removal when eligible.
+
+~> **Note:** Increasing ´num_nodes´ scales out the cluster, changing
+   ´instance_type´ replaces the cluster nodes one node at a time and changing
+   ´cdm_version´ upgrades the cluster using a rolling upgrade. These operations
+   are performed in place and can take several hours, the update timeout
+   defaults to 8 hours. The upgrade compatibility of the new CDM version is
+   checked when planning. If an operation fails, the planned values
+   aren't saved. The number of nodes and the instance type are refreshed from
+   RSC, an instance type which doesn't match the vCPUs of the nodes is read as
+   empty.
 `
 
 // This resource uses a template for its documentation due to a bug in the TF
@@ -138,8 +148,7 @@ func resourceAwsCloudCluster() *schema.Resource {
 						keyNumNodes: {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ForceNew:     true,
-							Description:  "Number of nodes in the cluster. Changing this forces a new resource to be created.",
+							Description:  "Number of nodes in the cluster. Increasing the number of nodes scales out the cluster in place. The number of nodes cannot be decreased.",
 							ValidateFunc: validateNumNodes,
 						},
 						keyDNSNameServers: {
@@ -221,16 +230,14 @@ func resourceAwsCloudCluster() *schema.Resource {
 			keyVMConfig: {
 				Type:        schema.TypeList,
 				Required:    true,
-				ForceNew:    true,
 				MaxItems:    1,
-				Description: "VM configuration for the cluster nodes. Changing this forces a new resource to be created.",
+				Description: "VM configuration for the cluster nodes.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						keyCDMVersion: {
 							Type:         schema.TypeString,
 							Required:     true,
-							ForceNew:     true,
-							Description:  "CDM version to use. Changing this forces a new resource to be created.",
+							Description:  "CDM version to use. Changing this upgrades the cluster in place using a rolling upgrade orchestrated by RSC. The upgrade compatibility is checked when planning.",
 							ValidateFunc: validation.StringIsNotWhiteSpace,
 						},
 						keyCDMProduct: {
@@ -241,8 +248,7 @@ func resourceAwsCloudCluster() *schema.Resource {
 						keyInstanceType: {
 							Type:        schema.TypeString,
 							Required:    true,
-							ForceNew:    true,
-							Description: "AWS instance type for the cluster nodes. Changing this forces a new resource to be created. Supported values are `M5_4XLARGE`, `M6I_2XLARGE`, `M6I_4XLARGE`, `M6I_8XLARGE`, `R6I_4XLARGE`, `M6A_2XLARGE`, `M6A_4XLARGE`, `M6A_8XLARGE` and `R6A_4XLARGE`.",
+							Description: "AWS instance type for the cluster nodes. Changing this replaces the cluster nodes in place, one node at a time. Supported values are `M5_4XLARGE`, `M6I_2XLARGE`, `M6I_4XLARGE`, `M6I_8XLARGE`, `R6I_4XLARGE`, `M6A_2XLARGE`, `M6A_4XLARGE`, `M6A_8XLARGE` and `R6A_4XLARGE`.",
 							ValidateFunc: validation.StringInSlice([]string{
 								string(gqlcloudcluster.AwsInstanceTypeM5_4XLarge),
 								string(gqlcloudcluster.AwsInstanceTypeM6I_2XLarge),
@@ -324,6 +330,20 @@ func resourceAwsCloudCluster() *schema.Resource {
 					},
 				},
 			},
+			keyTaskID: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "ID of the RSC activity series of the cluster create job. Only set when the cluster is " +
+					"created without waiting for completion. Use with the `polaris_task_wait` resource to wait for " +
+					"the create job to finish.",
+			},
+			keyWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Flag to determine if Terraform should wait for the cluster create job to complete. " +
+					"When `false`, Terraform returns as soon as the create job has started. Default value is `true`.",
+			},
 		},
 		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 			vmConfigList := diff.Get(keyVMConfig).([]any)
@@ -357,11 +377,12 @@ func resourceAwsCloudCluster() *schema.Resource {
 				}
 			}
 
-			return nil
+			return customizeDiffCloudClusterLifecycle(ctx, diff, meta)
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(60 * time.Minute),
 			Read:    schema.DefaultTimeout(20 * time.Minute),
+			Update:  schema.DefaultTimeout(8 * time.Hour),
 			Default: schema.DefaultTimeout(20 * time.Minute),
 		},
 	}
@@ -486,20 +507,38 @@ func awsCreateCloudCluster(ctx context.Context, d *schema.ResourceData, m any) d
 		VMConfig:             vmConfig,
 	}
 
-	cloudcluster, err := cloudcluster.Wrap(client).CreateCloudCluster(ctx, input, false)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	if d.Get(keyWaitForCompletion).(bool) {
+		cloudcluster, err := cloudcluster.Wrap(client).CreateCloudCluster(ctx, input, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	d.SetId(cloudcluster.ID.String())
+		d.SetId(cloudcluster.ID.String())
 
-	vmConfigList = d.Get(keyVMConfig).([]any)
-	if len(vmConfigList) > 0 {
-		vmConfigMap := vmConfigList[0].(map[string]any)
-		vmConfigMap[keyCDMProduct] = cloudcluster.CdmProduct
-		d.Set(keyVMConfig, []any{vmConfigMap})
+		vmConfigList = d.Get(keyVMConfig).([]any)
+		if len(vmConfigList) > 0 {
+			vmConfigMap := vmConfigList[0].(map[string]any)
+			vmConfigMap[keyCDMProduct] = cloudcluster.CdmProduct
+			d.Set(keyVMConfig, []any{vmConfigMap})
+		}
+		d.Set(keyCloudAccountID, cloudcluster.CloudAccountID)
+	} else {
+		// Only wait for the create job to start, the polaris_task_wait
+		// resource can be used to wait for the job to finish.
+		clusterID, taskID, err := startCloudClusterCreate(ctx, client.GQL, input.ClusterConfig.ClusterName,
+			func(ctx context.Context) (uuid.UUID, error) {
+				cluster, err := cloudcluster.Wrap(client).CreateCloudCluster(ctx, input, false)
+				return cluster.ID, err
+			})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(clusterID.String())
+		if err := d.Set(keyTaskID, taskID); err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	d.Set(keyCloudAccountID, cloudcluster.CloudAccountID)
 
 	// Read back the created resource to populate computed fields. A failed
 	// readback must not be returned as an error: the resource was successfully
@@ -536,6 +575,14 @@ func awsReadCloudCluster(ctx context.Context, d *schema.ResourceData, m any) dia
 		return diag.FromErr(err)
 	}
 
+	// A cluster created without waiting for completion might not be visible
+	// until the create job has finished.
+	if pending, err := cloudClusterCreatePending(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	} else if pending {
+		return nil
+	}
+
 	clusterFilter := gqlcluster.SearchFilter{
 		ID: []string{id.String()},
 	}
@@ -562,10 +609,20 @@ func awsReadCloudCluster(ctx context.Context, d *schema.ResourceData, m any) dia
 	clusterConfigList := d.Get(keyClusterConfig).([]any)
 	clusterConfigMap := clusterConfigList[0].(map[string]any)
 
+	// The node count is only known once the cluster nodes have registered.
+	if numNodes := len(cloudCluster.ClusterNodes.Edges); numNodes > 0 {
+		clusterConfigMap[keyNumNodes] = numNodes
+	}
+
 	// Check if the CDM version changed
 	vmConfigList := d.Get(keyVMConfig).([]any)
 	vmConfigMap := vmConfigList[0].(map[string]any)
 	vmConfigMap[keyCDMVersion] = cloudCluster.Version
+	instanceType, err := cloudClusterInstanceType(ctx, client, cloudCluster, vmConfigMap[keyInstanceType].(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vmConfigMap[keyInstanceType] = instanceType
 
 	// Read DNS, NTP, and DNS Search Domains from API and check if they match the Terraform state
 	dnsServers, err := gqlcluster.Wrap(client).DNSServers(ctx, uuid.MustParse(d.Id()))
@@ -658,6 +715,9 @@ func awsDeleteCloudCluster(ctx context.Context, d *schema.ResourceData, m any) d
 
 // awsUpdateCloudCluster updates the resource in-place. The following actions
 // are supported:
+//   - Scale out (add nodes)
+//   - Replace the instance type of the nodes
+//   - Upgrade the CDM version
 //   - Update Network DNS
 //   - Update Network DNS Search Domains
 //   - Update NTP
@@ -677,6 +737,10 @@ func awsUpdateCloudCluster(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(err)
 	}
 
+	if err := updateCloudClusterLifecycle(ctx, d, client, clusterID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	gqlCluster := gqlcluster.Wrap(client.GQL)
 
 	// Check if cluster_config block has changes
@@ -41,36 +41,11 @@ Rubrik container registry.
    account.
 
 ## Exocompute Image Bundles
-The following GraphQL query can be used to retrieve information about the image
-bundles used by RSC for exocompute:
-´´´graphql
-query ExotaskImageBundle($input: GetExotaskImageBundleInput) {
-  exotaskImageBundle(input: $input) {
-    bundleImages {
-      name
-      sha
-      tag
-    }
-    bundleVersion
-    eksVersion
-    repoUrl
-  }
-}
-´´´
-The ´repoUrl´ field holds the URL to the RSC container registry from where the RSC
-images can be pulled.
-
-The input is an object with the following structure:
-´´´json
-{
-  "input": {
-    "eksVersion": "1.29"
-  }
-}
-´´´
-Where ´eksVersion´ is the version of the customer's' EKS cluster. ´eksVersion´ is
-optional, if it's not specified it defaults to the latest EKS version supported by
-RSC.
+The ´polaris_exocompute_image_bundle´ data source can be used to retrieve
+information about the image bundles used by RSC for exocompute, e.g. the images
+which must be mirrored into the private container registry. The ´repo_url´ field
+holds the URL to the RSC container registry from where the RSC images can be
+pulled.
 
 The following GraphQL mutation can be used to set the approved bundle version for
 the RSC customer account:
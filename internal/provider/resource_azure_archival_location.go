@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -57,6 +58,17 @@ region must be specified. For ´SOURCE_REGION´, a customer managed key block fo
 each source region should be specified, source regions not having a customer
 managed key block will have its data encrypted with platform managed keys.
 
+Archiving to the archival location can be paused by setting ´archival_state´ to
+´PAUSED´, and resumed by setting it back to ´ACTIVE´. When ´validate_connection´
+is true, the connection to the archival location is tested when it's created.
+If the connection status doesn't become ´CONNECTED´ before the create timeout
+expires, the apply fails and the archival location is marked as tainted. The
+default create timeout is 10 minutes and can be overridden with a ´timeouts´
+block.
+
+To move the archival of an SLA domain from one archival location to another,
+use the ´polaris_sla_archival_location_migration´ resource.
+
 -> **Note:** When using ´SOURCE_REGION´ the Azure storage account isn't created
    until the first protected object is archived.
 `
@@ -68,7 +80,12 @@ func resourceAzureArchivalLocation() *schema.Resource {
 		UpdateContext: azureUpdateArchivalLocation,
 		DeleteContext: azureDeleteArchivalLocation,
 		CustomizeDiff: azureCustomizeDiffArchivalLocation,
-		Description:   description(resourceAzureArchivalLocationDescription),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Description: description(resourceAzureArchivalLocationDescription),
 		Schema: map[string]*schema.Schema{
 			keyID: {
 				Type:        schema.TypeString,
@@ -82,6 +99,14 @@ func resourceAzureArchivalLocation() *schema.Resource {
 				Description:  "RSC cloud account ID (UUID). Changing this forces a new resource to be created.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
+			keyArchivalState: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  archivalStateActive,
+				Description: "State of the cloud native archival location. Possible values are `ACTIVE` and `PAUSED`. " +
+					"RSC doesn't archive snapshots to a paused archival location. Default value is `ACTIVE`.",
+				ValidateFunc: validation.StringInSlice([]string{archivalStateActive, archivalStatePaused}, false),
+			},
 			keyConnectionStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -166,6 +191,14 @@ func resourceAzureArchivalLocation() *schema.Resource {
 				Description:  "Azure storage tier. Possible values are `COOL` and `HOT`. Default value is `COOL`.",
 				ValidateFunc: validation.StringInSlice([]string{"COOL", "HOT"}, false),
 			},
+			keyValidateConnection: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the connection to the cloud native archival location is tested when the " +
+					"archival location is created. The apply fails if the connection status of the archival location " +
+					"doesn't become `CONNECTED` before the create timeout expires. Default value is `false`.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -208,6 +241,30 @@ func azureCreateArchivalLocation(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	d.SetId(targetMappingID.String())
+
+	// Test the connection to the archival location. If the test fails, the
+	// archival location is left in place and marked as tainted.
+	if d.Get(keyValidateConnection).(bool) {
+		err := waitForArchivalLocationConnection(ctx, targetMappingID, func(ctx context.Context) (string, error) {
+			targetMapping, err := archival.Wrap(client).AzureTargetMappingByID(ctx, targetMappingID)
+			if err != nil {
+				return "", err
+			}
+			return targetMapping.ConnectionStatus.Status, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Pause the archival location, archival locations are created in the
+	// active state.
+	if state := d.Get(keyArchivalState).(string); state == archivalStatePaused {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, state); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	azureReadArchivalLocation(ctx, d, m)
 	return nil
 }
@@ -241,6 +298,9 @@ func azureReadArchivalLocation(ctx context.Context, d *schema.ResourceData, m an
 	if err := d.Set(keyCloudAccountID, targetMapping.TargetTemplate.CloudAccount.ID.String()); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(keyArchivalState, archivalStateFromConnectionStatus(targetMapping.ConnectionStatus.Status)); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set(keyConnectionStatus, targetMapping.ConnectionStatus.Status); err != nil {
 		return diag.FromErr(err)
 	}
@@ -304,6 +364,13 @@ func azureUpdateArchivalLocation(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	// Pause or resume the archival location.
+	if d.HasChange(keyArchivalState) {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, d.Get(keyArchivalState).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
@@ -41,24 +41,11 @@ standard Rubrik container registry.
    customer account.
 
 ## Exocompute Image Bundles
-The following GraphQL query can be used to retrieve information about the image
-bundles used by RSC for exocompute:
-´´´graphql
-query ExotaskImageBundle {
-  exotaskImageBundle {
-    bundleImages {
-      name
-      sha
-      tag
-    }
-    bundleVersion
-    eksVersion
-    repoUrl
-  }
-}
-´´´
-The ´repoUrl´ field holds the URL to the RSC container registry from where the
-RSC images can be pulled.
+The ´polaris_exocompute_image_bundle´ data source can be used to retrieve
+information about the image bundles used by RSC for exocompute, e.g. the images
+which must be mirrored into the private container registry. The ´repo_url´ field
+holds the URL to the RSC container registry from where the RSC images can be
+pulled.
 
 The following GraphQL mutation can be used to set the approved bundle version
 for the RSC customer account:
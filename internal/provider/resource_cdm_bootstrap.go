@@ -22,7 +22,11 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 	"strconv"
 	"time"
 
@@ -45,7 +49,8 @@ The ´polaris_cdm_bootstrap´ resource bootstraps a Rubrik cluster.
    only removes it from the local state.
 
 ~> **Note:** Updating the ´cluster_nodes´ field is possible, but nodes added
-   still need to be manually added to the cluster.
+   are not added to the cluster. Use the ´polaris_cdm_cluster_nodes´ resource
+   to add nodes to and remove nodes from a bootstrapped cluster.
 `
 
 // This resource uses a template for its documentation due to a bug in the TF
@@ -58,6 +63,8 @@ func resourceCDMBootstrap() *schema.Resource {
 		UpdateContext: resourceCDMBootstrapUpdate,
 		DeleteContext: resourceCDMBootstrapDelete,
 
+		CustomizeDiff: customizeDiffCDMBootstrap,
+
 		Description: description(resourceCDMBootstrapDescription),
 		Schema: map[string]*schema.Schema{
 			keyAdminEmail: {
@@ -93,18 +100,20 @@ func resourceCDMBootstrap() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.IsIPAddress,
 				},
-				ExactlyOneOf: []string{keyNodeConfig},
+				ExactlyOneOf: []string{keyNode, keyNodeConfig},
 				Description:  "The node name and IP formatted as a map.",
 			},
+			keyDataNetwork: cdmBootstrapNetworkSchema("Data network configuration. Required when a " +
+				"`node` block specifies a `data_ip`."),
 			keyDNSNameServers: {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.IsIPv4Address,
+					ValidateFunc: validation.IsIPAddress,
 				},
 				MinItems:    1,
-				Description: "IPv4 addresses of DNS servers.",
+				Description: "IPv4 or IPv6 addresses of DNS servers.",
 			},
 			keyDNSSearchDomain: {
 				Type:     schema.TypeList,
@@ -122,6 +131,8 @@ func resourceCDMBootstrap() *schema.Resource {
 				Default:     true,
 				Description: "Enable software data encryption at rest.",
 			},
+			keyIPMINetwork: cdmBootstrapNetworkSchema("IPMI network configuration. Required when a " +
+				"`node` block specifies an `ipmi_ip`."),
 			keyManagementGateway: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -129,11 +140,13 @@ func resourceCDMBootstrap() *schema.Resource {
 				ValidateFunc: validation.IsIPAddress,
 			},
 			keyManagementSubnetMask: {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Subnet mask assigned to the management network.",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Subnet mask assigned to the management network. For IPv6, the subnet mask is " +
+					"specified in address form, e.g. `ffff:ffff:ffff:ffff::`.",
 				ValidateFunc: validation.IsIPAddress,
 			},
+			keyNode: cdmBootstrapNodeSchema(),
 			keyNodeConfig: {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -145,56 +158,76 @@ func resourceCDMBootstrap() *schema.Resource {
 					"instead. Only kept for backwards compatibility.",
 				Deprecated: "Use `cluster_nodes` instead. Only kept for backwards compatibility.",
 			},
+			keyNTPServer: cdmBootstrapNTPServerSchema(),
 			keyNTPServer1Name: {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Name or IP address for NTP server #1.",
+				Optional:     true,
+				ExactlyOneOf: []string{keyNTPServer},
+				RequiredWith: []string{keyNTPServer2Name},
+				Description: "Name or IP address for NTP server #1. **Deprecated:** use `ntp_server` instead. " +
+					"Only kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer1Key: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key_id", "ntp_server1_key_type"},
-				Description:  "Symmetric key material for NTP server #1.",
+				Description: "Symmetric key material for NTP server #1. **Deprecated:** use `ntp_server` instead. Only " +
+					"kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer1KeyID: {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key", "ntp_server1_key_type"},
-				Description:  "Key id number for NTP server #1 (typically this is 0).",
+				Description: "Key id number for NTP server #1 (typically this is 0). **Deprecated:** use `ntp_server` " +
+					"instead. Only kept for backwards compatibility.",
+				Deprecated: "Use `ntp_server` instead. Only kept for backwards compatibility.",
 			},
 			keyNTPServer1KeyType: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key", "ntp_server1_key_id"},
-				Description:  "Symmetric key type for NTP server #1.",
+				Description: "Symmetric key type for NTP server #1. **Deprecated:** use `ntp_server` instead. Only kept " +
+					"for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2Name: {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Name or IP address for NTP server #2.",
+				Optional:     true,
+				RequiredWith: []string{keyNTPServer1Name},
+				Description: "Name or IP address for NTP server #2. **Deprecated:** use `ntp_server` instead. " +
+					"Only kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2Key: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key_id", "ntp_server2_key_type"},
-				Description:  "Symmetric key material for NTP server #2.",
+				Description: "Symmetric key material for NTP server #2. **Deprecated:** use `ntp_server` instead. Only " +
+					"kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2KeyID: {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key", "ntp_server2_key_type"},
-				Description:  "Key id number for NTP server #2 (typically this is 1).",
+				Description: "Key id number for NTP server #2 (typically this is 1). **Deprecated:** use `ntp_server` " +
+					"instead. Only kept for backwards compatibility.",
+				Deprecated: "Use `ntp_server` instead. Only kept for backwards compatibility.",
 			},
 			keyNTPServer2KeyType: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key", "ntp_server2_key_id"},
-				Description:  "Symmetric key type for NTP server #2.",
+				Description: "Symmetric key type for NTP server #2. **Deprecated:** use `ntp_server` instead. Only kept " +
+					"for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyTimeout: {
@@ -203,6 +236,7 @@ func resourceCDMBootstrap() *schema.Resource {
 				Description:  "The time to wait to establish a connection the Rubrik cluster before returning an error (defaults to `4m`).",
 				ValidateFunc: validateBackwardsCompatibleTimeout,
 			},
+			keyVLAN: cdmBootstrapVLANSchema(),
 			keyWaitForCompletion: {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -239,13 +273,13 @@ func resourceCDMBootstrapCreate(ctx context.Context, d *schema.ResourceData, m a
 	if d.Get(keyClusterNodeIPAddress).(string) != "" {
 		nodeIP = d.Get(keyClusterNodeIPAddress).(string)
 	}
-	client := cdm.WrapBootstrap(cdm.NewClientWithLogger(nodeIP, true, m.(*client).logger))
-	requestID, err := client.BootstrapCluster(ctx, config, timeout, bootstrapWaitTime)
+	client := cdm.NewClientWithLogger(nodeIP, true, m.(*client).logger)
+	requestID, err := bootstrapCluster(ctx, client, config, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if d.Get(keyWaitForCompletion).(bool) {
-		if err := client.WaitForBootstrap(ctx, requestID, timeout, bootstrapWaitTime); err != nil {
+		if err := cdm.WrapBootstrap(client).WaitForBootstrap(ctx, requestID, timeout, bootstrapWaitTime); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -298,7 +332,203 @@ func resourceCDMBootstrapDelete(ctx context.Context, d *schema.ResourceData, m a
 	return nil
 }
 
-func toClusterConfig(d *schema.ResourceData) cdm.ClusterConfig {
+// clusterConfig holds the bootstrap configuration for a Rubrik cluster. It
+// extends the SDK cluster configuration with the data, IPMI and VLAN
+// interfaces of the nodes and the GCS storage of GCP cloud clusters, which the
+// SDK bootstrap request doesn't support.
+type clusterConfig struct {
+	cdm.ClusterConfig
+	DataNetwork    *bootstrapNetwork
+	IPMINetwork    *bootstrapNetwork
+	VLANs          []bootstrapVLAN
+	NodeInterfaces map[string]bootstrapNodeInterfaces
+	GCPStorage     *gcpStorageConfig
+}
+
+// gcpStorageConfig holds the GCS bucket used to bootstrap a Rubrik GCP cloud
+// cluster.
+type gcpStorageConfig struct {
+	BucketName string `json:"bucketName"`
+}
+
+// bootstrapNetwork holds the subnet mask and gateway of a network.
+type bootstrapNetwork struct {
+	SubnetMask string
+	Gateway    string
+}
+
+// bootstrapVLAN holds the configuration of a VLAN.
+type bootstrapVLAN struct {
+	ID         int
+	SubnetMask string
+	Gateway    string
+}
+
+// bootstrapNodeInterfaces holds the data, IPMI and VLAN interface addresses of
+// a node. The VLAN interface addresses are keyed by VLAN ID.
+type bootstrapNodeInterfaces struct {
+	DataIP  string
+	IPMIIP  string
+	VLANIPs map[int]string
+}
+
+// hasNodeInterfaces returns true if the cluster configuration has any data,
+// IPMI or VLAN interfaces.
+func (c clusterConfig) hasNodeInterfaces() bool {
+	return c.DataNetwork != nil || c.IPMINetwork != nil || len(c.VLANs) > 0
+}
+
+// cdmBootstrapNetworkSchema returns the schema for the configuration of a
+// network shared by all nodes of the cluster.
+func cdmBootstrapNetworkSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyGateway: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "IP address assigned to the network gateway.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keySubnetMask: {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "Subnet mask assigned to the network. For IPv6, the subnet mask is specified in " +
+						"address form, e.g. `ffff:ffff:ffff:ffff::`.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+		MaxItems:    1,
+		Optional:    true,
+		Description: description,
+	}
+}
+
+// cdmBootstrapNodeSchema returns the schema for the node configuration of the
+// cluster.
+func cdmBootstrapNodeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyDataIP: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IP address assigned to the data interface of the node.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keyIPMIIP: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IP address assigned to the IPMI interface of the node.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keyManagementIP: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "IP address assigned to the management interface of the node.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keyName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Node name.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				keyVLANIPs: {
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsIPAddress,
+					},
+					Optional: true,
+					Description: "IP addresses assigned to the VLAN interfaces of the node, keyed by VLAN ID. Each " +
+						"VLAN ID must be declared in a `vlan` block.",
+				},
+			},
+		},
+		Optional:     true,
+		ExactlyOneOf: []string{keyClusterNodes, keyNodeConfig},
+		Description: "Node configuration. Use instead of `cluster_nodes` to configure the data, IPMI and VLAN " +
+			"interfaces of the nodes.",
+	}
+}
+
+// cdmBootstrapNTPServerSchema returns the schema for the NTP servers of the
+// cluster.
+func cdmBootstrapNTPServerSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "Symmetric key material for the NTP server. Requires `key_type`.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				keyKeyID: {
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Description: "Key id number for the NTP server.",
+				},
+				keyKeyType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "Symmetric key type for the NTP server, e.g. `MD5` or `SHA1`.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				keyName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Name or IP address of the NTP server.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		Optional:    true,
+		MinItems:    1,
+		Description: "NTP server. Can be specified multiple times.",
+	}
+}
+
+// cdmBootstrapVLANSchema returns the schema for the VLANs of the cluster.
+func cdmBootstrapVLANSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyGateway: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "IP address assigned to the VLAN gateway.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keySubnetMask: {
+					Type:     schema.TypeString,
+					Required: true,
+					Description: "Subnet mask assigned to the VLAN. For IPv6, the subnet mask is specified in " +
+						"address form, e.g. `ffff:ffff:ffff:ffff::`.",
+					ValidateFunc: validation.IsIPAddress,
+				},
+				keyVLANID: {
+					Type:         schema.TypeInt,
+					Required:     true,
+					Description:  "VLAN ID used to tag the traffic of the VLAN interfaces.",
+					ValidateFunc: validation.IntBetween(1, 4094),
+				},
+			},
+		},
+		Optional: true,
+		Description: "VLAN configuration. Can be specified multiple times. The IP addresses of the nodes on the " +
+			"VLAN are specified using the `vlan_ips` field of the `node` blocks.",
+	}
+}
+
+func toClusterConfig(d *schema.ResourceData) clusterConfig {
 	var clusterNodes []cdm.NodeConfig
 	for name, ip := range d.Get(keyClusterNodes).(map[string]any) {
 		clusterNodes = append(clusterNodes, cdm.NodeConfig{
@@ -312,6 +542,27 @@ func toClusterConfig(d *schema.ResourceData) cdm.ClusterConfig {
 			ManagementIP: ip.(string),
 		})
 	}
+	nodeInterfaces := make(map[string]bootstrapNodeInterfaces)
+	for _, node := range d.Get(keyNode).([]any) {
+		node := node.(map[string]any)
+		name := node[keyName].(string)
+		clusterNodes = append(clusterNodes, cdm.NodeConfig{
+			Name:         name,
+			ManagementIP: node[keyManagementIP].(string),
+		})
+
+		vlanIPs := make(map[int]string)
+		for vlanID, ip := range node[keyVLANIPs].(map[string]any) {
+			// The VLAN IDs are validated when planning.
+			id, _ := strconv.Atoi(vlanID)
+			vlanIPs[id] = ip.(string)
+		}
+		nodeInterfaces[name] = bootstrapNodeInterfaces{
+			DataIP:  node[keyDataIP].(string),
+			IPMIIP:  node[keyIPMIIP].(string),
+			VLANIPs: vlanIPs,
+		}
+	}
 
 	var dnsServers []string
 	for _, nameServer := range d.Get(keyDNSNameServers).([]any) {
@@ -323,21 +574,59 @@ func toClusterConfig(d *schema.ResourceData) cdm.ClusterConfig {
 		dnsSearchDomains = append(dnsSearchDomains, searchDomain.(string))
 	}
 
-	return cdm.ClusterConfig{
-		ClusterName:          d.Get(keyClusterName).(string),
-		ClusterNodes:         clusterNodes,
-		ManagementGateway:    d.Get(keyManagementGateway).(string),
-		ManagementSubnetMask: d.Get(keyManagementSubnetMask).(string),
-		AdminEmail:           d.Get(keyAdminEmail).(string),
-		AdminPassword:        d.Get(keyAdminPassword).(string),
-		DNSServers:           dnsServers,
-		DNSSearchDomains:     dnsSearchDomains,
-		NTPServers:           toNTPServers(d),
+	var vlans []bootstrapVLAN
+	for _, vlan := range d.Get(keyVLAN).([]any) {
+		vlan := vlan.(map[string]any)
+		vlans = append(vlans, bootstrapVLAN{
+			ID:         vlan[keyVLANID].(int),
+			SubnetMask: vlan[keySubnetMask].(string),
+			Gateway:    vlan[keyGateway].(string),
+		})
+	}
+
+	return clusterConfig{
+		ClusterConfig: cdm.ClusterConfig{
+			ClusterName:          d.Get(keyClusterName).(string),
+			ClusterNodes:         clusterNodes,
+			ManagementGateway:    d.Get(keyManagementGateway).(string),
+			ManagementSubnetMask: d.Get(keyManagementSubnetMask).(string),
+			AdminEmail:           d.Get(keyAdminEmail).(string),
+			AdminPassword:        d.Get(keyAdminPassword).(string),
+			DNSServers:           dnsServers,
+			DNSSearchDomains:     dnsSearchDomains,
+			NTPServers:           toNTPServers(d),
+		},
+		DataNetwork:    toBootstrapNetwork(d.Get(keyDataNetwork).([]any)),
+		IPMINetwork:    toBootstrapNetwork(d.Get(keyIPMINetwork).([]any)),
+		VLANs:          vlans,
+		NodeInterfaces: nodeInterfaces,
+	}
+}
+
+// toBootstrapNetwork converts the network block of the resource configuration
+// to a bootstrap network. Returns nil if the network isn't configured.
+func toBootstrapNetwork(block []any) *bootstrapNetwork {
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+
+	network := block[0].(map[string]any)
+	return &bootstrapNetwork{
+		SubnetMask: network[keySubnetMask].(string),
+		Gateway:    network[keyGateway].(string),
 	}
 }
 
+// toNTPServers returns the NTP servers of the resource configuration. The NTP
+// servers are either specified using the ntp_server blocks or the deprecated
+// ntp_server1 and ntp_server2 fields.
 func toNTPServers(d *schema.ResourceData) []cdm.NTPServerConfig {
+	if ntpServers := fromNTPServerBlocks(d.Get(keyNTPServer).([]any)); len(ntpServers) > 0 {
+		return ntpServers
+	}
+
 	var ntpServers []cdm.NTPServerConfig
+
 	for i := 0; i < 2; i++ {
 		ntpBase := fmt.Sprintf("ntp_server%d_", i+1)
 
@@ -359,6 +648,331 @@ func toNTPServers(d *schema.ResourceData) []cdm.NTPServerConfig {
 	return ntpServers
 }
 
+// fromNTPServerBlocks converts the ntp_server blocks of the resource
+// configuration to NTP server configurations.
+func fromNTPServerBlocks(servers []any) []cdm.NTPServerConfig {
+	var ntpServers []cdm.NTPServerConfig
+	for _, server := range servers {
+		server := server.(map[string]any)
+
+		var symmetricKey *cdm.NTPSymmetricKey
+		if key := server[keyKey].(string); key != "" {
+			symmetricKey = &cdm.NTPSymmetricKey{
+				KeyID:   server[keyKeyID].(int),
+				Key:     key,
+				KeyType: server[keyKeyType].(string),
+			}
+		}
+
+		ntpServers = append(ntpServers, cdm.NTPServerConfig{
+			Server:       server[keyName].(string),
+			SymmetricKey: symmetricKey,
+		})
+	}
+
+	return ntpServers
+}
+
+// validateNTPServerKeys verifies that the symmetric key and the key type of
+// the ntp_server blocks are specified together.
+func validateNTPServerKeys(servers []any) error {
+	for _, server := range servers {
+		server := server.(map[string]any)
+		if (server[keyKey].(string) == "") != (server[keyKeyType].(string) == "") {
+			return fmt.Errorf("NTP server %q: %s and %s must be specified together", server[keyName], keyKey,
+				keyKeyType)
+		}
+	}
+
+	return nil
+}
+
+// customizeDiffCDMBootstrap validates the NTP servers and the network
+// configuration of the cluster when planning. All addresses of a network must belong to the same
+// IP family, the node interfaces must refer to configured networks and every
+// node must have an address on every VLAN.
+func customizeDiffCDMBootstrap(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffCDMBootstrap")
+
+	managementAddrs := []string{diff.Get(keyManagementGateway).(string), diff.Get(keyManagementSubnetMask).(string)}
+	for _, ip := range diff.Get(keyClusterNodes).(map[string]any) {
+		managementAddrs = append(managementAddrs, ip.(string))
+	}
+
+	if err := validateNTPServerKeys(diff.Get(keyNTPServer).([]any)); err != nil {
+		return err
+	}
+
+	dataNetwork := toBootstrapNetwork(diff.Get(keyDataNetwork).([]any))
+	var dataAddrs []string
+	if dataNetwork != nil {
+		dataAddrs = append(dataAddrs, dataNetwork.Gateway, dataNetwork.SubnetMask)
+	}
+	ipmiNetwork := toBootstrapNetwork(diff.Get(keyIPMINetwork).([]any))
+	var ipmiAddrs []string
+	if ipmiNetwork != nil {
+		ipmiAddrs = append(ipmiAddrs, ipmiNetwork.Gateway, ipmiNetwork.SubnetMask)
+	}
+
+	vlanAddrs := make(map[int][]string)
+	for _, vlan := range diff.Get(keyVLAN).([]any) {
+		vlan := vlan.(map[string]any)
+		id := vlan[keyVLANID].(int)
+		if _, ok := vlanAddrs[id]; ok {
+			return fmt.Errorf("VLAN %d is declared more than once", id)
+		}
+		vlanAddrs[id] = []string{vlan[keyGateway].(string), vlan[keySubnetMask].(string)}
+	}
+
+	nodes := diff.Get(keyNode).([]any)
+	if len(nodes) == 0 && (dataNetwork != nil || ipmiNetwork != nil || len(vlanAddrs) > 0) {
+		return fmt.Errorf("%s, %s and %s require the nodes to be specified using %s blocks", keyDataNetwork,
+			keyIPMINetwork, keyVLAN, keyNode)
+	}
+	for _, node := range nodes {
+		node := node.(map[string]any)
+		name := node[keyName].(string)
+		managementAddrs = append(managementAddrs, node[keyManagementIP].(string))
+		if ip := node[keyDataIP].(string); ip != "" {
+			if dataNetwork == nil {
+				return fmt.Errorf("node %q has a %s but %s is not configured", name, keyDataIP, keyDataNetwork)
+			}
+			dataAddrs = append(dataAddrs, ip)
+		}
+		if ip := node[keyIPMIIP].(string); ip != "" {
+			if ipmiNetwork == nil {
+				return fmt.Errorf("node %q has an %s but %s is not configured", name, keyIPMIIP, keyIPMINetwork)
+			}
+			ipmiAddrs = append(ipmiAddrs, ip)
+		}
+
+		vlanIPs := node[keyVLANIPs].(map[string]any)
+		for vlanID, ip := range vlanIPs {
+			id, err := strconv.Atoi(vlanID)
+			if err != nil {
+				return fmt.Errorf("node %q has an invalid VLAN ID %q in %s", name, vlanID, keyVLANIPs)
+			}
+			if _, ok := vlanAddrs[id]; !ok {
+				return fmt.Errorf("node %q has an address on VLAN %d which is not declared in a %s block", name, id,
+					keyVLAN)
+			}
+			vlanAddrs[id] = append(vlanAddrs[id], ip.(string))
+		}
+		if len(vlanIPs) != len(vlanAddrs) {
+			return fmt.Errorf("node %q must have an address on every VLAN declared in a %s block", name, keyVLAN)
+		}
+	}
+
+	if !sameIPFamily(managementAddrs) {
+		return errors.New("the management network addresses must all be either IPv4 or IPv6")
+	}
+	if !sameIPFamily(dataAddrs) {
+		return errors.New("the data network addresses must all be either IPv4 or IPv6")
+	}
+	if !sameIPFamily(ipmiAddrs) {
+		return errors.New("the IPMI network addresses must all be either IPv4 or IPv6")
+	}
+	for id, addrs := range vlanAddrs {
+		if !sameIPFamily(addrs) {
+			return fmt.Errorf("the VLAN %d addresses must all be either IPv4 or IPv6", id)
+		}
+	}
+
+	return nil
+}
+
+// sameIPFamily returns true if all addresses belong to the same IP family.
+// Empty and unparsable addresses, e.g. unknown values, are ignored.
+func sameIPFamily(addrs []string) bool {
+	family := 0
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr)
+		if ip == nil {
+			continue
+		}
+		f := 6
+		if ip.To4() != nil {
+			f = 4
+		}
+		if family != 0 && family != f {
+			return false
+		}
+		family = f
+	}
+
+	return true
+}
+
+// bootstrapAdmin holds the admin account of the bootstrap request.
+type bootstrapAdmin struct {
+	ID       string `json:"id"`
+	Email    string `json:"emailAddress"`
+	Password string `json:"password"`
+}
+
+// bootstrapIPConfig holds the IP configuration of a node interface in the
+// bootstrap request.
+type bootstrapIPConfig struct {
+	Address string `json:"address"`
+	Netmask string `json:"netmask"`
+	Gateway string `json:"gateway,omitempty"`
+}
+
+// bootstrapVLANIPConfig holds the IP configuration of a node VLAN interface in
+// the bootstrap request.
+type bootstrapVLANIPConfig struct {
+	VLAN     int               `json:"vlan"`
+	IPConfig bootstrapIPConfig `json:"ipConfig"`
+}
+
+// bootstrapNodeConfig holds the interface configuration of a node in the
+// bootstrap request.
+type bootstrapNodeConfig struct {
+	ManagementIPConfig bootstrapIPConfig       `json:"managementIpConfig"`
+	DataIPConfig       *bootstrapIPConfig      `json:"dataIpConfig,omitempty"`
+	IPMIIPConfig       *bootstrapIPConfig      `json:"ipmiIpConfig,omitempty"`
+	VLANIPConfigs      []bootstrapVLANIPConfig `json:"vlanIpConfigs,omitempty"`
+}
+
+// toBootstrapStorageConfig returns the encryption flag and the cloud storage
+// location of the bootstrap request. Encryption can only be enabled on
+// physical Rubrik clusters.
+func toBootstrapStorageConfig(config clusterConfig) (bool, any) {
+	var enableEncryption bool
+	var storageConfig any
+	switch storage := config.StorageConfig.(type) {
+	case cdm.CDMStorageConfig:
+		enableEncryption = storage.EnableEncryption
+	case cdm.AWSStorageConfig:
+		storageConfig = struct {
+			cdm.AWSStorageConfig `json:"awsStorageConfig"`
+		}{AWSStorageConfig: storage}
+	case cdm.AzureStorageConfig:
+		storageConfig = struct {
+			cdm.AzureStorageConfig `json:"azureStorageConfig"`
+		}{AzureStorageConfig: storage}
+	}
+	if config.GCPStorage != nil {
+		storageConfig = struct {
+			GCPStorageConfig *gcpStorageConfig `json:"gcpStorageConfig"`
+		}{GCPStorageConfig: config.GCPStorage}
+	}
+
+	return enableEncryption, storageConfig
+}
+
+// bootstrapCluster starts the bootstrap process for a Rubrik cluster and
+// returns the bootstrap request ID. The SDK bootstrap request only configures
+// the management interface of the nodes and doesn't support GCS storage. When
+// the data, IPMI or VLAN interfaces or GCS storage are configured, the
+// bootstrap request is made by the provider.
+func bootstrapCluster(ctx context.Context, client *cdm.Client, config clusterConfig, timeout time.Duration) (int, error) {
+	tflog.Trace(ctx, "bootstrapCluster")
+
+	api := cdm.WrapBootstrap(client)
+	if !config.hasNodeInterfaces() && config.GCPStorage == nil {
+		return api.BootstrapCluster(ctx, config.ClusterConfig, timeout, bootstrapWaitTime)
+	}
+
+	ok, err := api.IsBootstrapped(ctx, timeout, bootstrapWaitTime)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check cluster bootstrap status: %s", err)
+	}
+	if ok {
+		return 0, errors.New("cluster is already bootstrapped")
+	}
+
+	enableEncryption, storageConfig := toBootstrapStorageConfig(config)
+
+	nodes := make(map[string]bootstrapNodeConfig, len(config.ClusterNodes))
+	for _, node := range config.ClusterNodes {
+		nodeConfig := bootstrapNodeConfig{
+			ManagementIPConfig: bootstrapIPConfig{
+				Address: node.ManagementIP,
+				Netmask: config.ManagementSubnetMask,
+				Gateway: config.ManagementGateway,
+			},
+		}
+		interfaces := config.NodeInterfaces[node.Name]
+		if interfaces.DataIP != "" && config.DataNetwork != nil {
+			nodeConfig.DataIPConfig = &bootstrapIPConfig{
+				Address: interfaces.DataIP,
+				Netmask: config.DataNetwork.SubnetMask,
+				Gateway: config.DataNetwork.Gateway,
+			}
+		}
+		if interfaces.IPMIIP != "" && config.IPMINetwork != nil {
+			nodeConfig.IPMIIPConfig = &bootstrapIPConfig{
+				Address: interfaces.IPMIIP,
+				Netmask: config.IPMINetwork.SubnetMask,
+				Gateway: config.IPMINetwork.Gateway,
+			}
+		}
+		for _, vlan := range config.VLANs {
+			if ip, ok := interfaces.VLANIPs[vlan.ID]; ok {
+				nodeConfig.VLANIPConfigs = append(nodeConfig.VLANIPConfigs, bootstrapVLANIPConfig{
+					VLAN: vlan.ID,
+					IPConfig: bootstrapIPConfig{
+						Address: ip,
+						Netmask: vlan.SubnetMask,
+						Gateway: vlan.Gateway,
+					},
+				})
+			}
+		}
+		nodes[node.Name] = nodeConfig
+	}
+
+	endpoint := "/cluster/me/bootstrap"
+	buf, code, err := client.Post(ctx, cdm.Internal, endpoint, struct {
+		Name          string                         `json:"name"`
+		Encryption    bool                           `json:"enableSoftwareEncryptionAtRest"`
+		Admin         bootstrapAdmin                 `json:"adminUserInfo"`
+		NameServers   []string                       `json:"dnsNameservers"`
+		SearchDomains []string                       `json:"dnsSearchDomains"`
+		NTPServers    []cdm.NTPServerConfig          `json:"ntpServerConfigs"`
+		StorageConfig any                            `json:"cloudStorageLocation,omitempty"`
+		Nodes         map[string]bootstrapNodeConfig `json:"nodeConfigs"`
+	}{
+		Name:       config.ClusterName,
+		Encryption: enableEncryption,
+		Admin: bootstrapAdmin{
+			ID:       "admin",
+			Email:    config.AdminEmail,
+			Password: config.AdminPassword,
+		},
+		NameServers:   config.DNSServers,
+		SearchDomains: config.DNSSearchDomains,
+		NTPServers:    config.NTPServers,
+		StorageConfig: storageConfig,
+		Nodes:         nodes,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed POST request %q: %s", endpoint, err)
+	}
+
+	var bootstrap struct {
+		ID      int    `json:"id"`
+		Status  string `json:"status"`
+		Message string `json:"message"`
+	}
+	jsonErr := json.Unmarshal(buf, &bootstrap)
+	if code != http.StatusAccepted {
+		msg := fmt.Sprintf("%s (%d)", http.StatusText(code), code)
+		if bootstrap.Status != "" {
+			msg = fmt.Sprintf("%s: %s", msg, bootstrap.Status)
+		} else if bootstrap.Message != "" {
+			msg = fmt.Sprintf("%s: %s", msg, bootstrap.Message)
+		}
+		return 0, fmt.Errorf("failed POST request %q: %s", endpoint, msg)
+	}
+	if jsonErr != nil {
+		return 0, fmt.Errorf("failed to unmarshal bootstrap status: %s", jsonErr)
+	}
+
+	return bootstrap.ID, nil
+}
+
 // toBackwardsCompatibleTimeout returns the timeout duration from the resource
 // data. The timeout can be specified as either a string with a time suffix or
 // as an integer in seconds. If the timeout is not specified it defaults to 4
@@ -40,7 +40,8 @@ cluster.
    only removes it from the local state.
 
 ~> **Note:** Updating the ´cluster_nodes´ field is possible, but nodes added
-   still need to be manually added to the cluster.
+   are not added to the cluster. Use the ´polaris_cdm_cluster_nodes´ resource
+   to add nodes to and remove nodes from a bootstrapped cluster.
 `
 
 // This resource uses a template for its documentation due to a bug in the TF
@@ -53,6 +54,8 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 		UpdateContext: resourceCDMBootstrapCCESAWSUpdate,
 		DeleteContext: resourceCDMBootstrapCCESAWSDelete,
 
+		CustomizeDiff: customizeDiffCDMBootstrap,
+
 		Description: description(resourceCDMBootstrapCCESAWSDescription),
 		Schema: map[string]*schema.Schema{
 			keyAdminEmail: {
@@ -94,18 +97,20 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.IsIPAddress,
 				},
-				ExactlyOneOf: []string{keyNodeConfig},
+				ExactlyOneOf: []string{keyNode, keyNodeConfig},
 				Description:  "The node name and IP address formatted as a map.",
 			},
+			keyDataNetwork: cdmBootstrapNetworkSchema("Data network configuration. Required when a " +
+				"`node` block specifies a `data_ip`."),
 			keyDNSNameServers: {
 				Type:     schema.TypeList,
 				Required: true,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.IsIPv4Address,
+					ValidateFunc: validation.IsIPAddress,
 				},
 				MinItems:    1,
-				Description: "IPv4 addresses of DNS servers.",
+				Description: "IPv4 or IPv6 addresses of DNS servers.",
 			},
 			keyDNSSearchDomain: {
 				Type:     schema.TypeList,
@@ -131,6 +136,8 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 				Default:     false,
 				Description: "Flag to determine if versioning will be used on the S3 object storage to enable immutability.",
 			},
+			keyIPMINetwork: cdmBootstrapNetworkSchema("IPMI network configuration. Required when a " +
+				"`node` block specifies an `ipmi_ip`."),
 			keyManagementGateway: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -138,11 +145,13 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 				ValidateFunc: validation.IsIPAddress,
 			},
 			keyManagementSubnetMask: {
-				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Subnet mask assigned to the management network.",
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Subnet mask assigned to the management network. For IPv6, the subnet mask is " +
+					"specified in address form, e.g. `ffff:ffff:ffff:ffff::`.",
 				ValidateFunc: validation.IsIPAddress,
 			},
+			keyNode: cdmBootstrapNodeSchema(),
 			keyNodeConfig: {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -154,56 +163,76 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 					"instead. Only kept for backwards compatibility.",
 				Deprecated: "Use `cluster_nodes` instead. Only kept for backwards compatibility.",
 			},
+			keyNTPServer: cdmBootstrapNTPServerSchema(),
 			keyNTPServer1Name: {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Name or IP address for NTP server #1.",
+				Optional:     true,
+				ExactlyOneOf: []string{keyNTPServer},
+				RequiredWith: []string{keyNTPServer2Name},
+				Description: "Name or IP address for NTP server #1. **Deprecated:** use `ntp_server` instead. " +
+					"Only kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer1Key: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key_id", "ntp_server1_key_type"},
-				Description:  "Symmetric key material for NTP server #1.",
+				Description: "Symmetric key material for NTP server #1. **Deprecated:** use `ntp_server` instead. Only " +
+					"kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer1KeyID: {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key", "ntp_server1_key_type"},
-				Description:  "Key id number for NTP server #1 (typically this is 0).",
+				Description: "Key id number for NTP server #1 (typically this is 0). **Deprecated:** use `ntp_server` " +
+					"instead. Only kept for backwards compatibility.",
+				Deprecated: "Use `ntp_server` instead. Only kept for backwards compatibility.",
 			},
 			keyNTPServer1KeyType: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server1_key", "ntp_server1_key_id"},
-				Description:  "Symmetric key type for NTP server #1.",
+				Description: "Symmetric key type for NTP server #1. **Deprecated:** use `ntp_server` instead. Only kept " +
+					"for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2Name: {
 				Type:         schema.TypeString,
-				Required:     true,
-				Description:  "Name or IP address for NTP server #2.",
+				Optional:     true,
+				RequiredWith: []string{keyNTPServer1Name},
+				Description: "Name or IP address for NTP server #2. **Deprecated:** use `ntp_server` instead. " +
+					"Only kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2Key: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key_id", "ntp_server2_key_type"},
-				Description:  "Symmetric key material for NTP server #2.",
+				Description: "Symmetric key material for NTP server #2. **Deprecated:** use `ntp_server` instead. Only " +
+					"kept for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyNTPServer2KeyID: {
 				Type:         schema.TypeInt,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key", "ntp_server2_key_type"},
-				Description:  "Key id number for NTP server #2 (typically this is 1).",
+				Description: "Key id number for NTP server #2 (typically this is 1). **Deprecated:** use `ntp_server` " +
+					"instead. Only kept for backwards compatibility.",
+				Deprecated: "Use `ntp_server` instead. Only kept for backwards compatibility.",
 			},
 			keyNTPServer2KeyType: {
 				Type:         schema.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"ntp_server2_key", "ntp_server2_key_id"},
-				Description:  "Symmetric key type for NTP server #2.",
+				Description: "Symmetric key type for NTP server #2. **Deprecated:** use `ntp_server` instead. Only kept " +
+					"for backwards compatibility.",
+				Deprecated:   "Use `ntp_server` instead. Only kept for backwards compatibility.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyTimeout: {
@@ -212,6 +241,7 @@ func resourceCDMBootstrapCCESAWS() *schema.Resource {
 				Description:  "The time to wait to establish a connection the Rubrik cluster before returning an error (defaults to `4m`).",
 				ValidateFunc: validateBackwardsCompatibleTimeout,
 			},
+			keyVLAN: cdmBootstrapVLANSchema(),
 			keyWaitForCompletion: {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -249,13 +279,13 @@ func resourceCDMBootstrapCCESAWSCreate(ctx context.Context, d *schema.ResourceDa
 	if d.Get(keyClusterNodeIPAddress).(string) != "" {
 		nodeIP = d.Get(keyClusterNodeIPAddress).(string)
 	}
-	client := cdm.WrapBootstrap(cdm.NewClientWithLogger(nodeIP, true, m.(*client).logger))
-	requestID, err := client.BootstrapCluster(ctx, config, timeout, bootstrapWaitTime)
+	client := cdm.NewClientWithLogger(nodeIP, true, m.(*client).logger)
+	requestID, err := bootstrapCluster(ctx, client, config, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 	if d.Get(keyWaitForCompletion).(bool) {
-		if err := client.WaitForBootstrap(ctx, requestID, timeout, bootstrapWaitTime); err != nil {
+		if err := cdm.WrapBootstrap(client).WaitForBootstrap(ctx, requestID, timeout, bootstrapWaitTime); err != nil {
 			return diag.FromErr(err)
 		}
 	}
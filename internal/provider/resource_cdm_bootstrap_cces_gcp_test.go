@@ -0,0 +1,97 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+// TestToCCESGCPClusterConfig verifies that the GCS bucket is added to the
+// bootstrap configuration.
+func TestToCCESGCPClusterConfig(t *testing.T) {
+	res := resourceCDMBootstrapCCESGCP()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyBucketName:  "my-cces-bucket",
+		keyClusterName: "my-cluster",
+		keyClusterNodes: map[string]any{
+			"node-1": "10.1.100.100",
+		},
+	})
+
+	config := toCCESGCPClusterConfig(d)
+	if config.ClusterName != "my-cluster" {
+		t.Errorf("cluster name = %q, want my-cluster", config.ClusterName)
+	}
+	if len(config.ClusterNodes) != 1 || config.ClusterNodes[0].ManagementIP != "10.1.100.100" {
+		t.Fatalf("unexpected cluster nodes: %+v", config.ClusterNodes)
+	}
+	if config.GCPStorage == nil || config.GCPStorage.BucketName != "my-cces-bucket" {
+		t.Fatalf("unexpected GCP storage: %+v", config.GCPStorage)
+	}
+	if config.hasNodeInterfaces() {
+		t.Error("unexpected node interfaces")
+	}
+}
+
+// TestToBootstrapStorageConfig verifies the cloud storage location of the
+// bootstrap request for the different storage configurations.
+func TestToBootstrapStorageConfig(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         clusterConfig
+		wantEncryption bool
+		wantStorage    string
+	}{{
+		name: "CDMEncrypted",
+		config: clusterConfig{
+			ClusterConfig: cdm.ClusterConfig{StorageConfig: cdm.CDMStorageConfig{EnableEncryption: true}},
+		},
+		wantEncryption: true,
+		wantStorage:    "null",
+	}, {
+		name: "GCP",
+		config: clusterConfig{
+			GCPStorage: &gcpStorageConfig{BucketName: "my-cces-bucket"},
+		},
+		wantStorage: `{"gcpStorageConfig":{"bucketName":"my-cces-bucket"}}`,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encryption, storage := toBootstrapStorageConfig(tc.config)
+			if encryption != tc.wantEncryption {
+				t.Errorf("encryption = %t, want %t", encryption, tc.wantEncryption)
+			}
+			buf, err := json.Marshal(storage)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(buf) != tc.wantStorage {
+				t.Errorf("storage config = %s, want %s", buf, tc.wantStorage)
+			}
+		})
+	}
+}
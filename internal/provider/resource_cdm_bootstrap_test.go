@@ -0,0 +1,152 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestToNTPServers verifies that the NTP servers are read from the ntp_server
+// blocks.
+func TestToNTPServers(t *testing.T) {
+	res := resourceCDMBootstrap()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyNTPServer: []any{
+			map[string]any{
+				keyName: "ntp1.example.org",
+			},
+			map[string]any{
+				keyName: "ntp2.example.org",
+			},
+			map[string]any{
+				keyName:    "ntp3.example.org",
+				keyKey:     "secret",
+				keyKeyID:   2,
+				keyKeyType: "SHA1",
+			},
+		},
+	})
+
+	servers := toNTPServers(d)
+	if len(servers) != 3 {
+		t.Fatalf("expected 3 NTP servers, got %d", len(servers))
+	}
+	if servers[0].Server != "ntp1.example.org" || servers[0].SymmetricKey != nil {
+		t.Errorf("unexpected NTP server: %+v", servers[0])
+	}
+	if key := servers[2].SymmetricKey; key == nil || key.KeyID != 2 || key.Key != "secret" || key.KeyType != "SHA1" {
+		t.Errorf("unexpected NTP server symmetric key: %+v", key)
+	}
+}
+
+// TestToNTPServersDeprecated verifies that the NTP servers are read from the
+// deprecated ntp_server1 and ntp_server2 fields.
+func TestToNTPServersDeprecated(t *testing.T) {
+	res := resourceCDMBootstrap()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyNTPServer1Name: "10.1.200.100",
+		keyNTPServer2Name: "10.1.200.200",
+	})
+
+	servers := toNTPServers(d)
+	if len(servers) != 2 {
+		t.Fatalf("expected 2 NTP servers, got %d", len(servers))
+	}
+	if servers[0].Server != "10.1.200.100" || servers[1].Server != "10.1.200.200" {
+		t.Errorf("unexpected NTP servers: %+v", servers)
+	}
+}
+
+// TestToClusterConfigNodeInterfaces verifies that the data, IPMI and VLAN
+// interfaces of the node blocks are part of the cluster configuration.
+func TestToClusterConfigNodeInterfaces(t *testing.T) {
+	res := resourceCDMBootstrap()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyNode: []any{
+			map[string]any{
+				keyName:         "node-1",
+				keyManagementIP: "10.1.100.100",
+				keyDataIP:       "10.1.110.100",
+				keyIPMIIP:       "10.1.120.100",
+				keyVLANIPs: map[string]any{
+					"100": "10.100.0.100",
+				},
+			},
+		},
+		keyDataNetwork: []any{
+			map[string]any{
+				keyGateway:    "10.1.110.1",
+				keySubnetMask: "255.255.255.0",
+			},
+		},
+		keyVLAN: []any{
+			map[string]any{
+				keyVLANID:     100,
+				keySubnetMask: "255.255.255.0",
+			},
+		},
+	})
+
+	config := toClusterConfig(d)
+	if !config.hasNodeInterfaces() {
+		t.Fatal("expected node interfaces")
+	}
+	if len(config.ClusterNodes) != 1 || config.ClusterNodes[0].ManagementIP != "10.1.100.100" {
+		t.Fatalf("unexpected cluster nodes: %+v", config.ClusterNodes)
+	}
+	if config.DataNetwork == nil || config.DataNetwork.Gateway != "10.1.110.1" {
+		t.Errorf("unexpected data network: %+v", config.DataNetwork)
+	}
+	if config.IPMINetwork != nil {
+		t.Errorf("unexpected IPMI network: %+v", config.IPMINetwork)
+	}
+	interfaces := config.NodeInterfaces["node-1"]
+	if interfaces.DataIP != "10.1.110.100" || interfaces.IPMIIP != "10.1.120.100" {
+		t.Errorf("unexpected node interfaces: %+v", interfaces)
+	}
+	if ip := interfaces.VLANIPs[100]; ip != "10.100.0.100" {
+		t.Errorf("VLAN 100 address = %q, want 10.100.0.100", ip)
+	}
+}
+
+// TestSameIPFamily verifies that mixing IPv4 and IPv6 addresses is detected.
+func TestSameIPFamily(t *testing.T) {
+	testCases := []struct {
+		addrs []string
+		want  bool
+	}{
+		{addrs: nil, want: true},
+		{addrs: []string{"10.0.0.1", "255.255.255.0"}, want: true},
+		{addrs: []string{"2001:db8::1", "ffff:ffff:ffff:ffff::"}, want: true},
+		{addrs: []string{"10.0.0.1", "", "2001:db8::1"}, want: false},
+		{addrs: []string{"", "2001:db8::1"}, want: true},
+	}
+	for _, tc := range testCases {
+		if got := sameIPFamily(tc.addrs); got != tc.want {
+			t.Errorf("sameIPFamily(%q) = %t, want %t", tc.addrs, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMCertificateDescription = `
+The ´polaris_cdm_certificate´ resource uploads a PEM encoded certificate, and
+optionally the private key, to a bootstrapped Rubrik cluster. The resource
+connects directly to the Rubrik cluster. Use the ´polaris_cdm_certificate_binding´
+resource to bind the certificate to the web server or the replication endpoint
+of the cluster.
+
+The certificate and the private key cannot be changed once uploaded, changing
+either of them replaces the certificate. Combined with ´create_before_destroy´
+this allows certificates to be rotated without the cluster being left without a
+certificate. The expiration time of the certificate is available as a computed
+attribute.
+
+The private key cannot be read back from the Rubrik cluster, so changes to the
+private key made outside of Terraform are not detected.
+
+The resource can be imported using an ID of the form
+´<cluster_node_ip_address>/<certificate_id>´. The credentials used when
+importing are read from the ´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´
+and ´RUBRIK_CDM_PASSWORD´, environment variables.
+`
+
+const cdmCertificateEndpoint = "/certificate"
+
+// cdmCertificate holds a certificate stored on a cluster.
+type cdmCertificate struct {
+	ID          string `json:"certId,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	PEMFile     string `json:"pemFile,omitempty"`
+	PrivateKey  string `json:"privateKey,omitempty"`
+	Expiration  string `json:"expiration,omitempty"`
+	HasKey      bool   `json:"hasKey,omitempty"`
+}
+
+func resourceCDMCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMCertificateCreate,
+		ReadContext:   resourceCDMCertificateRead,
+		UpdateContext: resourceCDMCertificateUpdate,
+		DeleteContext: resourceCDMCertificateDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMCertificate,
+		},
+
+		Description: description(resourceCDMCertificateDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate ID.",
+			},
+			keyAdminPassword: cdmAdminPasswordSchema(),
+			keyCertificate: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "PEM encoded certificate. Intermediate CA certificates can be included after the " +
+					"certificate to form a certificate chain. Changing this value forces a new resource to be " +
+					"created.",
+				ValidateFunc: validateCertificatePEM,
+			},
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Certificate description.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyExpiration: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the certificate, in RFC 3339 format.",
+			},
+			keyHasPrivateKey: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the private key of the certificate is stored on the cluster.",
+			},
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Certificate name.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyPrivateKey: {
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+				Description: "PEM encoded private key of the certificate. Required when the certificate is bound " +
+					"to the web server or the replication endpoint of the cluster. Changing this value forces a " +
+					"new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func resourceCDMCertificateCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var certificate cdmCertificate
+	params := cdmCertificate{
+		Name:        d.Get(keyName).(string),
+		Description: d.Get(keyDescription).(string),
+		PEMFile:     d.Get(keyCertificate).(string),
+		PrivateKey:  d.Get(keyPrivateKey).(string),
+	}
+	if err := cdmRequest(ctx, client, http.MethodPost, cdm.V1, cdmCertificateEndpoint, params, &certificate); err != nil {
+		return diag.FromErr(err)
+	}
+	if certificate.ID == "" {
+		return diag.Errorf("certificate ID not found in response")
+	}
+
+	d.SetId(certificate.ID)
+	return resourceCDMCertificateRead(ctx, d, m)
+}
+
+func resourceCDMCertificateRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	certificate, ok, err := cdmClusterCertificate(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(keyName, certificate.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyDescription, certificate.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if certificate.PEMFile != "" {
+		if err := d.Set(keyCertificate, certificate.PEMFile); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set(keyExpiration, certificate.Expiration); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyHasPrivateKey, certificate.HasKey); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMCertificateUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(keyDescription, keyName) {
+		params := cdmCertificate{
+			Name:        d.Get(keyName).(string),
+			Description: d.Get(keyDescription).(string),
+		}
+		if err := cdmRequest(ctx, client, http.MethodPatch, cdm.V1, cdmCertificatePath(d.Id()), params, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMCertificateRead(ctx, d, m)
+}
+
+func resourceCDMCertificateDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateDelete")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := cdmRequest(ctx, client, http.MethodDelete, cdm.V1, cdmCertificatePath(d.Id()), nil, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importCDMCertificate imports a certificate. The import ID has the form
+// <cluster_node_ip_address>/<certificate_id>. The credentials are read from
+// the environment, see cdmClusterClient.
+func importCDMCertificate(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	tflog.Trace(ctx, "importCDMCertificate")
+
+	nodeIP, certificateID, err := parseCDMCertificateImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(keyClusterNodeIPAddress, nodeIP); err != nil {
+		return nil, err
+	}
+
+	d.SetId(certificateID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseCDMCertificateImportID parses the certificate import ID into the IP
+// address of the cluster node and the certificate ID.
+func parseCDMCertificateImportID(id string) (string, string, error) {
+	nodeIP, certificateID, ok := strings.Cut(id, "/")
+	if !ok || nodeIP == "" || certificateID == "" {
+		return "", "", fmt.Errorf("invalid certificate import ID %q, expected "+
+			"<cluster_node_ip_address>/<certificate_id>", id)
+	}
+
+	return nodeIP, certificateID, nil
+}
+
+// cdmClusterCertificate returns the certificate with the specified ID. Returns
+// false if the cluster has no certificate with the ID.
+func cdmClusterCertificate(ctx context.Context, client *cdm.Client, certificateID string) (cdmCertificate, bool, error) {
+	var certificates struct {
+		Data []cdmCertificate `json:"data"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.V1, cdmCertificateEndpoint, nil, &certificates); err != nil {
+		return cdmCertificate{}, false, err
+	}
+	for _, certificate := range certificates.Data {
+		if certificate.ID == certificateID {
+			return certificate, true, nil
+		}
+	}
+
+	return cdmCertificate{}, false, nil
+}
+
+// cdmCertificatePath returns the endpoint of the certificate with the specified
+// ID. Certificate IDs contain colons, so the ID is path escaped.
+func cdmCertificatePath(certificateID string) string {
+	return cdmCertificateEndpoint + "/" + url.PathEscape(certificateID)
+}
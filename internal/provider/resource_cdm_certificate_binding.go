@@ -0,0 +1,258 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMCertificateBindingDescription = `
+The ´polaris_cdm_certificate_binding´ resource binds a certificate uploaded with
+the ´polaris_cdm_certificate´ resource to the web server or the replication
+endpoint of a bootstrapped Rubrik cluster. The resource connects directly to the
+Rubrik cluster.
+
+Changing the certificate updates the binding in place, which allows certificates
+to be rotated by uploading a new certificate and pointing the binding at it.
+Destroying the resource reverts the endpoint to the self-signed certificate of
+the cluster.
+
+The resource can be imported using an ID of the form
+´<cluster_node_ip_address>/<endpoint>´. The credentials used when importing are
+read from the ´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and
+´RUBRIK_CDM_PASSWORD´, environment variables.
+`
+
+const (
+	cdmCertificateEndpointReplication = "REPLICATION"
+	cdmCertificateEndpointWebServer   = "WEB_SERVER"
+)
+
+// cdmCertificateBindingEndpoints maps the certificate endpoints to the CDM
+// endpoints used to manage the certificate bound to them.
+var cdmCertificateBindingEndpoints = map[string]string{
+	cdmCertificateEndpointReplication: "/cluster/me/security/replication_signed_cert",
+	cdmCertificateEndpointWebServer:   "/cluster/me/security/web_signed_cert",
+}
+
+func resourceCDMCertificateBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMCertificateBindingCreate,
+		ReadContext:   resourceCDMCertificateBindingRead,
+		UpdateContext: resourceCDMCertificateBindingUpdate,
+		DeleteContext: resourceCDMCertificateBindingDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMCertificateBinding,
+		},
+
+		Description: description(resourceCDMCertificateBindingDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate binding ID. The ID has the form `<cluster_id>:<endpoint>`.",
+			},
+			keyAdminPassword: cdmAdminPasswordSchema(),
+			keyCertificateID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "ID of the certificate to bind to the endpoint. The certificate must have a private key.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyEndpoint: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Cluster endpoint to bind the certificate to. Possible values are `REPLICATION` and " +
+					"`WEB_SERVER`. Changing this value forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice([]string{
+					cdmCertificateEndpointReplication, cdmCertificateEndpointWebServer,
+				}, false),
+			},
+			keyExpiration: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration time of the bound certificate, in RFC 3339 format.",
+			},
+		},
+	}
+}
+
+func resourceCDMCertificateBindingCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateBindingCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpoint := d.Get(keyEndpoint).(string)
+	if err := bindCDMCertificate(ctx, client, endpoint, d.Get(keyCertificateID).(string)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID + ":" + endpoint)
+	return resourceCDMCertificateBindingRead(ctx, d, m)
+}
+
+func resourceCDMCertificateBindingRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateBindingRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	certificateID, err := cdmBoundCertificateID(ctx, client, d.Get(keyEndpoint).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if certificateID == "" {
+		d.SetId("")
+		return nil
+	}
+
+	var expiration string
+	certificate, ok, err := cdmClusterCertificate(ctx, client, certificateID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if ok {
+		expiration = certificate.Expiration
+	}
+
+	if err := d.Set(keyCertificateID, certificateID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyExpiration, expiration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMCertificateBindingUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateBindingUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(keyCertificateID) {
+		if err := bindCDMCertificate(ctx, client, d.Get(keyEndpoint).(string), d.Get(keyCertificateID).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMCertificateBindingRead(ctx, d, m)
+}
+
+func resourceCDMCertificateBindingDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMCertificateBindingDelete")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	endpoint := cdmCertificateBindingEndpoints[d.Get(keyEndpoint).(string)]
+	if err := cdmRequest(ctx, client, http.MethodDelete, cdm.Internal, endpoint, nil, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importCDMCertificateBinding imports a certificate binding. The import ID has
+// the form <cluster_node_ip_address>/<endpoint>. The credentials are read from
+// the environment, see cdmClusterClient.
+func importCDMCertificateBinding(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	tflog.Trace(ctx, "importCDMCertificateBinding")
+
+	nodeIP, endpoint, ok := strings.Cut(d.Id(), "/")
+	if !ok || nodeIP == "" {
+		return nil, fmt.Errorf("invalid certificate binding import ID %q, expected "+
+			"<cluster_node_ip_address>/<endpoint>", d.Id())
+	}
+	if _, ok := cdmCertificateBindingEndpoints[endpoint]; !ok {
+		endpoints := make([]string, 0, len(cdmCertificateBindingEndpoints))
+		for endpoint := range cdmCertificateBindingEndpoints {
+			endpoints = append(endpoints, endpoint)
+		}
+		slices.Sort(endpoints)
+		return nil, fmt.Errorf("invalid certificate endpoint %q, expected one of %s", endpoint,
+			strings.Join(endpoints, ", "))
+	}
+	if err := d.Set(keyClusterNodeIPAddress, nodeIP); err != nil {
+		return nil, err
+	}
+	if err := d.Set(keyEndpoint, endpoint); err != nil {
+		return nil, err
+	}
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return nil, err
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(clusterID + ":" + endpoint)
+	return []*schema.ResourceData{d}, nil
+}
+
+// bindCDMCertificate binds the certificate with the specified ID to the
+// endpoint.
+func bindCDMCertificate(ctx context.Context, client *cdm.Client, endpoint, certificateID string) error {
+	params := struct {
+		CertificateID string `json:"certificateId"`
+	}{CertificateID: certificateID}
+	return cdmRequest(ctx, client, http.MethodPut, cdm.Internal, cdmCertificateBindingEndpoints[endpoint], params, nil)
+}
+
+// cdmBoundCertificateID returns the ID of the certificate bound to the
+// endpoint. Returns an empty string if the endpoint uses the self-signed
+// certificate of the cluster.
+func cdmBoundCertificateID(ctx context.Context, client *cdm.Client, endpoint string) (string, error) {
+	var binding struct {
+		CertificateID string `json:"certificateId"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmCertificateBindingEndpoints[endpoint], nil, &binding); err != nil {
+		return "", err
+	}
+
+	return binding.CertificateID, nil
+}
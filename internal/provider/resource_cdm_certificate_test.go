@@ -0,0 +1,101 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestParseCDMCertificateImportID(t *testing.T) {
+	nodeIP, certificateID, err := parseCDMCertificateImportID("10.1.100.100/Certificate:::8a5d7f2e-5a3f-4c4e-9d3b-2f1d0a6b9c11")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeIP != "10.1.100.100" {
+		t.Fatalf("invalid node IP address: %s", nodeIP)
+	}
+	if certificateID != "Certificate:::8a5d7f2e-5a3f-4c4e-9d3b-2f1d0a6b9c11" {
+		t.Fatalf("invalid certificate ID: %s", certificateID)
+	}
+
+	for _, id := range []string{"", "10.1.100.100", "10.1.100.100/", "/Certificate:::1"} {
+		if _, _, err := parseCDMCertificateImportID(id); err == nil {
+			t.Fatalf("expected import ID %q to fail", id)
+		}
+	}
+}
+
+func TestValidateCertificatePEM(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "rubrik.example.org"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	testCases := []struct {
+		name  string
+		value string
+		valid bool
+	}{{
+		name:  "Certificate",
+		value: cert,
+		valid: true,
+	}, {
+		name:  "CertificateChain",
+		value: cert + cert,
+		valid: true,
+	}, {
+		name:  "Empty",
+		value: "",
+	}, {
+		name:  "PrivateKey",
+		value: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: key.Seed()})),
+	}, {
+		name:  "InvalidCertificate",
+		value: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")})),
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, errs := validateCertificatePEM(testCase.value, keyCertificate)
+			if valid := len(errs) == 0; valid != testCase.valid {
+				t.Fatalf("expected valid to be %t, got errors: %v", testCase.valid, errs)
+			}
+		})
+	}
+}
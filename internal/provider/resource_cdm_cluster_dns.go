@@ -0,0 +1,183 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterDNSDescription = `
+The ´polaris_cdm_cluster_dns´ resource manages the DNS servers and the DNS
+search domains of a bootstrapped Rubrik cluster. The resource connects directly
+to the Rubrik cluster.
+
+~> **Note:** A Rubrik cluster always has DNS servers configured. Destroying the
+   resource only removes it from the local state.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const (
+	cdmDNSNameServerEndpoint   = "/cluster/me/dns_nameserver"
+	cdmDNSSearchDomainEndpoint = "/cluster/me/dns_search_domain"
+)
+
+func resourceCDMClusterDNS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterDNSCreate,
+		ReadContext:   resourceCDMClusterDNSRead,
+		UpdateContext: resourceCDMClusterDNSUpdate,
+		DeleteContext: resourceCDMClusterDNSDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterDNSDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyDNSNameServers: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				MinItems:    1,
+				Description: "IPv4 or IPv6 addresses of DNS servers.",
+			},
+			keyDNSSearchDomain: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				Description: "The search domains that the DNS Service will use to resolve hostnames that are not " +
+					"fully qualified.",
+			},
+		},
+	}
+}
+
+func resourceCDMClusterDNSCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterDNSCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterDNS(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterDNSRead(ctx, d, m)
+}
+
+func resourceCDMClusterDNSRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterDNSRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameServers []string
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmDNSNameServerEndpoint, nil, &nameServers); err != nil {
+		return diag.FromErr(err)
+	}
+	var searchDomains []string
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmDNSSearchDomainEndpoint, nil, &searchDomains); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(keyDNSNameServers, nameServers); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyDNSSearchDomain, searchDomains); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterDNSUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterDNSUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(keyDNSNameServers, keyDNSSearchDomain) {
+		if err := setCDMClusterDNS(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterDNSRead(ctx, d, m)
+}
+
+// The DNS servers of a cluster cannot be removed, delete simply removes the
+// resource from the local state.
+func resourceCDMClusterDNSDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterDNSDelete")
+	d.SetId("")
+	return nil
+}
+
+// setCDMClusterDNS sets the DNS servers and the DNS search domains of the
+// cluster to the ones of the resource configuration.
+func setCDMClusterDNS(ctx context.Context, client *cdm.Client, d *schema.ResourceData) error {
+	nameServers := []string{}
+	for _, nameServer := range d.Get(keyDNSNameServers).([]any) {
+		nameServers = append(nameServers, nameServer.(string))
+	}
+	if err := cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmDNSNameServerEndpoint, nameServers, nil); err != nil {
+		return err
+	}
+
+	searchDomains := []string{}
+	for _, searchDomain := range d.Get(keyDNSSearchDomain).([]any) {
+		searchDomains = append(searchDomains, searchDomain.(string))
+	}
+	return cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmDNSSearchDomainEndpoint, searchDomains, nil)
+}
@@ -0,0 +1,387 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterNodesDescription = `
+The ´polaris_cdm_cluster_nodes´ resource manages the nodes of a bootstrapped
+Rubrik cluster. Nodes added to ´cluster_nodes´ are added to the cluster and
+nodes removed from ´cluster_nodes´ are removed from the cluster. The resource
+connects directly to the Rubrik cluster and waits for each add-node and
+remove-node operation to finish. Nodes are removed one at a time.
+
+When ´wait_for_completion´ is ´false´, the resource returns as soon as the
+add-node operation has started. The progress of the operation is then reported
+by the ´node_status´ field when the resource is refreshed. Remove-node
+operations are always waited for, since nodes are removed one at a time.
+
+Changes to the nodes of the cluster made outside of Terraform are detected and
+shown as a difference in ´cluster_nodes´ when planning.
+
+~> **Note:** The node with the ´cluster_node_ip_address´ IP address is used to
+   connect to the cluster and cannot be removed by the resource.
+
+~> **Note:** Destroying the resource only removes it from the local state, no
+   nodes are removed from the cluster.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const (
+	cdmNodeEndpoint     = "/node"
+	cdmAddNodesEndpoint = "/cluster/me/add_nodes"
+	cdmNodesWaitTime    = 30 * time.Second
+)
+
+// cdmNode holds the status of a cluster node.
+type cdmNode struct {
+	ID        string `json:"id"`
+	IPAddress string `json:"ipAddress"`
+	Status    string `json:"status"`
+}
+
+func resourceCDMClusterNodes() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterNodesCreate,
+		ReadContext:   resourceCDMClusterNodesRead,
+		UpdateContext: resourceCDMClusterNodesUpdate,
+		DeleteContext: resourceCDMClusterNodesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterNodesDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyClusterNodes: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+				Description: "The node name and management IP address of the cluster nodes formatted as a map.",
+			},
+			keyManagementGateway: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "IP address assigned to the management network gateway. Required when adding nodes.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			keyManagementSubnetMask: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Subnet mask assigned to the management network. Required when adding nodes. For IPv6, " +
+					"the subnet mask is specified in address form, e.g. `ffff:ffff:ffff:ffff::`.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			keyNodeStatus: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyIPAddress: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Management IP address of the node.",
+						},
+						keyName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node name.",
+						},
+						keyStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Node status, e.g. `OK`, `ADDING` or `REMOVING`.",
+						},
+					},
+				},
+				Description: "Status of each node of the cluster.",
+			},
+			keyWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Flag to determine if Terraform should wait for the add-node operation to complete. " +
+					"When `false`, Terraform returns as soon as the add-node operation has started. Nodes being " +
+					"removed are always waited for. Default value is `true`.",
+			},
+		},
+
+		CustomizeDiff: customizeDiffCDMClusterNodes,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Update:  schema.DefaultTimeout(240 * time.Minute),
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func resourceCDMClusterNodesCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNodesCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterNodes(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterNodesRead(ctx, d, m)
+}
+
+func resourceCDMClusterNodesRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNodesRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nodes, err := cdmClusterNodes(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterNodes := make(map[string]any, len(nodes))
+	nodeStatus := make([]any, 0, len(nodes))
+	for _, node := range nodes {
+		clusterNodes[node.ID] = node.IPAddress
+		nodeStatus = append(nodeStatus, map[string]any{
+			keyIPAddress: node.IPAddress,
+			keyName:      node.ID,
+			keyStatus:    node.Status,
+		})
+	}
+	if err := d.Set(keyClusterNodes, clusterNodes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyNodeStatus, nodeStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterNodesUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNodesUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(keyClusterNodes) {
+		if err := setCDMClusterNodes(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterNodesRead(ctx, d, m)
+}
+
+// Removing all nodes of a cluster isn't possible, delete simply removes the
+// resource from the local state.
+func resourceCDMClusterNodesDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNodesDelete")
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffCDMClusterNodes verifies that the node used to connect to the
+// cluster is kept, that the IP addresses of the nodes are unique, that the IP
+// address of an existing node isn't changed and that the management network is
+// specified when nodes are added.
+func customizeDiffCDMClusterNodes(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffCDMClusterNodes")
+
+	if !diff.NewValueKnown(keyClusterNodes) {
+		return nil
+	}
+
+	nodeIP := diff.Get(keyClusterNodeIPAddress).(string)
+	foundNodeIP := false
+	nodeNames := make(map[string]string)
+	for name, ip := range diff.Get(keyClusterNodes).(map[string]any) {
+		ip := ip.(string)
+		if other, ok := nodeNames[ip]; ok {
+			return fmt.Errorf("nodes %q and %q have the same IP address %q", other, name, ip)
+		}
+		nodeNames[ip] = name
+		if ip == nodeIP {
+			foundNodeIP = true
+		}
+	}
+	if nodeIP != "" && !foundNodeIP {
+		return fmt.Errorf("the node with IP address %q is used to connect to the cluster and must be part of %s",
+			nodeIP, keyClusterNodes)
+	}
+
+	oldNodes, newNodes := diff.GetChange(keyClusterNodes)
+	for name, ip := range newNodes.(map[string]any) {
+		if oldIP, ok := oldNodes.(map[string]any)[name]; ok && oldIP != ip {
+			return fmt.Errorf("changing the IP address of node %q isn't supported, remove the node and add it "+
+				"back", name)
+		}
+	}
+	added, _ := diffCDMClusterNodes(oldNodes.(map[string]any), newNodes.(map[string]any))
+	if diff.Id() != "" && len(added) > 0 {
+		if diff.Get(keyManagementGateway).(string) == "" || diff.Get(keyManagementSubnetMask).(string) == "" {
+			return fmt.Errorf("%s and %s are required when adding nodes", keyManagementGateway,
+				keyManagementSubnetMask)
+		}
+	}
+
+	return nil
+}
+
+// cdmClusterNodes returns the nodes of the cluster.
+func cdmClusterNodes(ctx context.Context, client *cdm.Client) ([]cdmNode, error) {
+	var nodes struct {
+		Data []cdmNode `json:"data"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmNodeEndpoint, nil, &nodes); err != nil {
+		return nil, err
+	}
+
+	return nodes.Data, nil
+}
+
+// setCDMClusterNodes adds and removes nodes so that the nodes of the cluster
+// match the nodes of the resource configuration. Nodes are added in a single
+// operation, then removed one at a time. Each operation is polled until it
+// finishes. When not waiting for completion and no nodes are removed, the
+// add-node operation isn't polled.
+func setCDMClusterNodes(ctx context.Context, client *cdm.Client, d *schema.ResourceData) error {
+	nodes, err := cdmClusterNodes(ctx, client)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]any, len(nodes))
+	for _, node := range nodes {
+		current[node.ID] = node.IPAddress
+	}
+
+	desired := d.Get(keyClusterNodes).(map[string]any)
+	added, removed := diffCDMClusterNodes(current, desired)
+	if len(added) > 0 {
+		gateway := d.Get(keyManagementGateway).(string)
+		netmask := d.Get(keyManagementSubnetMask).(string)
+		if gateway == "" || netmask == "" {
+			return fmt.Errorf("%s and %s are required when adding nodes", keyManagementGateway,
+				keyManagementSubnetMask)
+		}
+
+		params := struct {
+			Nodes map[string]bootstrapNodeConfig `json:"nodes"`
+		}{Nodes: make(map[string]bootstrapNodeConfig, len(added))}
+		for _, name := range added {
+			params.Nodes[name] = bootstrapNodeConfig{
+				ManagementIPConfig: bootstrapIPConfig{
+					Address: desired[name].(string),
+					Netmask: netmask,
+					Gateway: gateway,
+				},
+			}
+		}
+
+		tflog.Info(ctx, "adding cluster nodes", map[string]any{"nodes": added})
+		var request struct {
+			ID int `json:"id"`
+		}
+		if err := cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmAddNodesEndpoint, params, &request); err != nil {
+			return err
+		}
+		if !d.Get(keyWaitForCompletion).(bool) && len(removed) == 0 {
+			return nil
+		}
+		endpoint := fmt.Sprintf("%s?request_id=%d", cdmAddNodesEndpoint, request.ID)
+		if err := cdmWaitForRequest(ctx, client, cdm.Internal, endpoint, cdmNodesWaitTime); err != nil {
+			return fmt.Errorf("failed to add nodes %v: %s", added, err)
+		}
+	}
+
+	for _, name := range removed {
+		tflog.Info(ctx, "removing cluster node", map[string]any{"node": name})
+		var request struct {
+			ID string `json:"id"`
+		}
+		endpoint := fmt.Sprintf("%s/%s", cdmNodeEndpoint, name)
+		if err := cdmRequest(ctx, client, http.MethodDelete, cdm.Internal, endpoint, nil, &request); err != nil {
+			return err
+		}
+		endpoint = fmt.Sprintf("%s/request/%s", cdmNodeEndpoint, request.ID)
+		if err := cdmWaitForRequest(ctx, client, cdm.Internal, endpoint, cdmNodesWaitTime); err != nil {
+			return fmt.Errorf("failed to remove node %q: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// diffCDMClusterNodes returns the names of the nodes added to and removed from
+// the current nodes to get the desired nodes. The names are sorted.
+func diffCDMClusterNodes(current, desired map[string]any) ([]string, []string) {
+	var added, removed []string
+	for name := range desired {
+		if _, ok := current[name]; !ok {
+			added = append(added, name)
+		}
+	}
+	for name := range current {
+		if _, ok := desired[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+
+	return added, removed
+}
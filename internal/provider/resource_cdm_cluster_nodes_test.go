@@ -0,0 +1,131 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+func TestDiffCDMClusterNodes(t *testing.T) {
+	current := map[string]any{
+		"node-1": "10.1.100.100",
+		"node-2": "10.1.100.101",
+		"node-3": "10.1.100.102",
+	}
+	desired := map[string]any{
+		"node-1": "10.1.100.100",
+		"node-3": "10.1.100.102",
+		"node-5": "10.1.100.104",
+		"node-4": "10.1.100.103",
+	}
+
+	added, removed := diffCDMClusterNodes(current, desired)
+	if !slices.Equal(added, []string{"node-4", "node-5"}) {
+		t.Fatalf("invalid added nodes: %v", added)
+	}
+	if !slices.Equal(removed, []string{"node-2"}) {
+		t.Fatalf("invalid removed nodes: %v", removed)
+	}
+
+	added, removed = diffCDMClusterNodes(current, current)
+	if len(added) != 0 || len(removed) != 0 {
+		t.Fatalf("expected no changes, got added %v and removed %v", added, removed)
+	}
+}
+
+// TestSetCDMClusterNodesWaitForCompletion verifies that the add-node operation
+// is only polled when waiting for completion.
+func TestSetCDMClusterNodesWaitForCompletion(t *testing.T) {
+	tests := []struct {
+		name              string
+		waitForCompletion bool
+		wantPolled        bool
+	}{{
+		name:              "Wait",
+		waitForCompletion: true,
+		wantPolled:        true,
+	}, {
+		name:              "NoWait",
+		waitForCompletion: false,
+		wantPolled:        false,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []string
+			srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests = append(requests, r.Method+" "+r.URL.RequestURI())
+				mu.Unlock()
+
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/internal/node":
+					_ = json.NewEncoder(w).Encode(map[string]any{"data": []cdmNode{
+						{ID: "node-1", IPAddress: "10.1.100.100", Status: "OK"},
+					}})
+				case r.Method == http.MethodPost && r.URL.Path == "/api/internal/cluster/me/add_nodes":
+					_ = json.NewEncoder(w).Encode(map[string]any{"id": 7})
+				case r.Method == http.MethodGet && r.URL.Path == "/api/internal/cluster/me/add_nodes":
+					_ = json.NewEncoder(w).Encode(map[string]any{"status": "SUCCESS"})
+				default:
+					http.Error(w, "unexpected request", http.StatusNotFound)
+				}
+			}))
+			defer srv.Close()
+
+			d := schema.TestResourceDataRaw(t, resourceCDMClusterNodes().Schema, map[string]any{
+				keyClusterNodes: map[string]any{
+					"node-1": "10.1.100.100",
+					"node-2": "10.1.100.101",
+				},
+				keyManagementGateway:    "10.1.100.1",
+				keyManagementSubnetMask: "255.255.255.0",
+				keyWaitForCompletion:    tc.waitForCompletion,
+			})
+			client := cdm.NewClient(strings.TrimPrefix(srv.URL, "https://"), true)
+			if err := setCDMClusterNodes(context.Background(), client, d); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			if !slices.Contains(requests, "POST /api/internal/cluster/me/add_nodes") {
+				t.Fatalf("expected the nodes to be added, got requests %v", requests)
+			}
+			polled := slices.Contains(requests, "GET /api/internal/cluster/me/add_nodes?request_id=7")
+			if polled != tc.wantPolled {
+				t.Errorf("add-node operation polled = %t, want %t", polled, tc.wantPolled)
+			}
+		})
+	}
+}
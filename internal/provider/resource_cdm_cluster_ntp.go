@@ -0,0 +1,170 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterNTPDescription = `
+The ´polaris_cdm_cluster_ntp´ resource manages the NTP servers of a
+bootstrapped Rubrik cluster. The resource connects directly to the Rubrik
+cluster.
+
+The symmetric key material of an NTP server cannot be read back from the Rubrik
+cluster, so changes to the key material made outside of Terraform are not
+detected.
+
+~> **Note:** A Rubrik cluster always has NTP servers configured. Destroying the
+   resource only removes it from the local state.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const cdmNTPServerEndpoint = "/cluster/me/ntp_server"
+
+func resourceCDMClusterNTP() *schema.Resource {
+	ntpServer := cdmBootstrapNTPServerSchema()
+	ntpServer.Optional = false
+	ntpServer.Required = true
+
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterNTPCreate,
+		ReadContext:   resourceCDMClusterNTPRead,
+		UpdateContext: resourceCDMClusterNTPUpdate,
+		DeleteContext: resourceCDMClusterNTPDelete,
+
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+			return validateNTPServerKeys(diff.Get(keyNTPServer).([]any))
+		},
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterNTPDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyNTPServer:            ntpServer,
+		},
+	}
+}
+
+func resourceCDMClusterNTPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNTPCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ntpServers := fromNTPServerBlocks(d.Get(keyNTPServer).([]any))
+	if err := cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmNTPServerEndpoint, ntpServers, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterNTPRead(ctx, d, m)
+}
+
+func resourceCDMClusterNTPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNTPRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var ntpServers struct {
+		Data []cdm.NTPServerConfig `json:"data"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmNTPServerEndpoint, nil, &ntpServers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The symmetric key material is never returned by the cluster, so the key
+	// material of the current state is kept.
+	keys := make(map[string]string)
+	for _, server := range d.Get(keyNTPServer).([]any) {
+		server := server.(map[string]any)
+		keys[server[keyName].(string)] = server[keyKey].(string)
+	}
+	servers := make([]any, 0, len(ntpServers.Data))
+	for _, ntpServer := range ntpServers.Data {
+		server := map[string]any{
+			keyName: ntpServer.Server,
+		}
+		if ntpServer.SymmetricKey != nil {
+			server[keyKey] = keys[ntpServer.Server]
+			server[keyKeyID] = ntpServer.SymmetricKey.KeyID
+			server[keyKeyType] = ntpServer.SymmetricKey.KeyType
+		}
+		servers = append(servers, server)
+	}
+	if err := d.Set(keyNTPServer, servers); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterNTPUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNTPUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(keyNTPServer) {
+		ntpServers := fromNTPServerBlocks(d.Get(keyNTPServer).([]any))
+		if err := cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmNTPServerEndpoint, ntpServers, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterNTPRead(ctx, d, m)
+}
+
+// The NTP servers of a cluster cannot be removed, delete simply removes the
+// resource from the local state.
+func resourceCDMClusterNTPDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterNTPDelete")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterProxyDescription = `
+The ´polaris_cdm_cluster_proxy´ resource manages the proxy server used by a
+bootstrapped Rubrik cluster for outbound connections. The resource connects
+directly to the Rubrik cluster.
+
+The password of the proxy server cannot be read back from the Rubrik cluster, so
+changes to the password made outside of Terraform are not detected.
+
+Destroying the resource removes the proxy server configuration from the Rubrik
+cluster.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const cdmProxyConfigEndpoint = "/node_management/proxy_config"
+
+// cdmProxyConfig holds the proxy server configuration of a cluster.
+type cdmProxyConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port,omitempty"`
+	Protocol string `json:"protocol"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+func resourceCDMClusterProxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterProxyCreate,
+		ReadContext:   resourceCDMClusterProxyRead,
+		UpdateContext: resourceCDMClusterProxyUpdate,
+		DeleteContext: resourceCDMClusterProxyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterProxyDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyHost: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Proxy server IP address or FQDN.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyPassword: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{keyUsername},
+				Description:  "Password used to authenticate with the proxy server.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyPort: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Proxy server port number.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			keyProtocol: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "HTTP",
+				Description: "Protocol used to connect to the proxy server. Possible values are `HTTP`, `HTTPS` and " +
+					"`SOCKS5`. Default value is `HTTP`.",
+				ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "SOCKS5"}, false),
+			},
+			keyUsername: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Username used to authenticate with the proxy server.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func resourceCDMClusterProxyCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterProxyCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterProxy(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterProxyRead(ctx, d, m)
+}
+
+func resourceCDMClusterProxyRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterProxyRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var config cdmProxyConfig
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmProxyConfigEndpoint, nil, &config); err != nil {
+		return diag.FromErr(err)
+	}
+	if config.Host == "" {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(keyHost, config.Host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyPort, config.Port); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyProtocol, config.Protocol); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyUsername, config.Username); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterProxyUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterProxyUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(keyHost, keyPassword, keyPort, keyProtocol, keyUsername) {
+		if err := setCDMClusterProxy(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterProxyRead(ctx, d, m)
+}
+
+func resourceCDMClusterProxyDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterProxyDelete")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := cdmRequest(ctx, client, http.MethodDelete, cdm.Internal, cdmProxyConfigEndpoint, nil, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// setCDMClusterProxy sets the proxy server configuration of the cluster to the
+// one of the resource configuration.
+func setCDMClusterProxy(ctx context.Context, client *cdm.Client, d *schema.ResourceData) error {
+	config := cdmProxyConfig{
+		Host:     d.Get(keyHost).(string),
+		Port:     d.Get(keyPort).(int),
+		Protocol: d.Get(keyProtocol).(string),
+		Username: d.Get(keyUsername).(string),
+		Password: d.Get(keyPassword).(string),
+	}
+
+	return cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmProxyConfigEndpoint, config, nil)
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterSMTPDescription = `
+The ´polaris_cdm_cluster_smtp´ resource manages the SMTP server used by a
+bootstrapped Rubrik cluster to send email notifications. The resource connects
+directly to the Rubrik cluster.
+
+The password of the SMTP server cannot be read back from the Rubrik cluster, so
+changes to the password made outside of Terraform are not detected.
+
+~> **Note:** The SMTP server configuration of a cluster cannot be removed.
+   Destroying the resource only removes it from the local state.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const cdmSMTPInstanceEndpoint = "/smtp_instance"
+
+// cdmSMTPInstance holds the SMTP server configuration of a cluster.
+type cdmSMTPInstance struct {
+	ID          string `json:"id,omitempty"`
+	Hostname    string `json:"smtpHostname"`
+	Port        int    `json:"smtpPort"`
+	Security    string `json:"smtpSecurity"`
+	Username    string `json:"smtpUsername,omitempty"`
+	Password    string `json:"smtpPassword,omitempty"`
+	FromEmailID string `json:"fromEmailId"`
+}
+
+func resourceCDMClusterSMTP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterSMTPCreate,
+		ReadContext:   resourceCDMClusterSMTPRead,
+		UpdateContext: resourceCDMClusterSMTPUpdate,
+		DeleteContext: resourceCDMClusterSMTPDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterSMTPDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyFromEmail: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Email address used as the sender of the email notifications.",
+				ValidateFunc: validateEmailAddress,
+			},
+			keyHost: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "SMTP server IP address or FQDN.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyPassword: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{keyUsername},
+				Description:  "Password used to authenticate with the SMTP server.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      25,
+				Description:  "SMTP server port number. Default value is `25`.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			keySecurity: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NONE",
+				Description: "SMTP connection security. Possible values are `NONE`, `SSL` and `STARTTLS`. Default " +
+					"value is `NONE`.",
+				ValidateFunc: validation.StringInSlice([]string{"NONE", "SSL", "STARTTLS"}, false),
+			},
+			keyUsername: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Username used to authenticate with the SMTP server.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+func resourceCDMClusterSMTPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSMTPCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterSMTP(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterSMTPRead(ctx, d, m)
+}
+
+func resourceCDMClusterSMTPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSMTPRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instance, ok, err := cdmClusterSMTPInstance(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(keyHost, instance.Hostname); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyPort, instance.Port); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySecurity, instance.Security); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyUsername, instance.Username); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyFromEmail, instance.FromEmailID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterSMTPUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSMTPUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(keyFromEmail, keyHost, keyPassword, keyPort, keySecurity, keyUsername) {
+		if err := setCDMClusterSMTP(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterSMTPRead(ctx, d, m)
+}
+
+// The SMTP server configuration of a cluster cannot be removed, delete simply
+// removes the resource from the local state.
+func resourceCDMClusterSMTPDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSMTPDelete")
+	d.SetId("")
+	return nil
+}
+
+// cdmClusterSMTPInstance returns the SMTP server configuration of the cluster.
+// Returns false if the cluster has no SMTP server configured.
+func cdmClusterSMTPInstance(ctx context.Context, client *cdm.Client) (cdmSMTPInstance, bool, error) {
+	var instances struct {
+		Data []cdmSMTPInstance `json:"data"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmSMTPInstanceEndpoint, nil, &instances); err != nil {
+		return cdmSMTPInstance{}, false, err
+	}
+	if len(instances.Data) == 0 {
+		return cdmSMTPInstance{}, false, nil
+	}
+
+	return instances.Data[0], true, nil
+}
+
+// setCDMClusterSMTP sets the SMTP server configuration of the cluster to the
+// one of the resource configuration. The SMTP server configuration is created
+// if the cluster has none.
+func setCDMClusterSMTP(ctx context.Context, client *cdm.Client, d *schema.ResourceData) error {
+	instance, ok, err := cdmClusterSMTPInstance(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	params := cdmSMTPInstance{
+		Hostname:    d.Get(keyHost).(string),
+		Port:        d.Get(keyPort).(int),
+		Security:    d.Get(keySecurity).(string),
+		Username:    d.Get(keyUsername).(string),
+		Password:    d.Get(keyPassword).(string),
+		FromEmailID: d.Get(keyFromEmail).(string),
+	}
+	if !ok {
+		return cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmSMTPInstanceEndpoint, params, nil)
+	}
+	return cdmRequest(ctx, client, http.MethodPatch, cdm.Internal, cdmSMTPInstanceEndpoint+"/"+instance.ID, params, nil)
+}
@@ -0,0 +1,227 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterSNMPDescription = `
+The ´polaris_cdm_cluster_snmp´ resource manages the SNMP configuration of a
+bootstrapped Rubrik cluster. The resource connects directly to the Rubrik
+cluster.
+
+The community string cannot be read back from the Rubrik cluster, so changes to
+the community string made outside of Terraform are not detected.
+
+~> **Note:** The SNMP configuration of a cluster cannot be removed. Destroying
+   the resource only removes it from the local state, to disable SNMP set
+   ´enabled´ to ´false´.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const cdmSNMPConfigurationEndpoint = "/cluster/me/snmp_configuration"
+
+// cdmSNMPTrapReceiver holds the configuration of an SNMP trap receiver.
+type cdmSNMPTrapReceiver struct {
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+}
+
+// cdmSNMPConfiguration holds the SNMP configuration of a cluster.
+type cdmSNMPConfiguration struct {
+	IsEnabled       bool                  `json:"isSnmpEnabled"`
+	CommunityString string                `json:"communityString,omitempty"`
+	Port            int                   `json:"snmpAgentPort"`
+	TrapReceivers   []cdmSNMPTrapReceiver `json:"trapReceiverConfigs"`
+}
+
+func resourceCDMClusterSNMP() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterSNMPCreate,
+		ReadContext:   resourceCDMClusterSNMPRead,
+		UpdateContext: resourceCDMClusterSNMPUpdate,
+		DeleteContext: resourceCDMClusterSNMPDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterSNMPDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyCommunityString: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "SNMP community string.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether SNMP is enabled or not. Default value is `true`.",
+			},
+			keyPort: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      161,
+				Description:  "SNMP agent port number. Default value is `161`.",
+				ValidateFunc: validation.IsPortNumber,
+			},
+			keyTrapReceiver: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyAddress: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Trap receiver IP address or FQDN.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      162,
+							Description:  "Trap receiver port number. Default value is `162`.",
+							ValidateFunc: validation.IsPortNumber,
+						},
+					},
+				},
+				Description: "SNMP trap receiver.",
+			},
+		},
+	}
+}
+
+func resourceCDMClusterSNMPCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSNMPCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterSNMP(ctx, client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterSNMPRead(ctx, d, m)
+}
+
+func resourceCDMClusterSNMPRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSNMPRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var config cdmSNMPConfiguration
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmSNMPConfigurationEndpoint, nil, &config); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(keyEnabled, config.IsEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyPort, config.Port); err != nil {
+		return diag.FromErr(err)
+	}
+	receiverBlocks := make([]any, 0, len(config.TrapReceivers))
+	for _, receiver := range config.TrapReceivers {
+		receiverBlocks = append(receiverBlocks, map[string]any{
+			keyAddress: receiver.Address,
+			keyPort:    receiver.Port,
+		})
+	}
+	if err := d.Set(keyTrapReceiver, receiverBlocks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterSNMPUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSNMPUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges(keyCommunityString, keyEnabled, keyPort, keyTrapReceiver) {
+		if err := setCDMClusterSNMP(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterSNMPRead(ctx, d, m)
+}
+
+// The SNMP configuration of a cluster cannot be removed, delete simply removes
+// the resource from the local state.
+func resourceCDMClusterSNMPDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSNMPDelete")
+	d.SetId("")
+	return nil
+}
+
+// setCDMClusterSNMP sets the SNMP configuration of the cluster to the one of
+// the resource configuration.
+func setCDMClusterSNMP(ctx context.Context, client *cdm.Client, d *schema.ResourceData) error {
+	config := cdmSNMPConfiguration{
+		IsEnabled:       d.Get(keyEnabled).(bool),
+		CommunityString: d.Get(keyCommunityString).(string),
+		Port:            d.Get(keyPort).(int),
+		TrapReceivers:   []cdmSNMPTrapReceiver{},
+	}
+	for _, block := range d.Get(keyTrapReceiver).([]any) {
+		block := block.(map[string]any)
+		config.TrapReceivers = append(config.TrapReceivers, cdmSNMPTrapReceiver{
+			Address: block[keyAddress].(string),
+			Port:    block[keyPort].(int),
+		})
+	}
+
+	return cdmRequest(ctx, client, http.MethodPatch, cdm.Internal, cdmSNMPConfigurationEndpoint, config, nil)
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMClusterSyslogDescription = `
+The ´polaris_cdm_cluster_syslog´ resource manages the syslog servers of a
+bootstrapped Rubrik cluster. The resource connects directly to the Rubrik
+cluster.
+
+Destroying the resource removes all syslog servers from the Rubrik cluster.
+
+The resource can be imported using the IP address of the cluster node to
+connect to. The credentials used when importing are read from the
+´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´ and ´RUBRIK_CDM_PASSWORD´,
+environment variables.
+`
+
+const cdmSyslogEndpoint = "/syslog"
+
+// cdmSyslogServer holds the configuration of a syslog server.
+type cdmSyslogServer struct {
+	ID       string `json:"id,omitempty"`
+	Hostname string `json:"hostname"`
+	Port     int    `json:"port"`
+	Protocol string `json:"protocol"`
+}
+
+func resourceCDMClusterSyslog() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMClusterSyslogCreate,
+		ReadContext:   resourceCDMClusterSyslogRead,
+		UpdateContext: resourceCDMClusterSyslogUpdate,
+		DeleteContext: resourceCDMClusterSyslogDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMClusterSetting,
+		},
+
+		Description: description(resourceCDMClusterSyslogDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyAdminPassword:        cdmAdminPasswordSchema(),
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyServer: {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyHost: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Syslog server IP address or FQDN.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      514,
+							Description:  "Syslog server port number. Default value is `514`.",
+							ValidateFunc: validation.IsPortNumber,
+						},
+						keyProtocol: {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "UDP",
+							Description: "Protocol used to send messages to the syslog server. Possible values are " +
+								"`TCP` and `UDP`. Default value is `UDP`.",
+							ValidateFunc: validation.StringInSlice([]string{"TCP", "UDP"}, false),
+						},
+					},
+				},
+				MinItems:    1,
+				Description: "Syslog server.",
+			},
+		},
+	}
+}
+
+func resourceCDMClusterSyslogCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSyslogCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterID, err := cdmClusterID(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterSyslog(ctx, client, fromSyslogServerBlocks(d.Get(keyServer).([]any))); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID)
+	return resourceCDMClusterSyslogRead(ctx, d, m)
+}
+
+func resourceCDMClusterSyslogRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSyslogRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	servers, err := cdmClusterSyslogServers(ctx, client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(servers) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	serverBlocks := make([]any, 0, len(servers))
+	for _, server := range servers {
+		serverBlocks = append(serverBlocks, map[string]any{
+			keyHost:     server.Hostname,
+			keyPort:     server.Port,
+			keyProtocol: server.Protocol,
+		})
+	}
+	if err := d.Set(keyServer, serverBlocks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCDMClusterSyslogUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSyslogUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange(keyServer) {
+		if err := setCDMClusterSyslog(ctx, client, fromSyslogServerBlocks(d.Get(keyServer).([]any))); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMClusterSyslogRead(ctx, d, m)
+}
+
+func resourceCDMClusterSyslogDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMClusterSyslogDelete")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setCDMClusterSyslog(ctx, client, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// cdmClusterSyslogServers returns the syslog servers of the cluster.
+func cdmClusterSyslogServers(ctx context.Context, client *cdm.Client) ([]cdmSyslogServer, error) {
+	var servers struct {
+		Data []cdmSyslogServer `json:"data"`
+	}
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.Internal, cdmSyslogEndpoint, nil, &servers); err != nil {
+		return nil, err
+	}
+
+	return servers.Data, nil
+}
+
+// setCDMClusterSyslog sets the syslog servers of the cluster to the specified
+// servers. Existing syslog servers are reconciled with the specified servers
+// by position, servers in excess are added or removed.
+func setCDMClusterSyslog(ctx context.Context, client *cdm.Client, servers []cdmSyslogServer) error {
+	current, err := cdmClusterSyslogServers(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	for i, server := range servers {
+		if i >= len(current) {
+			if err := cdmRequest(ctx, client, http.MethodPost, cdm.Internal, cdmSyslogEndpoint, server, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if current[i].Hostname == server.Hostname && current[i].Port == server.Port &&
+			current[i].Protocol == server.Protocol {
+			continue
+		}
+		endpoint := cdmSyslogEndpoint + "/" + current[i].ID
+		if err := cdmRequest(ctx, client, http.MethodPatch, cdm.Internal, endpoint, server, nil); err != nil {
+			return err
+		}
+	}
+	for i := len(servers); i < len(current); i++ {
+		endpoint := cdmSyslogEndpoint + "/" + current[i].ID
+		if err := cdmRequest(ctx, client, http.MethodDelete, cdm.Internal, endpoint, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// fromSyslogServerBlocks returns the syslog servers of the server blocks.
+func fromSyslogServerBlocks(serverBlocks []any) []cdmSyslogServer {
+	servers := make([]cdmSyslogServer, 0, len(serverBlocks))
+	for _, block := range serverBlocks {
+		block := block.(map[string]any)
+		servers = append(servers, cdmSyslogServer{
+			Hostname: block[keyHost].(string),
+			Port:     block[keyPort].(int),
+			Protocol: block[keyProtocol].(string),
+		})
+	}
+
+	return servers
+}
@@ -22,12 +22,18 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
 	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
 )
 
@@ -35,15 +41,44 @@ const resourceCDMRegistrationDescription = `
 The ´polaris_cdm_registration´ resource registers a Rubrik cluster with the
 Rubrik Security Cloud (RSC).
 
-~> **Note:** The Terraform provider can only register clusters, it cannot
-   un-register clusters or read the state of a cluster registration. Destroying
-   the resource only removes it from the local state.
+The cluster can be registered using one of the following methods:
+  * ´OFFLINE´ - The entitlement details of the cluster nodes are read from the
+    cluster and used to generate an authentication token in RSC, which is then
+    used to set the registered mode of the cluster. This is the default.
+  * ´TOKEN´ - A registration token is generated in RSC and passed to the
+    cluster, which then connects to RSC.
+
+For both methods, the provider waits for the cluster to show up in RSC before
+the resource is created. The default create timeout is 20 minutes and can be
+overridden with a ´timeouts´ block.
+
+If the cluster is unregistered from RSC outside of Terraform, the resource is
+removed from the state and the cluster is registered again on the next apply.
+
+By default, destroying the resource only removes it from the local state. When
+´unregister_on_destroy´ is ´true´, destroying the resource also removes the
+cluster from RSC.
 `
 
+// Cluster registration methods.
+const (
+	registrationMethodOffline = "OFFLINE"
+	registrationMethodToken   = "TOKEN"
+)
+
+// cdmRegistrationTokenEndpoint is the CDM endpoint used to register a cluster
+// with RSC using a registration token.
+const cdmRegistrationTokenEndpoint = "/cluster/me/register_with_rsc"
+
+// cdmRegistrationWaitTime is the time to wait between checks for a cluster
+// registered using a registration token to show up in RSC.
+const cdmRegistrationWaitTime = 15 * time.Second
+
 func resourceCDMRegistration() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCDMRegistrationCreate,
 		ReadContext:   resourceCDMRegistrationRead,
+		UpdateContext: resourceCDMRegistrationUpdate,
 		DeleteContext: resourceCDMRegistrationDelete,
 
 		Description: description(resourceCDMRegistrationDescription),
@@ -61,6 +96,11 @@ func resourceCDMRegistration() *schema.Resource {
 				Description:  "Password for the cluster admin account.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
+			keyClusterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
 			keyClusterNodeIPAddress: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -75,12 +115,45 @@ func resourceCDMRegistration() *schema.Resource {
 				Description:  "Cluster name.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
+			keyRegistrationMethod: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  registrationMethodOffline,
+				Description: "Cluster registration method. Possible values are `OFFLINE` and `TOKEN`. Default value " +
+					"is `OFFLINE`. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice([]string{registrationMethodOffline, registrationMethodToken}, false),
+			},
 			keyRegistrationMode: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Cluster registration mode. For the `OFFLINE` method, the registered mode returned " +
+					"by the cluster. For the `TOKEN` method, the product type of the registration.",
+			},
+			keyProductType: {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "Cluster registration mode.",
+				Description: "Product type of the cluster as reported by RSC, e.g. `CDM`.",
+			},
+			keyUnregisterOnDestroy: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the cluster is removed from RSC when the resource is destroyed. Default value " +
+					"is `false`.",
 			},
 		},
+
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{{
+			Type:    resourceCDMRegistrationV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceCDMRegistrationStateUpgradeV0,
+			Version: 0,
+		}},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
 	}
 }
 
@@ -99,29 +172,40 @@ func resourceCDMRegistrationCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	clusterDetails, err := cdmClient.OfflineEntitle(ctx)
+	clusterID, err := cdmClusterID(ctx, cdmClient)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	var regConfig []core.NodeRegistrationConfig
-	for _, nodeDetails := range clusterDetails {
-		regConfig = append(regConfig, nodeDetails.ToNodeRegistrationConfig())
+	var mode string
+	switch d.Get(keyRegistrationMethod).(string) {
+	case registrationMethodToken:
+		mode, err = registerClusterWithToken(ctx, cdmClient, polarisClient)
+	default:
+		mode, err = registerClusterOffline(ctx, cdmClient, polarisClient)
 	}
-	authToken, _, err := core.Wrap(polarisClient.GQL).RegisterCluster(ctx, true, regConfig, true)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	mode, err := cdmClient.SetRegisteredMode(ctx, authToken)
+	// The cluster must show up in RSC before the resource is created,
+	// otherwise the next refresh would remove the resource from the state and
+	// the cluster would be registered again.
+	registered, err := waitForRegisteredCluster(ctx, polarisClient, clusterID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(d.Get(keyClusterName).(string))
+	if err := d.Set(keyClusterID, registered.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set(keyRegistrationMode, mode); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(keyProductType, string(registered.ProductType)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
@@ -129,13 +213,165 @@ func resourceCDMRegistrationCreate(ctx context.Context, d *schema.ResourceData,
 func resourceCDMRegistrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Trace(ctx, "resourceCDMRegistrationRead")
 
+	polarisClient, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// If the cluster has been unregistered from RSC, we remove the resource
+	// from the local state, so that the cluster is registered again. The
+	// cluster ID is only known once the cluster has been seen in RSC, a
+	// cluster which hasn't been seen yet might still be connecting to RSC.
+	clusterID := d.Get(keyClusterID).(string)
+	registered, ok, err := registeredCluster(ctx, polarisClient, clusterID, d.Get(keyClusterName).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		if clusterID == "" {
+			tflog.Warn(ctx, "cluster not yet registered with RSC", map[string]any{
+				"cluster_name": d.Get(keyClusterName).(string),
+			})
+			return nil
+		}
+		tflog.Warn(ctx, "cluster no longer registered with RSC", map[string]any{
+			"cluster_id":   clusterID,
+			"cluster_name": d.Get(keyClusterName).(string),
+		})
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set(keyClusterID, registered.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyProductType, string(registered.ProductType)); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
-// Once a Cluster has been registered it cannot be un-registered through the
-// resource, delete simply removes the resource from the local state.
+func resourceCDMRegistrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMRegistrationUpdate")
+
+	// Only unregister_on_destroy can be updated, which is only used when the
+	// resource is destroyed.
+	return resourceCDMRegistrationRead(ctx, d, m)
+}
+
+// resourceCDMRegistrationDelete removes the cluster from RSC if
+// unregister_on_destroy is true, otherwise delete simply removes the resource
+// from the local state.
 func resourceCDMRegistrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	tflog.Trace(ctx, "resourceCDMRegistrationDelete")
+
+	if d.Get(keyUnregisterOnDestroy).(bool) {
+		polarisClient, err := m.(*client).polaris()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		registered, ok, err := registeredCluster(ctx, polarisClient, d.Get(keyClusterID).(string),
+			d.Get(keyClusterName).(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if ok {
+			info, err := cluster.Wrap(polarisClient).RemoveCluster(ctx, registered.ID, false, 0)
+			if err != nil {
+				tflog.Error(ctx, "failed to unregister cluster", map[string]any{
+					"cluster_id":          registered.ID.String(),
+					"blocking_conditions": info.BlockingConditions,
+				})
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
+
+// registerClusterOffline registers the cluster with RSC using the entitlement
+// details of the cluster nodes. The cluster shows up in RSC once it has
+// connected to RSC. Returns the registered mode of the cluster.
+func registerClusterOffline(ctx context.Context, cdmClient *cdm.Client, polarisClient *polaris.Client) (string, error) {
+	clusterDetails, err := cdmClient.OfflineEntitle(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	var regConfig []core.NodeRegistrationConfig
+	for _, nodeDetails := range clusterDetails {
+		regConfig = append(regConfig, nodeDetails.ToNodeRegistrationConfig())
+	}
+	authToken, _, err := core.Wrap(polarisClient.GQL).RegisterCluster(ctx, true, regConfig, true)
+	if err != nil {
+		return "", err
+	}
+
+	return cdmClient.SetRegisteredMode(ctx, authToken)
+}
+
+// registerClusterWithToken registers the cluster with RSC using a registration
+// token generated by RSC. The cluster shows up in RSC once it has connected to
+// RSC. Returns the product type of the registration.
+func registerClusterWithToken(ctx context.Context, cdmClient *cdm.Client, polarisClient *polaris.Client) (string, error) {
+	token, productType, err := core.Wrap(polarisClient.GQL).RegisterCluster(ctx, true, nil, false)
+	if err != nil {
+		return "", err
+	}
+
+	err = cdmRequest(ctx, cdmClient, http.MethodPost, cdm.Internal, cdmRegistrationTokenEndpoint, struct {
+		Token string `json:"token"`
+	}{Token: token}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	return productType, nil
+}
+
+// waitForRegisteredCluster blocks until the cluster with the specified ID
+// shows up in RSC. Returns the cluster registered with RSC.
+func waitForRegisteredCluster(ctx context.Context, polarisClient *polaris.Client, clusterID string) (gqlcluster.Cluster, error) {
+	for {
+		registered, ok, err := registeredCluster(ctx, polarisClient, clusterID, "")
+		if err != nil {
+			return gqlcluster.Cluster{}, err
+		}
+		if ok {
+			return registered, nil
+		}
+
+		tflog.Info(ctx, "waiting for cluster to register with RSC", map[string]any{"cluster_id": clusterID})
+		select {
+		case <-ctx.Done():
+			return gqlcluster.Cluster{}, fmt.Errorf("wait for cluster %q to register with RSC: %w", clusterID, ctx.Err())
+		case <-time.After(cdmRegistrationWaitTime):
+		}
+	}
+}
+
+// registeredCluster returns the cluster registered with RSC. The cluster is
+// looked up by cluster ID, or by cluster name if the cluster ID is empty.
+// Returns false if the cluster isn't registered with RSC.
+func registeredCluster(ctx context.Context, polarisClient *polaris.Client, clusterID, clusterName string) (gqlcluster.Cluster, bool, error) {
+	var filter gqlcluster.SearchFilter
+	if clusterID != "" {
+		filter.ID = []string{clusterID}
+	} else {
+		filter.Name = []string{clusterName}
+	}
+	clusters, err := cluster.Wrap(polarisClient).ListClusters(ctx, filter, gqlcluster.SortByClusterName, core.SortOrderAsc)
+	if err != nil {
+		return gqlcluster.Cluster{}, false, err
+	}
+	for _, cluster := range clusters {
+		if (clusterID != "" && cluster.ID.String() == clusterID) || (clusterID == "" && cluster.Name == clusterName) {
+			return cluster, true, nil
+		}
+	}
+
+	return gqlcluster.Cluster{}, false, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	testRegistrationClusterID   = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a09"
+	testRegistrationClusterName = "my-cluster"
+)
+
+// testRegisteredClusters returns a GraphQL responder listing the specified
+// clusters.
+func testRegisteredClusters(clusters ...map[string]any) func(req gqlTestRequest) (any, error) {
+	return func(req gqlTestRequest) (any, error) {
+		if req.Operation != "SdkGolangAllClustersConnection" {
+			return nil, errors.New("unexpected operation: " + req.Operation)
+		}
+		edges := make([]any, 0, len(clusters))
+		for _, cluster := range clusters {
+			edges = append(edges, map[string]any{"node": cluster})
+		}
+		return map[string]any{"edges": edges}, nil
+	}
+}
+
+func TestReadCDMRegistration(t *testing.T) {
+	registered := map[string]any{
+		"id":          testRegistrationClusterID,
+		"name":        testRegistrationClusterName,
+		"productType": "CDM",
+	}
+
+	tests := []struct {
+		name      string
+		clusterID string
+		clusters  []map[string]any
+		wantID    bool
+		wantType  string
+	}{{
+		name:      "Registered",
+		clusterID: testRegistrationClusterID,
+		clusters:  []map[string]any{registered},
+		wantID:    true,
+		wantType:  "CDM",
+	}, {
+		name:     "RegisteredByName",
+		clusters: []map[string]any{registered},
+		wantID:   true,
+		wantType: "CDM",
+	}, {
+		name:      "Unregistered",
+		clusterID: testRegistrationClusterID,
+		wantID:    false,
+	}, {
+		name:   "NotYetSeen",
+		wantID: true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := newGQLTestClient(t, testRegisteredClusters(tc.clusters...))
+
+			d := schema.TestResourceDataRaw(t, resourceCDMRegistration().Schema, map[string]any{
+				keyClusterName: testRegistrationClusterName,
+			})
+			d.SetId(testRegistrationClusterName)
+			if err := d.Set(keyClusterID, tc.clusterID); err != nil {
+				t.Fatal(err)
+			}
+			if err := d.Set(keyRegistrationMode, "Registered"); err != nil {
+				t.Fatal(err)
+			}
+
+			if diags := resourceCDMRegistrationRead(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if hasID := d.Id() != ""; hasID != tc.wantID {
+				t.Fatalf("resource kept = %t, want %t", hasID, tc.wantID)
+			}
+			if !tc.wantID {
+				return
+			}
+			if tc.clusters != nil {
+				if id := d.Get(keyClusterID).(string); id != testRegistrationClusterID {
+					t.Errorf("cluster ID = %q, want %q", id, testRegistrationClusterID)
+				}
+			}
+			if productType := d.Get(keyProductType).(string); productType != tc.wantType {
+				t.Errorf("product type = %q, want %q", productType, tc.wantType)
+			}
+			if mode := d.Get(keyRegistrationMode).(string); mode != "Registered" {
+				t.Errorf("registration mode = %q, want it to be kept", mode)
+			}
+		})
+	}
+}
+
+func TestWaitForRegisteredCluster(t *testing.T) {
+	c, _ := newGQLTestClient(t, testRegisteredClusters(map[string]any{
+		"id":          testRegistrationClusterID,
+		"name":        testRegistrationClusterName,
+		"productType": "CDM",
+	}))
+
+	registered, err := waitForRegisteredCluster(context.Background(), c.polarisClient, testRegistrationClusterID)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if registered.ID.String() != testRegistrationClusterID || registered.ProductType != "CDM" {
+		t.Errorf("unexpected cluster: %+v", registered)
+	}
+
+	// A cluster which doesn't show up in RSC is waited for until the context
+	// is done.
+	c, _ = newGQLTestClient(t, testRegisteredClusters())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := waitForRegisteredCluster(ctx, c.polarisClient, testRegistrationClusterID); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestResourceCDMRegistrationStateUpgradeV0(t *testing.T) {
+	state := map[string]any{
+		keyID:                   testRegistrationClusterName,
+		keyAdminPassword:        "secret",
+		keyClusterNodeIPAddress: "10.1.100.100",
+		keyClusterName:          testRegistrationClusterName,
+		keyRegistrationMode:     "Registered",
+	}
+
+	upgraded, err := resourceCDMRegistrationStateUpgradeV0(context.Background(), state, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if method := upgraded[keyRegistrationMethod]; method != registrationMethodOffline {
+		t.Errorf("registration method = %v, want %s", method, registrationMethodOffline)
+	}
+	if mode := upgraded[keyRegistrationMode]; mode != "Registered" {
+		t.Errorf("registration mode = %v, want Registered", mode)
+	}
+	if id := upgraded[keyClusterID]; id != "" {
+		t.Errorf("cluster ID = %v, want it to be empty", id)
+	}
+	if destroy := upgraded[keyUnregisterOnDestroy]; destroy != false {
+		t.Errorf("unregister on destroy = %v, want false", destroy)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// resourceCDMRegistrationV0 returns the V0 schema for the
+// polaris_cdm_registration resource. This is used by the state upgrader.
+func resourceCDMRegistrationV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster name.",
+			},
+			keyAdminPassword: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				Description:  "Password for the cluster admin account.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyClusterNodeIPAddress: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The IP address of the cluster node to connect to.",
+				ValidateFunc: validation.IsIPAddress,
+			},
+			keyClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Cluster name.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyRegistrationMode: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster registration mode.",
+			},
+		},
+	}
+}
+
+// resourceCDMRegistrationStateUpgradeV0 upgrades the state from V0 to V1. V1
+// adds registration_method, unregister_on_destroy and cluster_id. V0 only
+// supported offline registration. The cluster ID is looked up by cluster name
+// the next time the resource is read.
+func resourceCDMRegistrationStateUpgradeV0(ctx context.Context, state map[string]any, m any) (map[string]any, error) {
+	tflog.Trace(ctx, "resourceCDMRegistrationStateUpgradeV0")
+
+	state[keyRegistrationMethod] = registrationMethodOffline
+	state[keyUnregisterOnDestroy] = false
+	state[keyClusterID] = ""
+
+	return state, nil
+}
@@ -0,0 +1,416 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/cdm"
+)
+
+const resourceCDMSLADomainDescription = `
+The ´polaris_cdm_sla_domain´ resource manages an SLA domain local to a Rubrik
+cluster. Local SLA domains are created on, and owned by, the Rubrik cluster, as
+opposed to global SLA domains which are managed by RSC using the
+´polaris_sla_domain´ resource. The resource connects directly to the Rubrik
+cluster.
+
+The retention of each schedule is specified in the unit of the schedule, i.e.,
+hours for the hourly schedule, days for the daily schedule, weeks for the weekly
+schedule, months for the monthly schedule and years for the yearly schedule.
+
+A local SLA domain can be converted to a global SLA domain using the
+´polaris_sla_domain_upgrade´ resource. After the conversion, the SLA domain is
+no longer local to the Rubrik cluster and should be removed from the state, e.g.
+using a ´removed´ block, and imported into a ´polaris_sla_domain´ resource.
+
+The resource can be imported using an ID of the form
+´<cluster_node_ip_address>/<sla_domain_id>´. The credentials used when
+importing are read from the ´RUBRIK_CDM_TOKEN´, or the ´RUBRIK_CDM_USERNAME´
+and ´RUBRIK_CDM_PASSWORD´, environment variables.
+`
+
+const cdmSLADomainEndpoint = "/sla_domain"
+
+// cdmSLAFrequency holds the frequency and retention of a local SLA domain
+// schedule.
+type cdmSLAFrequency struct {
+	Frequency      int    `json:"frequency"`
+	Retention      int    `json:"retention"`
+	DayOfWeek      string `json:"dayOfWeek,omitempty"`
+	DayOfMonth     string `json:"dayOfMonth,omitempty"`
+	DayOfYear      string `json:"dayOfYear,omitempty"`
+	YearStartMonth string `json:"yearStartMonth,omitempty"`
+}
+
+// cdmSLADomain holds an SLA domain local to a cluster.
+type cdmSLADomain struct {
+	ID               string                     `json:"id,omitempty"`
+	Name             string                     `json:"name"`
+	PrimaryClusterID string                     `json:"primaryClusterId,omitempty"`
+	Frequencies      map[string]cdmSLAFrequency `json:"frequencies"`
+}
+
+// cdmSLASchedules maps the schedule fields of the resource to the frequency
+// names of the CDM REST API.
+var cdmSLASchedules = []struct {
+	key       string
+	frequency string
+}{
+	{key: keyHourlySchedule, frequency: "hourly"},
+	{key: keyDailySchedule, frequency: "daily"},
+	{key: keyWeeklySchedule, frequency: "weekly"},
+	{key: keyMonthlySchedule, frequency: "monthly"},
+	{key: keyYearlySchedule, frequency: "yearly"},
+}
+
+func resourceCDMSLADomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCDMSLADomainCreate,
+		ReadContext:   resourceCDMSLADomainRead,
+		UpdateContext: resourceCDMSLADomainUpdate,
+		DeleteContext: resourceCDMSLADomainDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: importCDMSLADomain,
+		},
+
+		Description: description(resourceCDMSLADomainDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SLA domain ID (UUID).",
+			},
+			keyAdminPassword: cdmAdminPasswordSchema(),
+			keyClusterID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID (UUID) of the Rubrik cluster owning the SLA domain.",
+			},
+			keyClusterNodeIPAddress: cdmClusterNodeIPAddressSchema(),
+			keyDailySchedule:        cdmSLAScheduleSchema("Daily schedule of the SLA domain. Retention in days.", nil),
+			keyHourlySchedule:       cdmSLAScheduleSchema("Hourly schedule of the SLA domain. Retention in hours.", nil),
+			keyMonthlySchedule: cdmSLAScheduleSchema("Monthly schedule of the SLA domain. Retention in months.",
+				map[string]*schema.Schema{
+					keyDayOfMonth: {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "LastDay",
+						Description: "Day of the month. Possible values are `FirstDay`, `Fifteenth` and `LastDay`. " +
+							"Default value is `LastDay`.",
+						ValidateFunc: validation.StringInSlice([]string{"FirstDay", "Fifteenth", "LastDay"}, false),
+					},
+				}),
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "SLA domain name.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyWeeklySchedule: cdmSLAScheduleSchema("Weekly schedule of the SLA domain. Retention in weeks.",
+				map[string]*schema.Schema{
+					keyDayOfWeek: {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "Saturday",
+						Description: "Day of the week. Possible values are `Monday`, `Tuesday`, `Wednesday`, " +
+							"`Thursday`, `Friday`, `Saturday` and `Sunday`. Default value is `Saturday`.",
+						ValidateFunc: validation.StringInSlice([]string{"Monday", "Tuesday", "Wednesday", "Thursday",
+							"Friday", "Saturday", "Sunday"}, false),
+					},
+				}),
+			keyYearlySchedule: cdmSLAScheduleSchema("Yearly schedule of the SLA domain. Retention in years.",
+				map[string]*schema.Schema{
+					keyDayOfYear: {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "LastDay",
+						Description: "Day of the year. Possible values are `FirstDay` and `LastDay`. Default value " +
+							"is `LastDay`.",
+						ValidateFunc: validation.StringInSlice([]string{"FirstDay", "LastDay"}, false),
+					},
+					keyYearStartMonth: {
+						Type:     schema.TypeString,
+						Optional: true,
+						Default:  "January",
+						Description: "First month of the year. Possible values are `January` through `December`. " +
+							"Default value is `January`.",
+						ValidateFunc: validation.StringInSlice([]string{"January", "February", "March", "April",
+							"May", "June", "July", "August", "September", "October", "November", "December"}, false),
+					},
+				}),
+		},
+	}
+}
+
+// cdmSLAScheduleSchema returns the schema for a local SLA domain schedule with
+// the specified additional fields.
+func cdmSLAScheduleSchema(desc string, fields map[string]*schema.Schema) *schema.Schema {
+	elem := map[string]*schema.Schema{
+		keyFrequency: {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Frequency of the snapshots, in the unit of the schedule.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		keyRetention: {
+			Type:         schema.TypeInt,
+			Required:     true,
+			Description:  "Retention of the snapshots, in the unit of the schedule.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}
+	for key, field := range fields {
+		elem[key] = field
+	}
+
+	atLeastOneOf := make([]string, 0, len(cdmSLASchedules))
+	for _, s := range cdmSLASchedules {
+		atLeastOneOf = append(atLeastOneOf, s.key)
+	}
+
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  desc,
+		AtLeastOneOf: atLeastOneOf,
+		Elem: &schema.Resource{
+			Schema: elem,
+		},
+	}
+}
+
+func resourceCDMSLADomainCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMSLADomainCreate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var slaDomain cdmSLADomain
+	if err := cdmRequest(ctx, client, http.MethodPost, cdm.V2, cdmSLADomainEndpoint, toCDMSLADomain(d), &slaDomain); err != nil {
+		return diag.FromErr(err)
+	}
+	if slaDomain.ID == "" {
+		return diag.Errorf("SLA domain ID not found in response")
+	}
+
+	d.SetId(slaDomain.ID)
+	return resourceCDMSLADomainRead(ctx, d, m)
+}
+
+func resourceCDMSLADomainRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMSLADomainRead")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	slaDomain, ok, err := cdmClusterSLADomain(ctx, client, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set(keyName, slaDomain.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyClusterID, slaDomain.PrimaryClusterID); err != nil {
+		return diag.FromErr(err)
+	}
+	for key, block := range fromCDMSLAFrequencies(slaDomain.Frequencies) {
+		if err := d.Set(key, block); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceCDMSLADomainUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMSLADomainUpdate")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChangesExcept(keyAdminPassword, keyClusterNodeIPAddress) {
+		if err := cdmRequest(ctx, client, http.MethodPut, cdm.V2, cdmSLADomainPath(d.Id()), toCDMSLADomain(d), nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCDMSLADomainRead(ctx, d, m)
+}
+
+func resourceCDMSLADomainDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "resourceCDMSLADomainDelete")
+
+	client, err := cdmClusterClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := cdmRequest(ctx, client, http.MethodDelete, cdm.V2, cdmSLADomainPath(d.Id()), nil, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importCDMSLADomain imports a local SLA domain. The import ID has the form
+// <cluster_node_ip_address>/<sla_domain_id>. The credentials are read from the
+// environment, see cdmClusterClient.
+func importCDMSLADomain(ctx context.Context, d *schema.ResourceData, m any) ([]*schema.ResourceData, error) {
+	tflog.Trace(ctx, "importCDMSLADomain")
+
+	nodeIP, slaDomainID, err := parseCDMSLADomainImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(keyClusterNodeIPAddress, nodeIP); err != nil {
+		return nil, err
+	}
+
+	d.SetId(slaDomainID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// parseCDMSLADomainImportID parses the SLA domain import ID into the IP
+// address of the cluster node and the SLA domain ID.
+func parseCDMSLADomainImportID(id string) (string, string, error) {
+	nodeIP, slaDomainID, ok := strings.Cut(id, "/")
+	if !ok || nodeIP == "" || slaDomainID == "" {
+		return "", "", fmt.Errorf("invalid SLA domain import ID %q, expected "+
+			"<cluster_node_ip_address>/<sla_domain_id>", id)
+	}
+
+	return nodeIP, slaDomainID, nil
+}
+
+// cdmClusterSLADomain returns the local SLA domain with the specified ID.
+// Returns false if the cluster has no local SLA domain with the ID.
+func cdmClusterSLADomain(ctx context.Context, client *cdm.Client, slaDomainID string) (cdmSLADomain, bool, error) {
+	var slaDomains struct {
+		Data []cdmSLADomain `json:"data"`
+	}
+	endpoint := cdmSLADomainEndpoint + "?primary_cluster_id=local"
+	if err := cdmRequest(ctx, client, http.MethodGet, cdm.V2, endpoint, nil, &slaDomains); err != nil {
+		return cdmSLADomain{}, false, err
+	}
+	for _, slaDomain := range slaDomains.Data {
+		if slaDomain.ID == slaDomainID {
+			return slaDomain, true, nil
+		}
+	}
+
+	return cdmSLADomain{}, false, nil
+}
+
+// cdmSLADomainPath returns the endpoint of the SLA domain with the specified
+// ID.
+func cdmSLADomainPath(slaDomainID string) string {
+	return cdmSLADomainEndpoint + "/" + url.PathEscape(slaDomainID)
+}
+
+// toCDMSLADomain returns the local SLA domain of the resource data.
+func toCDMSLADomain(d *schema.ResourceData) cdmSLADomain {
+	slaDomain := cdmSLADomain{
+		Name:        d.Get(keyName).(string),
+		Frequencies: make(map[string]cdmSLAFrequency),
+	}
+	for _, s := range cdmSLASchedules {
+		block, ok := d.Get(s.key).([]any)
+		if !ok || len(block) == 0 || block[0] == nil {
+			continue
+		}
+		slaDomain.Frequencies[s.frequency] = toCDMSLAFrequency(block[0].(map[string]any))
+	}
+
+	return slaDomain
+}
+
+// toCDMSLAFrequency returns the frequency of the schedule block.
+func toCDMSLAFrequency(block map[string]any) cdmSLAFrequency {
+	frequency := cdmSLAFrequency{
+		Frequency: block[keyFrequency].(int),
+		Retention: block[keyRetention].(int),
+	}
+	if v, ok := block[keyDayOfWeek].(string); ok {
+		frequency.DayOfWeek = v
+	}
+	if v, ok := block[keyDayOfMonth].(string); ok {
+		frequency.DayOfMonth = v
+	}
+	if v, ok := block[keyDayOfYear].(string); ok {
+		frequency.DayOfYear = v
+	}
+	if v, ok := block[keyYearStartMonth].(string); ok {
+		frequency.YearStartMonth = v
+	}
+
+	return frequency
+}
+
+// fromCDMSLAFrequencies returns the schedule blocks of the frequencies, keyed
+// by the schedule field. Schedules without a frequency are returned as empty
+// lists.
+func fromCDMSLAFrequencies(frequencies map[string]cdmSLAFrequency) map[string][]any {
+	blocks := make(map[string][]any, len(cdmSLASchedules))
+	for _, s := range cdmSLASchedules {
+		frequency, ok := frequencies[s.frequency]
+		if !ok || frequency.Frequency == 0 {
+			blocks[s.key] = []any{}
+			continue
+		}
+
+		block := map[string]any{
+			keyFrequency: frequency.Frequency,
+			keyRetention: frequency.Retention,
+		}
+		switch s.key {
+		case keyWeeklySchedule:
+			block[keyDayOfWeek] = frequency.DayOfWeek
+		case keyMonthlySchedule:
+			block[keyDayOfMonth] = frequency.DayOfMonth
+		case keyYearlySchedule:
+			block[keyDayOfYear] = frequency.DayOfYear
+			block[keyYearStartMonth] = frequency.YearStartMonth
+		}
+		blocks[s.key] = []any{block}
+	}
+
+	return blocks
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseCDMSLADomainImportID(t *testing.T) {
+	nodeIP, slaDomainID, err := parseCDMSLADomainImportID("10.1.100.100/5d3a0c1e-8f7b-4b6a-9e2d-1c0b3a4f5e6d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodeIP != "10.1.100.100" {
+		t.Fatalf("invalid node IP address: %s", nodeIP)
+	}
+	if slaDomainID != "5d3a0c1e-8f7b-4b6a-9e2d-1c0b3a4f5e6d" {
+		t.Fatalf("invalid SLA domain ID: %s", slaDomainID)
+	}
+
+	for _, id := range []string{"", "10.1.100.100", "10.1.100.100/", "/5d3a0c1e-8f7b-4b6a-9e2d-1c0b3a4f5e6d"} {
+		if _, _, err := parseCDMSLADomainImportID(id); err == nil {
+			t.Fatalf("expected import ID %q to fail", id)
+		}
+	}
+}
+
+func TestCDMSLADomainRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCDMSLADomain().Schema, map[string]any{
+		keyClusterNodeIPAddress: "10.1.100.100",
+		keyName:                 "local-gold",
+		keyHourlySchedule: []any{map[string]any{
+			keyFrequency: 4,
+			keyRetention: 24,
+		}},
+		keyMonthlySchedule: []any{map[string]any{
+			keyFrequency:  1,
+			keyRetention:  12,
+			keyDayOfMonth: "FirstDay",
+		}},
+	})
+
+	slaDomain := toCDMSLADomain(d)
+	if slaDomain.Name != "local-gold" {
+		t.Fatalf("invalid name: %s", slaDomain.Name)
+	}
+	expected := map[string]cdmSLAFrequency{
+		"hourly":  {Frequency: 4, Retention: 24},
+		"monthly": {Frequency: 1, Retention: 12, DayOfMonth: "FirstDay"},
+	}
+	if !reflect.DeepEqual(slaDomain.Frequencies, expected) {
+		t.Fatalf("invalid frequencies: %v", slaDomain.Frequencies)
+	}
+
+	blocks := fromCDMSLAFrequencies(slaDomain.Frequencies)
+	for _, key := range []string{keyDailySchedule, keyWeeklySchedule, keyYearlySchedule} {
+		if len(blocks[key]) != 0 {
+			t.Fatalf("expected %s to be empty: %v", key, blocks[key])
+		}
+	}
+	for _, key := range []string{keyHourlySchedule, keyMonthlySchedule} {
+		if err := d.Set(key, blocks[key]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !reflect.DeepEqual(toCDMSLADomain(d), slaDomain) {
+		t.Fatalf("invalid round trip: %v", toCDMSLADomain(d))
+	}
+}
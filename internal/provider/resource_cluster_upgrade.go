@@ -0,0 +1,497 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+)
+
+const resourceClusterUpgradeDescription = `
+The ´polaris_cluster_upgrade´ resource upgrades a Rubrik cluster registered
+with RSC to a target CDM version. The upgrade is orchestrated by RSC, the
+resource:
+ 1. Verifies, when planning, that the cluster can be upgraded to the target CDM
+    version in a single upgrade.
+ 2. Downloads the upgrade package to the cluster, unless already staged, and
+    verifies the pre-upgrade checks.
+ 3. Waits for the maintenance window and for the number of clusters being
+    upgraded to drop below the concurrency limit.
+ 4. Starts the upgrade and waits for it to finish, reporting the progress in
+    the provider log.
+
+When ´wait_for_completion´ is ´false´, the resource returns as soon as the
+upgrade has started. The progress of the upgrade is then reported by the
+´upgrade_status´ and ´progress´ fields when the resource is refreshed.
+
+If the upgrade fails and the cluster rolls back to the previous CDM version, the
+resource waits for the rollback to finish before reporting the failure.
+Changing the target CDM version upgrades the cluster again.
+
+-> **Note:** The maintenance window only controls when the upgrade starts. An
+   upgrade which has started is not interrupted when the maintenance window
+   ends.
+
+~> **Note:** Destroying the resource only removes it from the local state. The
+   cluster is not downgraded.
+`
+
+const (
+	// clusterUpgradeSlotWaitTime is the time to wait between checks for a
+	// free cluster upgrade slot.
+	clusterUpgradeSlotWaitTime = 1 * time.Minute
+
+	// clusterUpgradeReportTime is the time it can take RSC to report an
+	// upgrade started by the provider.
+	clusterUpgradeReportTime = 10 * time.Minute
+)
+
+// clusterUpgradeMu serializes the concurrency check and the start of cluster
+// upgrades, so that concurrently applied resources don't exceed the
+// concurrency limit. clusterUpgradesInProgress holds the clusters upgraded by
+// the provider and the time the upgrades were started, since RSC can take a
+// while to report a started upgrade.
+var (
+	clusterUpgradeMu          sync.Mutex
+	clusterUpgradesInProgress = map[uuid.UUID]time.Time{}
+)
+
+func resourceClusterUpgrade() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createClusterUpgrade,
+		ReadContext:   readClusterUpgrade,
+		UpdateContext: updateClusterUpgrade,
+		DeleteContext: deleteClusterUpgrade,
+
+		Description: description(resourceClusterUpgradeDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cluster ID (UUID).",
+			},
+			keyClusterID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Cluster ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyInstalledVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CDM version installed on the cluster.",
+			},
+			keyMaintenanceWindow: {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyDuration: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Duration of the maintenance window in hours.",
+							ValidateFunc: validation.IntBetween(1, 24),
+						},
+						keyStartTime: {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Daily start time of the maintenance window, in UTC, in 24-hour format, " +
+								"e.g. `22:00`.",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+								"must be a time of day in 24-hour format, HH:MM"),
+						},
+					},
+				},
+				Optional: true,
+				MaxItems: 1,
+				Description: "Daily maintenance window. When specified, the upgrade is only started within the " +
+					"maintenance window.",
+			},
+			keyMaxConcurrentUpgrades: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "Maximum number of clusters in the RSC account being upgraded at the same time. The " +
+					"upgrade waits until fewer clusters are being upgraded before it starts. When not specified, " +
+					"there is no limit.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			keyMessage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status message of the last upgrade operation, e.g. the reason the upgrade failed.",
+			},
+			keyPreviousVersion: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CDM version installed on the cluster before the last upgrade.",
+			},
+			keyProgress: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Overall progress of the last upgrade operation, in percent.",
+			},
+			keyUpgradeStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Upgrade status of the cluster, e.g. `UPGRADING` or `UPGRADE_FAILED`.",
+			},
+			keyUpgradeType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(gqlcluster.UpgradeTypeRolling),
+				Description: "Upgrade type. Possible values are `FAST` and `ROLLING`. A rolling upgrade upgrades " +
+					"one node at a time, keeping the cluster available during the upgrade. Default value is " +
+					"`ROLLING`.",
+				ValidateFunc: validation.StringInSlice([]string{
+					string(gqlcluster.UpgradeTypeFast), string(gqlcluster.UpgradeTypeRolling),
+				}, false),
+			},
+			keyVersion: {
+				Type:     schema.TypeString,
+				Required: true,
+				Description: "Target CDM version, e.g. `9.2.3-p2-29595`. Changing this upgrades the cluster to " +
+					"the new version.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Flag to determine if Terraform should wait for the upgrade to complete. When `false`, " +
+					"Terraform returns as soon as the upgrade has started. Default value is `true`.",
+			},
+		},
+
+		CustomizeDiff: customizeDiffClusterUpgrade,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(24 * time.Hour),
+			Update:  schema.DefaultTimeout(24 * time.Hour),
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+func createClusterUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "createClusterUpgrade")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := upgradeClusterFromResource(ctx, d, client, clusterID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(clusterID.String())
+	return readClusterUpgrade(ctx, d, m)
+}
+
+func readClusterUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "readClusterUpgrade")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	details, err := cluster.Wrap(client).ClusterUpgrade(ctx, clusterID)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var info gqlcluster.CDMInfo
+	if details.CDMInfo != nil {
+		info = *details.CDMInfo
+	}
+	if err := d.Set(keyInstalledVersion, info.Version); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyPreviousVersion, info.PreviousVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyUpgradeStatus, clusterUpgradeStatus(&info)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyProgress, info.OverallProgress); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyMessage, clusterUpgradeErrorMessage(info)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateClusterUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "updateClusterUpgrade")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	switch {
+	case d.HasChange(keyVersion):
+		if err := upgradeClusterFromResource(ctx, d, client, clusterID); err != nil {
+			return diag.FromErr(err)
+		}
+	case d.HasChange(keyUpgradeType):
+		upgradeType := gqlcluster.UpgradeType(d.Get(keyUpgradeType).(string))
+		if _, err := cluster.Wrap(client).SetUpgradeType(ctx, clusterID, upgradeType); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return readClusterUpgrade(ctx, d, m)
+}
+
+// An upgrade cannot be undone, delete simply removes the resource from the
+// local state.
+func deleteClusterUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "deleteClusterUpgrade")
+
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffClusterUpgrade verifies that the cluster can be upgraded to the
+// target CDM version, so that a failing upgrade is detected when planning.
+func customizeDiffClusterUpgrade(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffClusterUpgrade")
+
+	if diff.Id() != "" && !diff.HasChange(keyVersion) {
+		return nil
+	}
+	if !diff.NewValueKnown(keyClusterID) || !diff.NewValueKnown(keyVersion) {
+		return nil
+	}
+	clusterID, err := uuid.Parse(diff.Get(keyClusterID).(string))
+	if err != nil {
+		return err
+	}
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return err
+	}
+
+	details, err := cluster.Wrap(client).ClusterUpgrade(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if details.CDMInfo == nil {
+		return fmt.Errorf("no upgrade information available for cluster %q", clusterID)
+	}
+	version := diff.Get(keyVersion).(string)
+	if details.CDMInfo.Version == version {
+		return nil
+	}
+	if err := checkClusterUpgrade(ctx, client.GQL, clusterID, details.CDMInfo.Version, version); err != nil {
+		return fmt.Errorf("pre-upgrade check failed: %s", err)
+	}
+	if diff.Get(keyUpgradeType).(string) == string(gqlcluster.UpgradeTypeRolling) &&
+		details.CDMInfo.RUUnsupportabilityReason != "" {
+		return fmt.Errorf("cluster %q does not support rolling upgrades: %s", clusterID,
+			details.CDMInfo.RUUnsupportabilityReason)
+	}
+
+	return nil
+}
+
+// upgradeClusterFromResource upgrades the cluster to the target CDM version of
+// the resource. The upgrade is started within the maintenance window and when
+// the number of clusters being upgraded is below the concurrency limit of the
+// resource. Nothing is done if the target CDM version is already installed.
+func upgradeClusterFromResource(ctx context.Context, d *schema.ResourceData, client *polaris.Client, clusterID uuid.UUID) error {
+	version := d.Get(keyVersion).(string)
+	upgradeType := gqlcluster.UpgradeType(d.Get(keyUpgradeType).(string))
+
+	details, err := cluster.Wrap(client).ClusterUpgrade(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if details.CDMInfo != nil && details.CDMInfo.Version == version {
+		return nil
+	}
+	if err := stageClusterUpgrade(ctx, client, clusterID, version); err != nil {
+		return err
+	}
+
+	var window *maintenanceWindow
+	if block, ok := d.Get(keyMaintenanceWindow).([]any); ok && len(block) > 0 {
+		block := block[0].(map[string]any)
+		window = &maintenanceWindow{startTime: block[keyStartTime].(string), duration: block[keyDuration].(int)}
+	}
+	maxConcurrent := d.Get(keyMaxConcurrentUpgrades).(int)
+	for {
+		wait, err := window.wait(time.Now())
+		if err != nil {
+			return err
+		}
+		if wait == 0 {
+			started, err := startClusterUpgradeWithLimit(ctx, client, clusterID, upgradeType, version, maxConcurrent)
+			if err != nil {
+				return err
+			}
+			if started {
+				break
+			}
+			wait = clusterUpgradeSlotWaitTime
+		}
+
+		tflog.Info(ctx, "waiting to start cluster upgrade", map[string]any{
+			"cluster_id": clusterID.String(),
+			"version":    version,
+			"wait":       wait.String(),
+		})
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("wait to start cluster %q upgrade: %w", clusterID, ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+
+	// When not waiting for the upgrade to finish, the cluster is kept in the
+	// clusters upgraded by the provider until RSC reports the upgrade.
+	if !d.Get(keyWaitForCompletion).(bool) {
+		return nil
+	}
+	defer func() {
+		clusterUpgradeMu.Lock()
+		delete(clusterUpgradesInProgress, clusterID)
+		clusterUpgradeMu.Unlock()
+	}()
+
+	return waitForClusterUpgrade(ctx, cluster.Wrap(client), clusterID, version)
+}
+
+// startClusterUpgradeWithLimit starts the upgrade of the cluster if fewer than
+// maxConcurrent clusters are being upgraded. Returns false if the upgrade
+// wasn't started. A maxConcurrent value of 0 means there is no limit.
+func startClusterUpgradeWithLimit(ctx context.Context, client *polaris.Client, clusterID uuid.UUID, upgradeType gqlcluster.UpgradeType, version string, maxConcurrent int) (bool, error) {
+	clusterUpgradeMu.Lock()
+	defer clusterUpgradeMu.Unlock()
+
+	if maxConcurrent > 0 {
+		upgrading, err := clustersBeingUpgraded(ctx, client)
+		if err != nil {
+			return false, err
+		}
+		for id, started := range clusterUpgradesInProgress {
+			if time.Since(started) < clusterUpgradeReportTime {
+				upgrading[id] = struct{}{}
+			}
+		}
+		delete(upgrading, clusterID)
+		if len(upgrading) >= maxConcurrent {
+			return false, nil
+		}
+	}
+	if err := startClusterUpgrade(ctx, client, clusterID, upgradeType, version); err != nil {
+		return false, err
+	}
+
+	clusterUpgradesInProgress[clusterID] = time.Now()
+	return true, nil
+}
+
+// clustersBeingUpgraded returns the IDs of the clusters in the RSC account
+// which are being upgraded or rolled back.
+func clustersBeingUpgraded(ctx context.Context, client *polaris.Client) (map[uuid.UUID]struct{}, error) {
+	details, err := cluster.Wrap(client).ListClusterUpgrades(ctx, &gqlcluster.CDMInfoFilter{
+		UpgradeJobStatus: []gqlcluster.ClusterJobStatus{
+			gqlcluster.ClusterJobStatusResumingUpgrade,
+			gqlcluster.ClusterJobStatusRollingBackUpgrade,
+			gqlcluster.ClusterJobStatusUpgrading,
+		},
+	}, "", core.SortOrderAsc)
+	if err != nil {
+		return nil, err
+	}
+
+	upgrading := make(map[uuid.UUID]struct{}, len(details))
+	for _, detail := range details {
+		upgrading[detail.ID] = struct{}{}
+	}
+	return upgrading, nil
+}
+
+// maintenanceWindow holds a daily maintenance window. The start time is in
+// UTC, in 24-hour format, and the duration is in hours.
+type maintenanceWindow struct {
+	startTime string
+	duration  int
+}
+
+// wait returns the time to wait, from now, until the maintenance window opens.
+// Returns 0 if now is within the maintenance window or if the maintenance
+// window is nil.
+func (w *maintenanceWindow) wait(now time.Time) (time.Duration, error) {
+	if w == nil {
+		return 0, nil
+	}
+	startTime, err := time.Parse("15:04", w.startTime)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maintenance window start time %q: %s", w.startTime, err)
+	}
+
+	now = now.UTC()
+	start := time.Date(now.Year(), now.Month(), now.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC)
+	if start.After(now) {
+		start = start.AddDate(0, 0, -1)
+	}
+	if now.Before(start.Add(time.Duration(w.duration) * time.Hour)) {
+		return 0, nil
+	}
+	return start.AddDate(0, 0, 1).Sub(now), nil
+}
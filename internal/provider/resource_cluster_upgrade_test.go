@@ -0,0 +1,73 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowWait(t *testing.T) {
+	testCases := []struct {
+		name   string
+		window *maintenanceWindow
+		now    time.Time
+		wait   time.Duration
+	}{{
+		name: "NoWindow",
+		now:  time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+	}, {
+		name:   "BeforeWindow",
+		window: &maintenanceWindow{startTime: "22:00", duration: 4},
+		now:    time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC),
+		wait:   10 * time.Hour,
+	}, {
+		name:   "WithinWindow",
+		window: &maintenanceWindow{startTime: "22:00", duration: 4},
+		now:    time.Date(2026, 10, 18, 23, 30, 0, 0, time.UTC),
+	}, {
+		name:   "WithinWindowAfterMidnight",
+		window: &maintenanceWindow{startTime: "22:00", duration: 4},
+		now:    time.Date(2026, 10, 19, 1, 59, 0, 0, time.UTC),
+	}, {
+		name:   "AfterWindow",
+		window: &maintenanceWindow{startTime: "22:00", duration: 4},
+		now:    time.Date(2026, 10, 19, 2, 0, 0, 0, time.UTC),
+		wait:   20 * time.Hour,
+	}, {
+		name:   "NonUTC",
+		window: &maintenanceWindow{startTime: "02:30", duration: 1},
+		now:    time.Date(2026, 10, 18, 2, 0, 0, 0, time.FixedZone("CET", 3600)),
+		wait:   90 * time.Minute,
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			wait, err := testCase.window.wait(testCase.now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if wait != testCase.wait {
+				t.Fatalf("expected wait %s, got %s", testCase.wait, wait)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlarchival "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/gcp"
+)
+
+// Data center archival location target types.
+const (
+	targetTypeAzure        = "AZURE"
+	targetTypeGCP          = "GCP"
+	targetTypeNFS          = "NFS"
+	targetTypeS3Compatible = "S3_COMPATIBLE"
+)
+
+// dataCenterTargetsQuery is the GraphQL query used to look up data center
+// archival locations which aren't supported by the SDK.
+const dataCenterTargetsQuery = `query SdkGolangDataCenterTargets($filter: [TargetFilterInput!]) {
+    result: targets(sortBy: NAME, sortOrder: ASC, filter: $filter) {
+        nodes {
+            id
+            cluster {
+                id
+            }
+            name
+            targetType
+            status
+            ... on RubrikManagedAzureTarget {
+                cloudAccount {
+                    cloudAccountId
+                }
+                syncStatus
+                syncFailureReason
+                storageAccountName
+                containerName
+                instanceType
+                isConsolidationEnabled
+                proxySettings {
+                    portNumber
+                    protocol
+                    proxyServer
+                    username
+                }
+                bypassProxy
+                immutabilitySettings {
+                    lockDurationDays
+                }
+            }
+            ... on RubrikManagedGcpTarget {
+                syncStatus
+                syncFailureReason
+                bucket
+                region
+                storageClass
+                proxySettings {
+                    portNumber
+                    protocol
+                    proxyServer
+                    username
+                }
+                bypassProxy
+            }
+            ... on RubrikManagedS3CompatibleTarget {
+                syncStatus
+                syncFailureReason
+                endpoint
+                bucketPrefix
+                numberOfBuckets
+                isConsolidationEnabled
+                proxySettings {
+                    portNumber
+                    protocol
+                    proxyServer
+                    username
+                }
+                bypassProxy
+                immutabilitySettings {
+                    lockDurationDays
+                }
+            }
+            ... on RubrikManagedNfsTarget {
+                syncStatus
+                syncFailureReason
+                host
+                exportDir
+                authType
+                nfsVersion
+                nfsAsyncWrite
+                otherNfsOptions
+                fileLockPeriodInSeconds
+                isConsolidationEnabled
+            }
+        }
+    }
+}`
+
+// dataCenterProxySettings holds the proxy settings of a data center archival
+// location.
+type dataCenterProxySettings struct {
+	PortNumber  int    `json:"portNumber"`
+	Protocol    string `json:"protocol"`
+	ProxyServer string `json:"proxyServer"`
+	Username    string `json:"username"`
+}
+
+// dataCenterImmutabilitySettings holds the immutability settings of a data
+// center archival location.
+type dataCenterImmutabilitySettings struct {
+	LockDurationDays int `json:"lockDurationDays"`
+}
+
+// dataCenterTarget holds a data center archival location with the Azure, GCP,
+// S3 compatible or NFS storage type. Only the fields of the storage type of the
+// archival location are set.
+type dataCenterTarget struct {
+	ID      uuid.UUID `json:"id"`
+	Cluster struct {
+		ID uuid.UUID `json:"id"`
+	} `json:"cluster"`
+	Name              string `json:"name"`
+	TargetType        string `json:"targetType"`
+	Status            string `json:"status"`
+	SyncStatus        string `json:"syncStatus"`
+	SyncFailureReason string `json:"syncFailureReason"`
+
+	// Azure.
+	CloudAccount *struct {
+		ID uuid.UUID `json:"cloudAccountId"`
+	} `json:"cloudAccount"`
+	StorageAccountName string `json:"storageAccountName"`
+	ContainerName      string `json:"containerName"`
+	InstanceType       string `json:"instanceType"`
+
+	// GCP.
+	Bucket       string         `json:"bucket"`
+	Region       gcp.RegionEnum `json:"region"`
+	StorageClass string         `json:"storageClass"`
+
+	// S3 compatible.
+	Endpoint        string `json:"endpoint"`
+	BucketPrefix    string `json:"bucketPrefix"`
+	NumberOfBuckets int    `json:"numberOfBuckets"`
+
+	// NFS.
+	Host                    string `json:"host"`
+	ExportDir               string `json:"exportDir"`
+	AuthType                string `json:"authType"`
+	NFSVersion              string `json:"nfsVersion"`
+	NFSAsyncWrite           bool   `json:"nfsAsyncWrite"`
+	OtherNFSOptions         string `json:"otherNfsOptions"`
+	FileLockPeriodInSeconds int    `json:"fileLockPeriodInSeconds"`
+
+	IsConsolidationEnabled bool                            `json:"isConsolidationEnabled"`
+	ProxySettings          *dataCenterProxySettings        `json:"proxySettings"`
+	BypassProxy            bool                            `json:"bypassProxy"`
+	ImmutabilitySettings   *dataCenterImmutabilitySettings `json:"immutabilitySettings"`
+}
+
+// dataCenterTargetByID returns the data center archival location with the
+// specified ID and target type. If no archival location is found,
+// graphql.ErrNotFound is returned.
+func dataCenterTargetByID(ctx context.Context, gql *graphql.Client, targetID uuid.UUID, targetType string) (dataCenterTarget, error) {
+	var result struct {
+		Nodes []dataCenterTarget `json:"nodes"`
+	}
+	err := gqlRequest(ctx, gql, dataCenterTargetsQuery, struct {
+		Filter []gqlarchival.ListTargetFilter `json:"filter"`
+	}{Filter: []gqlarchival.ListTargetFilter{{Field: "LOCATION_ID", Text: targetID.String()}}}, &result)
+	if err != nil {
+		return dataCenterTarget{}, err
+	}
+
+	for _, target := range result.Nodes {
+		if target.ID == targetID && target.TargetType == targetType {
+			return target, nil
+		}
+	}
+
+	return dataCenterTarget{}, fmt.Errorf("target for %q %w", targetID, graphql.ErrNotFound)
+}
+
+// createDataCenterTarget creates a data center archival location using the
+// specified GraphQL mutation and waits for the archival location to
+// synchronize with the cluster. Returns the ID of the archival location.
+func createDataCenterTarget(ctx context.Context, gql *graphql.Client, query string, params any, targetType string) (uuid.UUID, error) {
+	var result struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err := gqlRequest(ctx, gql, query, params, &result); err != nil {
+		return uuid.Nil, err
+	}
+
+	if err := waitForDataCenterTargetSync(ctx, gql, result.ID, targetType); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to wait for target to sync: %s", err)
+	}
+
+	return result.ID, nil
+}
+
+// updateDataCenterTarget updates a data center archival location using the
+// specified GraphQL mutation and waits for the archival location to
+// synchronize with the cluster.
+func updateDataCenterTarget(ctx context.Context, gql *graphql.Client, targetID uuid.UUID, query string, params any, targetType string) error {
+	var result struct {
+		ID uuid.UUID `json:"id"`
+	}
+	if err := gqlRequest(ctx, gql, query, params, &result); err != nil {
+		return err
+	}
+
+	if err := waitForDataCenterTargetSync(ctx, gql, targetID, targetType); err != nil {
+		return fmt.Errorf("failed to wait for target to sync: %s", err)
+	}
+
+	return nil
+}
+
+// waitForDataCenterTargetSync waits for the data center archival location to
+// synchronize with the cluster.
+func waitForDataCenterTargetSync(ctx context.Context, gql *graphql.Client, targetID uuid.UUID, targetType string) error {
+	for {
+		target, err := dataCenterTargetByID(ctx, gql, targetID, targetType)
+		if err != nil {
+			return fmt.Errorf("failed to get target: %s", err)
+		}
+		if target.SyncStatus == gqlarchival.TargetSynced {
+			return nil
+		}
+		if target.SyncStatus == gqlarchival.TargetSyncActionFailed {
+			return errors.New(target.SyncFailureReason)
+		}
+
+		select {
+		case <-time.After(10 * time.Second):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// dataCenterArchivalProxySettingsSchema returns the schema for the archival
+// proxy settings block of a data center archival location.
+func dataCenterArchivalProxySettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyBypassProxy: {
+					Type:         schema.TypeBool,
+					Optional:     true,
+					ExactlyOneOf: []string{keyArchivalProxySettings + ".0." + keyProxyServer},
+					Description: "When true, the system proxy will not be used to route the archival " +
+						"requests and data.",
+				},
+				keyPassword: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Sensitive:     true,
+					ConflictsWith: []string{keyArchivalProxySettings + ".0." + keyBypassProxy},
+					RequiredWith:  []string{keyArchivalProxySettings + ".0." + keyUsername},
+					Description:   "Proxy password.",
+					ValidateFunc:  validation.StringIsNotWhiteSpace,
+				},
+				keyPortNumber: {
+					Type:     schema.TypeInt,
+					Optional: true,
+					RequiredWith: []string{
+						keyArchivalProxySettings + ".0." + keyProtocol,
+						keyArchivalProxySettings + ".0." + keyProxyServer,
+					},
+					Description:  "Proxy port number.",
+					ValidateFunc: validation.IsPortNumber,
+				},
+				keyProtocol: {
+					Type:     schema.TypeString,
+					Optional: true,
+					RequiredWith: []string{
+						keyArchivalProxySettings + ".0." + keyPortNumber,
+						keyArchivalProxySettings + ".0." + keyProxyServer,
+					},
+					Description:  "Proxy protocol. Possible values are `HTTP`, `HTTPS` and `SOCKS5`.",
+					ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "SOCKS5"}, false),
+				},
+				keyProxyServer: {
+					Type:     schema.TypeString,
+					Optional: true,
+					ExactlyOneOf: []string{
+						keyArchivalProxySettings + ".0." + keyBypassProxy,
+					},
+					RequiredWith: []string{
+						keyArchivalProxySettings + ".0." + keyPortNumber,
+						keyArchivalProxySettings + ".0." + keyProtocol,
+					},
+					Description:  "Proxy server IP address or FQDN.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				keyUsername: {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "Proxy username.",
+					ConflictsWith: []string{keyArchivalProxySettings + ".0." + keyBypassProxy},
+					RequiredWith:  []string{keyArchivalProxySettings + ".0." + keyPassword},
+					ValidateFunc:  validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+		MaxItems:    1,
+		Optional:    true,
+		Description: "Archival proxy settings will be used to route the archival data and requests.",
+	}
+}
+
+// dataCenterImmutabilitySettingsSchema returns the schema for the immutability
+// settings block of a data center archival location. The immutability
+// settings block conflicts with the specified fields.
+func dataCenterImmutabilitySettingsSchema(description string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyLockPeriod: {
+					Type:        schema.TypeInt,
+					Required:    true,
+					Description: "Immutability lock period (days).",
+				},
+			},
+		},
+		MaxItems:      1,
+		Optional:      true,
+		ConflictsWith: conflictsWith,
+		Description:   description,
+	}
+}
+
+// dataCenterEncryptionPasswordSchema returns the schema for the encryption
+// password field of a data center archival location. When forceNew is true,
+// changing the encryption password forces a new resource. Exactly one of the
+// encryption password and the specified fields must be given. If no fields are
+// specified, the encryption password is optional.
+func dataCenterEncryptionPasswordSchema(forceNew bool, description string, exactlyOneOf ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		Sensitive:    true,
+		ExactlyOneOf: exactlyOneOf,
+		Description:  description,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
+
+// dataCenterRSAKeySchema returns the schema for the RSA key field of a data
+// center archival location. When forceNew is true, changing the RSA key forces
+// a new resource. Exactly one of the RSA key and the specified fields must be
+// given.
+func dataCenterRSAKeySchema(forceNew bool, description string, exactlyOneOf ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		Sensitive:    true,
+		ExactlyOneOf: exactlyOneOf,
+		Description:  description,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+}
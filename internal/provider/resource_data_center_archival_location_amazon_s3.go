@@ -62,73 +62,7 @@ func resourceDataCenterArchivalLocationAmazonS3() *schema.Resource {
 				Computed:    true,
 				Description: "Data center archival location ID (UUID).",
 			},
-			keyArchivalProxySettings: {
-				Type: schema.TypeList,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						keyBypassProxy: {
-							Type:         schema.TypeBool,
-							Optional:     true,
-							ExactlyOneOf: []string{keyArchivalProxySettings + ".0." + keyProxyServer},
-							Description: "When true, the system proxy will not be used to route the archival " +
-								"requests and data.",
-						},
-						keyPassword: {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Sensitive:     true,
-							ConflictsWith: []string{keyArchivalProxySettings + ".0." + keyBypassProxy},
-							RequiredWith:  []string{keyArchivalProxySettings + ".0." + keyUsername},
-							Description:   "Proxy password.",
-							ValidateFunc:  validation.StringIsNotWhiteSpace,
-						},
-						keyPortNumber: {
-							Type:     schema.TypeInt,
-							Optional: true,
-							RequiredWith: []string{
-								keyArchivalProxySettings + ".0." + keyProtocol,
-								keyArchivalProxySettings + ".0." + keyProxyServer,
-							},
-							Description:  "Proxy port number.",
-							ValidateFunc: validation.IsPortNumber,
-						},
-						keyProtocol: {
-							Type:     schema.TypeString,
-							Optional: true,
-							RequiredWith: []string{
-								keyArchivalProxySettings + ".0." + keyPortNumber,
-								keyArchivalProxySettings + ".0." + keyProxyServer,
-							},
-							Description:  "Proxy protocol. Possible values are `HTTP`, `HTTPS` and `SOCKS5`.",
-							ValidateFunc: validation.StringInSlice([]string{"HTTP", "HTTPS", "SOCKS5"}, false),
-						},
-						keyProxyServer: {
-							Type:     schema.TypeString,
-							Optional: true,
-							ExactlyOneOf: []string{
-								keyArchivalProxySettings + ".0." + keyBypassProxy,
-							},
-							RequiredWith: []string{
-								keyArchivalProxySettings + ".0." + keyPortNumber,
-								keyArchivalProxySettings + ".0." + keyProtocol,
-							},
-							Description:  "Proxy server IP address or FQDN.",
-							ValidateFunc: validation.StringIsNotWhiteSpace,
-						},
-						keyUsername: {
-							Type:          schema.TypeString,
-							Optional:      true,
-							Description:   "Proxy username.",
-							ConflictsWith: []string{keyArchivalProxySettings + ".0." + keyBypassProxy},
-							RequiredWith:  []string{keyArchivalProxySettings + ".0." + keyPassword},
-							ValidateFunc:  validation.StringIsNotWhiteSpace,
-						},
-					},
-				},
-				MaxItems:    1,
-				Optional:    true,
-				Description: "Archival proxy settings will be used to route the archival data and requests.",
-			},
+			keyArchivalProxySettings: dataCenterArchivalProxySettingsSchema(),
 			keyBucketName: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -224,15 +158,8 @@ func resourceDataCenterArchivalLocationAmazonS3() *schema.Resource {
 				Description: "Compute proxy settings will be used to make API calls for instantiating virtual " +
 					"machines.",
 			},
-			keyEncryptionPassword: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				ExactlyOneOf: []string{keyKMSMasterKey, keyRSAKey},
-				Description: "Encryption password. Password encryption is available only for immutable archival " +
-					"locations.",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			keyEncryptionPassword: dataCenterEncryptionPasswordSchema(false, "Encryption password. Password encryption is "+
+				"available only for immutable archival locations.", keyKMSMasterKey, keyRSAKey),
 			keyEndpointSettings: {
 				Type: schema.TypeList,
 				Elem: &schema.Resource{
@@ -259,25 +186,10 @@ func resourceDataCenterArchivalLocationAmazonS3() *schema.Resource {
 					"AWS S3 to leverage the AWS PrivateLink feature. The default region-based endpoint will be used " +
 					"if no endpoint is specified.",
 			},
-			keyImmutabilitySettings: {
-				Type: schema.TypeList,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						keyLockPeriod: {
-							Type:        schema.TypeInt,
-							Required:    true,
-							Description: "Immutability lock period (days).",
-						},
-					},
-				},
-				MaxItems:      1,
-				Optional:      true,
-				ConflictsWith: []string{keyKMSMasterKey, keyRSAKey},
-				Description: "Enables immutable storage with a time-based retention lock using the AWS immutability " +
-					"feature for your archival location. Once enabled, you cannot delete the snapshots in this " +
-					"archival location before the specified immutability lock period expires. Requires an encryption " +
-					"password policy.",
-			},
+			keyImmutabilitySettings: dataCenterImmutabilitySettingsSchema("Enables immutable storage with a "+
+				"time-based retention lock using the AWS immutability feature for your archival location. Once "+
+				"enabled, you cannot delete the snapshots in this archival location before the specified immutability "+
+				"lock period expires. Requires an encryption password policy.", keyKMSMasterKey, keyRSAKey),
 			keyKMSMasterKey: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -306,14 +218,8 @@ func resourceDataCenterArchivalLocationAmazonS3() *schema.Resource {
 					"`EXPEDITED_TIER` and `STANDARD_TIER`. Default value is `STANDARD_TIER`.",
 				ValidateFunc: validation.StringInSlice([]string{"BULK_TIER", "EXPEDITED_TIER", "STANDARD_TIER"}, false),
 			},
-			keyRSAKey: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				ExactlyOneOf: []string{keyEncryptionPassword, keyKMSMasterKey},
-				Description:  "PEM encoded private RSA key. Cannot be used with immutable archival locations.",
-				ValidateFunc: validation.StringIsNotWhiteSpace,
-			},
+			keyRSAKey: dataCenterRSAKeySchema(false, "PEM encoded private RSA key. Cannot be used with immutable archival "+
+				"locations.", keyEncryptionPassword, keyKMSMasterKey),
 			keyStorageClass: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -435,7 +341,7 @@ func dataCenterReadArchivalLocationAmazonS3(ctx context.Context, d *schema.Resou
 	if err := d.Set(keyKMSMasterKey, target.KMSMasterKeyID); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set(keyArchivalProxySettings, toArchivalProxySettings(target)); err != nil {
+	if err := d.Set(keyArchivalProxySettings, toArchivalProxySettings((*dataCenterProxySettings)(target.ProxySettings), target.BypassProxy)); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set(keyCloudComputeSettings, toCloudComputeSettings(target)); err != nil {
@@ -447,7 +353,7 @@ func dataCenterReadArchivalLocationAmazonS3(ctx context.Context, d *schema.Resou
 	if err := d.Set(keyEndpointSettings, toEndpointSettings(target)); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set(keyImmutabilitySettings, toImmutabilitySettings(target)); err != nil {
+	if err := d.Set(keyImmutabilitySettings, toImmutabilitySettings((*dataCenterImmutabilitySettings)(target.ImmutabilitySettings))); err != nil {
 		return diag.FromErr(err)
 	}
 
@@ -535,20 +441,20 @@ func fromArchivalProxySettings(d *schema.ResourceData) (*gqlarchival.AWSTargetPr
 	}, settings[keyBypassProxy].(bool)
 }
 
-// toArchivalProxySettings extracts the archival proxy settings data from the
-// target.
-func toArchivalProxySettings(target gqlarchival.AWSTarget) []any {
-	if target.ProxySettings == nil {
+// toArchivalProxySettings converts the archival proxy settings of a target to
+// the archival proxy settings data.
+func toArchivalProxySettings(proxySettings *dataCenterProxySettings, bypassProxy bool) []any {
+	if proxySettings == nil {
 		return nil
 	}
 
 	return []any{
 		map[string]any{
-			keyPortNumber:  target.ProxySettings.PortNumber,
-			keyProtocol:    target.ProxySettings.Protocol,
-			keyProxyServer: target.ProxySettings.ProxyServer,
-			keyUsername:    target.ProxySettings.Username,
-			keyBypassProxy: target.BypassProxy,
+			keyPortNumber:  proxySettings.PortNumber,
+			keyProtocol:    proxySettings.Protocol,
+			keyProxyServer: proxySettings.ProxyServer,
+			keyUsername:    proxySettings.Username,
+			keyBypassProxy: bypassProxy,
 		},
 	}
 }
@@ -661,16 +567,16 @@ func fromImmutabilitySettings(d *schema.ResourceData) *gqlarchival.AWSTargetImmu
 	}
 }
 
-// toImmutabilitySettings extracts the immutability settings data from the
-// target.
-func toImmutabilitySettings(target gqlarchival.AWSTarget) []any {
-	if target.ImmutabilitySettings == nil {
+// toImmutabilitySettings converts the immutability settings of a target to the
+// immutability settings data.
+func toImmutabilitySettings(immutabilitySettings *dataCenterImmutabilitySettings) []any {
+	if immutabilitySettings == nil {
 		return nil
 	}
 
 	return []any{
 		map[string]any{
-			keyLockPeriod: target.ImmutabilitySettings.LockDurationDays,
+			keyLockPeriod: immutabilitySettings.LockDurationDays,
 		},
 	}
 }
@@ -0,0 +1,339 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlarchival "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+)
+
+const resourceDataCenterArchivalLocationAzureBlobDescription = `
+The ´polaris_data_center_archival_location_azure_blob´ resource creates a data
+center archival location with the Azure Blob storage type. The Azure storage
+account and container must exist before the archival location is created.
+
+~> Before configuring the immutability settings, see
+   [KB article](https://support.rubrik.com/s/article/000005468) or the Rubrik
+   User Guide documentation to determine the proper immutability lock period.
+
+-> More information about Azure immutable blob storage and time-based retention
+   policies can be found in the Microsoft Azure documentation.
+`
+
+// createAzureTargetQuery is the GraphQL mutation used to create an Azure data
+// center archival location.
+const createAzureTargetQuery = `mutation SdkGolangCreateAzureTarget($input: CreateAzureTargetInput!) {
+    result: createAzureTarget(input: $input) {
+        id
+    }
+}`
+
+// updateAzureTargetQuery is the GraphQL mutation used to update an Azure data
+// center archival location.
+const updateAzureTargetQuery = `mutation SdkGolangUpdateAzureTarget($input: UpdateAzureTargetInput!) {
+    result: updateAzureTarget(input: $input) {
+        id
+    }
+}`
+
+// azureTargetParams holds the parameters for an Azure data center archival
+// location create and update operation. The update operation doesn't support
+// changing the cluster, the cloud account, the storage account, the container
+// or the encryption.
+type azureTargetParams struct {
+	ID                     uuid.UUID                                  `json:"id,omitzero"`
+	Name                   string                                     `json:"name"`
+	ClusterID              uuid.UUID                                  `json:"clusterUuid,omitzero"`
+	CloudAccountID         uuid.UUID                                  `json:"cloudAccountId,omitzero"`
+	StorageAccountName     string                                     `json:"storageAccountName,omitempty"`
+	ContainerName          string                                     `json:"containerName,omitempty"`
+	AccessKey              secret.String                              `json:"accessKey"`
+	InstanceType           string                                     `json:"instanceType,omitempty"`
+	RSAKey                 secret.String                              `json:"rsaKey,omitempty"`
+	EncryptionPassword     secret.String                              `json:"encryptionPassword,omitempty"`
+	IsConsolidationEnabled bool                                       `json:"isConsolidationEnabled"`
+	ProxySettings          *gqlarchival.AWSTargetProxySettings        `json:"proxySettings,omitempty"`
+	BypassProxy            bool                                       `json:"bypassProxy"`
+	ImmutabilitySettings   *gqlarchival.AWSTargetImmutabilitySettings `json:"immutabilitySettings,omitempty"`
+}
+
+func resourceDataCenterArchivalLocationAzureBlob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: dataCenterCreateArchivalLocationAzureBlob,
+		ReadContext:   dataCenterReadArchivalLocationAzureBlob,
+		UpdateContext: dataCenterUpdateArchivalLocationAzureBlob,
+		DeleteContext: dataCenterDeleteArchivalLocationAzureBlob,
+
+		Description: description(resourceDataCenterArchivalLocationAzureBlobDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data center archival location ID (UUID).",
+			},
+			keyAccessKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Azure storage account access key.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyArchivalConsolidation: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, archival consolidation is enabled. Archival consolidation frees up " +
+					"storage. Default value is `false`.",
+			},
+			keyArchivalProxySettings: dataCenterArchivalProxySettingsSchema(),
+			keyCloudAccountID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "RSC data center cloud account ID (UUID). Changing this forces a new resource to be " +
+					"created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyClusterID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Rubrik cluster ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyContainerName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Azure container name. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			keyEncryptionPassword: dataCenterEncryptionPasswordSchema(true, "Encryption password. Changing this forces a "+
+				"new resource to be created.", keyRSAKey),
+			keyImmutabilitySettings: dataCenterImmutabilitySettingsSchema("Enables immutable storage with a "+
+				"time-based retention policy using the Azure immutable blob storage feature for your archival "+
+				"location. Once enabled, you cannot delete the snapshots in this archival location before the "+
+				"specified immutability lock period expires. Requires an encryption password policy.", keyRSAKey),
+			keyInstanceType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "AZURE_COMMERCIAL",
+				Description: "Azure cloud instance type. Possible values are `AZURE_CHINA`, `AZURE_COMMERCIAL` and " +
+					"`AZURE_GOV`. Default value is `AZURE_COMMERCIAL`. Changing this forces a new resource to be " +
+					"created.",
+				ValidateFunc: validation.StringInSlice([]string{"AZURE_CHINA", "AZURE_COMMERCIAL", "AZURE_GOV"}, false),
+			},
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Data center archival location name.",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			keyRSAKey: dataCenterRSAKeySchema(true, "PEM encoded private RSA key. Cannot be used with immutable archival "+
+				"locations. Changing this forces a new resource to be created.", keyEncryptionPassword),
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of data center archival location.",
+			},
+			keyStorageAccountName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Azure storage account name. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringLenBetween(3, 24),
+			},
+			keySyncStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Synchronization status of Azure target.",
+			},
+		},
+	}
+}
+
+func dataCenterCreateArchivalLocationAzureBlob(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterCreateArchivalLocationAzureBlob")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountID, err := uuid.Parse(d.Get(keyCloudAccountID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	id, err := createDataCenterTarget(ctx, client.GQL, createAzureTargetQuery, struct {
+		Input azureTargetParams `json:"input"`
+	}{Input: azureTargetParams{
+		Name:                   d.Get(keyName).(string),
+		ClusterID:              clusterID,
+		CloudAccountID:         cloudAccountID,
+		StorageAccountName:     d.Get(keyStorageAccountName).(string),
+		ContainerName:          d.Get(keyContainerName).(string),
+		AccessKey:              secret.String(d.Get(keyAccessKey).(string)),
+		InstanceType:           d.Get(keyInstanceType).(string),
+		RSAKey:                 secret.String(d.Get(keyRSAKey).(string)),
+		EncryptionPassword:     secret.String(d.Get(keyEncryptionPassword).(string)),
+		IsConsolidationEnabled: d.Get(keyArchivalConsolidation).(bool),
+		ProxySettings:          archivalProxySettings,
+		BypassProxy:            bypassProxy,
+		ImmutabilitySettings:   fromImmutabilitySettings(d),
+	}}, targetTypeAzure)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	dataCenterReadArchivalLocationAzureBlob(ctx, d, m)
+	return nil
+}
+
+func dataCenterReadArchivalLocationAzureBlob(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterReadArchivalLocationAzureBlob")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target, err := dataCenterTargetByID(ctx, client.GQL, id, targetTypeAzure)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyName, target.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyClusterID, target.Cluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, target.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySyncStatus, target.SyncStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if target.CloudAccount != nil {
+		if err := d.Set(keyCloudAccountID, target.CloudAccount.ID.String()); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set(keyStorageAccountName, target.StorageAccountName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyContainerName, target.ContainerName); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyInstanceType, target.InstanceType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalConsolidation, target.IsConsolidationEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalProxySettings, toArchivalProxySettings(target.ProxySettings, target.BypassProxy)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyImmutabilitySettings, toImmutabilitySettings(target.ImmutabilitySettings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterUpdateArchivalLocationAzureBlob(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterUpdateArchivalLocationAzureBlob")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	err = updateDataCenterTarget(ctx, client.GQL, id, updateAzureTargetQuery, struct {
+		Input azureTargetParams `json:"input"`
+	}{Input: azureTargetParams{
+		ID:                     id,
+		Name:                   d.Get(keyName).(string),
+		AccessKey:              secret.String(d.Get(keyAccessKey).(string)),
+		IsConsolidationEnabled: d.Get(keyArchivalConsolidation).(bool),
+		ProxySettings:          archivalProxySettings,
+		BypassProxy:            bypassProxy,
+		ImmutabilitySettings:   fromImmutabilitySettings(d),
+	}}, targetTypeAzure)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterDeleteArchivalLocationAzureBlob(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterDeleteArchivalLocationAzureBlob")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := archival.Wrap(client).DeleteTarget(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
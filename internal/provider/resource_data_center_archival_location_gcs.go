@@ -0,0 +1,291 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlarchival "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/gcp"
+)
+
+const resourceDataCenterArchivalLocationGCSDescription = `
+The ´polaris_data_center_archival_location_gcs´ resource creates a data center
+archival location with the Google Cloud Storage storage type. The GCS bucket
+must exist before the archival location is created. The GCP service account
+used to access the bucket needs the ´Storage Admin´ role for the bucket.
+`
+
+// createGCPTargetQuery is the GraphQL mutation used to create a GCP data
+// center archival location.
+const createGCPTargetQuery = `mutation SdkGolangCreateGcpTarget($input: CreateGcpTargetInput!) {
+    result: createGcpTarget(input: $input) {
+        id
+    }
+}`
+
+// updateGCPTargetQuery is the GraphQL mutation used to update a GCP data
+// center archival location.
+const updateGCPTargetQuery = `mutation SdkGolangUpdateGcpTarget($input: UpdateGcpTargetInput!) {
+    result: updateGcpTarget(input: $input) {
+        id
+    }
+}`
+
+// gcpTargetParams holds the parameters for a GCP data center archival location
+// create and update operation. The update operation doesn't support changing
+// the cluster, the bucket, the region or the encryption.
+type gcpTargetParams struct {
+	ID                    uuid.UUID                           `json:"id,omitzero"`
+	Name                  string                              `json:"name"`
+	ClusterID             uuid.UUID                           `json:"clusterUuid,omitzero"`
+	Bucket                string                              `json:"bucket,omitempty"`
+	Region                *gcp.RegionEnum                     `json:"region,omitempty"`
+	StorageClass          string                              `json:"storageClass"`
+	ServiceAccountJSONKey secret.String                       `json:"serviceAccountJsonKey"`
+	RSAKey                secret.String                       `json:"rsaKey,omitempty"`
+	EncryptionPassword    secret.String                       `json:"encryptionPassword,omitempty"`
+	ProxySettings         *gqlarchival.AWSTargetProxySettings `json:"proxySettings,omitempty"`
+	BypassProxy           bool                                `json:"bypassProxy"`
+}
+
+func resourceDataCenterArchivalLocationGCS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: dataCenterCreateArchivalLocationGCS,
+		ReadContext:   dataCenterReadArchivalLocationGCS,
+		UpdateContext: dataCenterUpdateArchivalLocationGCS,
+		DeleteContext: dataCenterDeleteArchivalLocationGCS,
+
+		Description: description(resourceDataCenterArchivalLocationGCSDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data center archival location ID (UUID).",
+			},
+			keyArchivalProxySettings: dataCenterArchivalProxySettingsSchema(),
+			keyBucketName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "GCS bucket name. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringLenBetween(3, 63),
+			},
+			keyClusterID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Rubrik cluster ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyCredentials: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "GCP service account key in JSON format.",
+				ValidateFunc: validation.StringIsJSON,
+			},
+			keyEncryptionPassword: dataCenterEncryptionPasswordSchema(true, "Encryption password. Changing this "+
+				"forces a new resource to be created.", keyRSAKey),
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Data center archival location name.",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			keyRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "GCP region of the bucket. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice(gcp.AllRegionNames(), false),
+			},
+			keyRSAKey: dataCenterRSAKeySchema(true, "PEM encoded private RSA key. Changing this forces a new "+
+				"resource to be created.", keyEncryptionPassword),
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of data center archival location.",
+			},
+			keyStorageClass: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "STANDARD",
+				Description: "GCS bucket storage class. Possible values are `ARCHIVE`, `COLDLINE`, `NEARLINE`, " +
+					"`STANDARD` and `DURABLE_REDUCED_AVAILABILITY`. Default value is `STANDARD`.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"ARCHIVE", "COLDLINE", "NEARLINE", "STANDARD", "DURABLE_REDUCED_AVAILABILITY",
+				}, false),
+			},
+			keySyncStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Synchronization status of GCP target.",
+			},
+		},
+	}
+}
+
+func dataCenterCreateArchivalLocationGCS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterCreateArchivalLocationGCS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	id, err := createDataCenterTarget(ctx, client.GQL, createGCPTargetQuery, struct {
+		Input gcpTargetParams `json:"input"`
+	}{Input: gcpTargetParams{
+		Name:                  d.Get(keyName).(string),
+		ClusterID:             clusterID,
+		Bucket:                d.Get(keyBucketName).(string),
+		Region:                gcp.RegionFromName(d.Get(keyRegion).(string)).ToRegionEnumPtr(),
+		StorageClass:          toGCPStorageClass(d.Get(keyStorageClass).(string)),
+		ServiceAccountJSONKey: secret.String(d.Get(keyCredentials).(string)),
+		RSAKey:                secret.String(d.Get(keyRSAKey).(string)),
+		EncryptionPassword:    secret.String(d.Get(keyEncryptionPassword).(string)),
+		ProxySettings:         archivalProxySettings,
+		BypassProxy:           bypassProxy,
+	}}, targetTypeGCP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	dataCenterReadArchivalLocationGCS(ctx, d, m)
+	return nil
+}
+
+func dataCenterReadArchivalLocationGCS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterReadArchivalLocationGCS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target, err := dataCenterTargetByID(ctx, client.GQL, id, targetTypeGCP)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyName, target.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyClusterID, target.Cluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, target.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySyncStatus, target.SyncStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyBucketName, target.Bucket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyRegion, target.Region.Name()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStorageClass, fromGCPStorageClass(target.StorageClass)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalProxySettings, toArchivalProxySettings(target.ProxySettings, target.BypassProxy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterUpdateArchivalLocationGCS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterUpdateArchivalLocationGCS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	err = updateDataCenterTarget(ctx, client.GQL, id, updateGCPTargetQuery, struct {
+		Input gcpTargetParams `json:"input"`
+	}{Input: gcpTargetParams{
+		ID:                    id,
+		Name:                  d.Get(keyName).(string),
+		StorageClass:          toGCPStorageClass(d.Get(keyStorageClass).(string)),
+		ServiceAccountJSONKey: secret.String(d.Get(keyCredentials).(string)),
+		ProxySettings:         archivalProxySettings,
+		BypassProxy:           bypassProxy,
+	}}, targetTypeGCP)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterDeleteArchivalLocationGCS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterDeleteArchivalLocationGCS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := archival.Wrap(client).DeleteTarget(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
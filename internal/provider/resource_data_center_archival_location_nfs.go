@@ -0,0 +1,353 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+)
+
+const resourceDataCenterArchivalLocationNFSDescription = `
+The ´polaris_data_center_archival_location_nfs´ resource creates a data center
+archival location with the NFS storage type. The NFS export must exist and be
+accessible from the Rubrik cluster before the archival location is created.
+
+The immutability settings of an NFS archival location use NFS file locking to
+prevent the archived snapshots from being deleted or modified before the lock
+period expires. File locking requires that the NFS server supports it.
+
+~> Before configuring the immutability settings, see
+   [KB article](https://support.rubrik.com/s/article/000005468) or the Rubrik
+   User Guide documentation to determine the proper immutability lock period.
+`
+
+// createNFSTargetQuery is the GraphQL mutation used to create an NFS data
+// center archival location.
+const createNFSTargetQuery = `mutation SdkGolangCreateNfsTarget($input: CreateNfsTargetInput!) {
+    result: createNfsTarget(input: $input) {
+        id
+    }
+}`
+
+// updateNFSTargetQuery is the GraphQL mutation used to update an NFS data
+// center archival location.
+const updateNFSTargetQuery = `mutation SdkGolangUpdateNfsTarget($input: UpdateNfsTargetInput!) {
+    result: updateNfsTarget(input: $input) {
+        id
+    }
+}`
+
+// nfsTargetParams holds the parameters for an NFS data center archival
+// location create and update operation. The update operation doesn't support
+// changing the cluster, the host, the export directory or the encryption.
+type nfsTargetParams struct {
+	ID                      uuid.UUID     `json:"id,omitzero"`
+	Name                    string        `json:"name"`
+	ClusterID               uuid.UUID     `json:"clusterUuid,omitzero"`
+	Host                    string        `json:"host,omitempty"`
+	ExportDir               string        `json:"exportDir,omitempty"`
+	AuthType                string        `json:"authType"`
+	NFSVersion              string        `json:"nfsVersion"`
+	NFSAsyncWrite           bool          `json:"nfsAsyncWrite"`
+	OtherNFSOptions         string        `json:"otherNfsOptions"`
+	EncryptionPassword      secret.String `json:"encryptionPassword,omitempty"`
+	IsConsolidationEnabled  bool          `json:"isConsolidationEnabled"`
+	FileLockPeriodInSeconds int           `json:"fileLockPeriodInSeconds"`
+}
+
+func resourceDataCenterArchivalLocationNFS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: dataCenterCreateArchivalLocationNFS,
+		ReadContext:   dataCenterReadArchivalLocationNFS,
+		UpdateContext: dataCenterUpdateArchivalLocationNFS,
+		DeleteContext: dataCenterDeleteArchivalLocationNFS,
+
+		Description: description(resourceDataCenterArchivalLocationNFSDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data center archival location ID (UUID).",
+			},
+			keyArchivalConsolidation: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, archival consolidation is enabled. Archival consolidation frees up " +
+					"storage. Default value is `false`.",
+			},
+			keyAsyncWrite: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "When true, data is written asynchronously to the NFS export. Default value is `false`.",
+			},
+			keyAuthenticationType: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "AUTH_TYPE_SYSTEM",
+				Description: "NFS authentication type. Possible values are `AUTH_TYPE_SYSTEM`, `AUTH_TYPE_KRB5`, " +
+					"`AUTH_TYPE_KRB5I` and `AUTH_TYPE_KRB5P`. Default value is `AUTH_TYPE_SYSTEM`.",
+				ValidateFunc: validation.StringInSlice([]string{
+					"AUTH_TYPE_SYSTEM", "AUTH_TYPE_KRB5", "AUTH_TYPE_KRB5I", "AUTH_TYPE_KRB5P",
+				}, false),
+			},
+			keyClusterID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Rubrik cluster ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyEncryptionPassword: dataCenterEncryptionPasswordSchema(true, "Encryption password. If not "+
+				"specified, the archived data isn't encrypted. Changing this forces a new resource to be created."),
+			keyExportDirectory: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "NFS export directory. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyHost: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "NFS server IP address or FQDN. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyImmutabilitySettings: dataCenterImmutabilitySettingsSchema("Enables immutable storage with a " +
+				"time-based retention lock using NFS file locking for your archival location. Once enabled, you " +
+				"cannot delete the snapshots in this archival location before the specified immutability lock " +
+				"period expires."),
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Data center archival location name.",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			keyNFSOptions: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Additional NFS mount options, e.g. `rsize=1048576,wsize=1048576`.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyNFSVersion: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "NFS_V3",
+				Description: "NFS protocol version. Possible values are `NFS_V3` and `NFS_V4`. Default value is " +
+					"`NFS_V3`.",
+				ValidateFunc: validation.StringInSlice([]string{"NFS_V3", "NFS_V4"}, false),
+			},
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of data center archival location.",
+			},
+			keySyncStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Synchronization status of NFS target.",
+			},
+		},
+	}
+}
+
+func dataCenterCreateArchivalLocationNFS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterCreateArchivalLocationNFS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := createDataCenterTarget(ctx, client.GQL, createNFSTargetQuery, struct {
+		Input nfsTargetParams `json:"input"`
+	}{Input: nfsTargetParams{
+		Name:                    d.Get(keyName).(string),
+		ClusterID:               clusterID,
+		Host:                    d.Get(keyHost).(string),
+		ExportDir:               d.Get(keyExportDirectory).(string),
+		AuthType:                d.Get(keyAuthenticationType).(string),
+		NFSVersion:              d.Get(keyNFSVersion).(string),
+		NFSAsyncWrite:           d.Get(keyAsyncWrite).(bool),
+		OtherNFSOptions:         d.Get(keyNFSOptions).(string),
+		EncryptionPassword:      secret.String(d.Get(keyEncryptionPassword).(string)),
+		IsConsolidationEnabled:  d.Get(keyArchivalConsolidation).(bool),
+		FileLockPeriodInSeconds: fromFileLockPeriod(d),
+	}}, targetTypeNFS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	dataCenterReadArchivalLocationNFS(ctx, d, m)
+	return nil
+}
+
+func dataCenterReadArchivalLocationNFS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterReadArchivalLocationNFS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target, err := dataCenterTargetByID(ctx, client.GQL, id, targetTypeNFS)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyName, target.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyClusterID, target.Cluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, target.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySyncStatus, target.SyncStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyHost, target.Host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyExportDirectory, target.ExportDir); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyAuthenticationType, target.AuthType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyNFSVersion, target.NFSVersion); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyAsyncWrite, target.NFSAsyncWrite); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyNFSOptions, target.OtherNFSOptions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalConsolidation, target.IsConsolidationEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyImmutabilitySettings, toFileLockPeriod(target.FileLockPeriodInSeconds)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterUpdateArchivalLocationNFS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterUpdateArchivalLocationNFS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateDataCenterTarget(ctx, client.GQL, id, updateNFSTargetQuery, struct {
+		Input nfsTargetParams `json:"input"`
+	}{Input: nfsTargetParams{
+		ID:                      id,
+		Name:                    d.Get(keyName).(string),
+		AuthType:                d.Get(keyAuthenticationType).(string),
+		NFSVersion:              d.Get(keyNFSVersion).(string),
+		NFSAsyncWrite:           d.Get(keyAsyncWrite).(bool),
+		OtherNFSOptions:         d.Get(keyNFSOptions).(string),
+		IsConsolidationEnabled:  d.Get(keyArchivalConsolidation).(bool),
+		FileLockPeriodInSeconds: fromFileLockPeriod(d),
+	}}, targetTypeNFS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterDeleteArchivalLocationNFS(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterDeleteArchivalLocationNFS")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := archival.Wrap(client).DeleteTarget(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// fromFileLockPeriod converts the immutability settings data of the resource
+// configuration to the NFS file lock period in seconds.
+func fromFileLockPeriod(d *schema.ResourceData) int {
+	if settings := fromImmutabilitySettings(d); settings != nil {
+		return settings.LockDurationDays * 24 * 60 * 60
+	}
+
+	return 0
+}
+
+// toFileLockPeriod converts the NFS file lock period in seconds to the
+// immutability settings data.
+func toFileLockPeriod(fileLockPeriodInSeconds int) []any {
+	if fileLockPeriodInSeconds == 0 {
+		return nil
+	}
+
+	return toImmutabilitySettings(&dataCenterImmutabilitySettings{
+		LockDurationDays: fileLockPeriodInSeconds / (24 * 60 * 60),
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestFileLockPeriod verifies that the immutability lock period in days is
+// converted to and from the NFS file lock period in seconds.
+func TestFileLockPeriod(t *testing.T) {
+	res := resourceDataCenterArchivalLocationNFS()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyImmutabilitySettings: []any{
+			map[string]any{
+				keyLockPeriod: 14,
+			},
+		},
+	})
+
+	seconds := fromFileLockPeriod(d)
+	if want := 14 * 24 * 60 * 60; seconds != want {
+		t.Fatalf("file lock period = %d, want %d", seconds, want)
+	}
+
+	settings := toFileLockPeriod(seconds)
+	if len(settings) != 1 {
+		t.Fatalf("expected one immutability settings block, got %d", len(settings))
+	}
+	if got := settings[0].(map[string]any)[keyLockPeriod]; got != 14 {
+		t.Errorf("lock period = %v, want 14", got)
+	}
+}
+
+// TestFileLockPeriodUnset verifies that an NFS archival location without
+// immutability settings has no file lock period.
+func TestFileLockPeriodUnset(t *testing.T) {
+	res := resourceDataCenterArchivalLocationNFS()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{})
+	if seconds := fromFileLockPeriod(d); seconds != 0 {
+		t.Errorf("file lock period = %d, want 0", seconds)
+	}
+	if settings := toFileLockPeriod(0); settings != nil {
+		t.Errorf("expected no immutability settings, got %v", settings)
+	}
+}
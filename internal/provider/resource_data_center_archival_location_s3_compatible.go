@@ -0,0 +1,343 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlarchival "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/archival"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+)
+
+const resourceDataCenterArchivalLocationS3CompatibleDescription = `
+The ´polaris_data_center_archival_location_s3_compatible´ resource creates a
+data center archival location with the S3 compatible storage type. S3
+compatible object stores are accessed using a custom endpoint, e.g.,
+´https://s3.example.com:9000´. If the object store uses a certificate signed by
+a private certificate authority, the PEM encoded CA certificate must be
+specified using the ´ca_certificate´ field.
+
+RSC creates the buckets used by the archival location. The bucket names are
+made up of the bucket prefix followed by a number.
+
+~> Before configuring the immutability settings, see
+   [KB article](https://support.rubrik.com/s/article/000005468) or the Rubrik
+   User Guide documentation to determine the proper immutability lock period.
+   Immutability requires that the object store supports S3 object lock.
+`
+
+// createS3CompatibleTargetQuery is the GraphQL mutation used to create an S3
+// compatible data center archival location.
+const createS3CompatibleTargetQuery = `mutation SdkGolangCreateS3CompatibleTarget($input: CreateS3CompatibleTargetInput!) {
+    result: createS3CompatibleTarget(input: $input) {
+        id
+    }
+}`
+
+// updateS3CompatibleTargetQuery is the GraphQL mutation used to update an S3
+// compatible data center archival location.
+const updateS3CompatibleTargetQuery = `mutation SdkGolangUpdateS3CompatibleTarget($input: UpdateS3CompatibleTargetInput!) {
+    result: updateS3CompatibleTarget(input: $input) {
+        id
+    }
+}`
+
+// s3CompatibleTargetParams holds the parameters for an S3 compatible data
+// center archival location create and update operation. The update operation
+// doesn't support changing the cluster, the endpoint, the buckets or the
+// encryption.
+type s3CompatibleTargetParams struct {
+	ID                     uuid.UUID                                  `json:"id,omitzero"`
+	Name                   string                                     `json:"name"`
+	ClusterID              uuid.UUID                                  `json:"clusterUuid,omitzero"`
+	Endpoint               string                                     `json:"endpoint,omitempty"`
+	BucketPrefix           string                                     `json:"bucketPrefix,omitempty"`
+	NumberOfBuckets        int                                        `json:"numberOfBuckets,omitempty"`
+	AccessKey              secret.String                              `json:"accessKey"`
+	SecretKey              secret.String                              `json:"secretKey"`
+	CACertificate          string                                     `json:"caCertificate,omitempty"`
+	RSAKey                 secret.String                              `json:"rsaKey,omitempty"`
+	EncryptionPassword     secret.String                              `json:"encryptionPassword,omitempty"`
+	IsConsolidationEnabled bool                                       `json:"isConsolidationEnabled"`
+	ProxySettings          *gqlarchival.AWSTargetProxySettings        `json:"proxySettings,omitempty"`
+	BypassProxy            bool                                       `json:"bypassProxy"`
+	ImmutabilitySettings   *gqlarchival.AWSTargetImmutabilitySettings `json:"immutabilitySettings,omitempty"`
+}
+
+func resourceDataCenterArchivalLocationS3Compatible() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: dataCenterCreateArchivalLocationS3Compatible,
+		ReadContext:   dataCenterReadArchivalLocationS3Compatible,
+		UpdateContext: dataCenterUpdateArchivalLocationS3Compatible,
+		DeleteContext: dataCenterDeleteArchivalLocationS3Compatible,
+
+		Description: description(resourceDataCenterArchivalLocationS3CompatibleDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Data center archival location ID (UUID).",
+			},
+			keyAccessKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Access key of the S3 compatible object store.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyArchivalConsolidation: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "When true, archival consolidation is enabled. Archival consolidation frees up " +
+					"storage. Default value is `false`.",
+			},
+			keyArchivalProxySettings: dataCenterArchivalProxySettingsSchema(),
+			keyBucketPrefix: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Bucket prefix. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringLenBetween(1, 60),
+			},
+			keyCACertificate: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "PEM encoded CA certificate used to verify the certificate of the S3 compatible " +
+					"object store. Only required when the certificate is signed by a private certificate authority.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyClusterID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Rubrik cluster ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyEncryptionPassword: dataCenterEncryptionPasswordSchema(true, "Encryption password. Changing this "+
+				"forces a new resource to be created.", keyRSAKey),
+			keyEndpoint: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Endpoint URL of the S3 compatible object store, e.g. `https://s3.example.com:9000`. " +
+					"Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			keyImmutabilitySettings: dataCenterImmutabilitySettingsSchema("Enables immutable storage with a "+
+				"time-based retention lock using S3 object lock for your archival location. Once enabled, you "+
+				"cannot delete the snapshots in this archival location before the specified immutability lock "+
+				"period expires. Requires an encryption password policy.", keyRSAKey),
+			keyName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Data center archival location name.",
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			keyNumberOfBuckets: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Default:  1,
+				Description: "Number of buckets used by the archival location. Default value is `1`. Changing " +
+					"this forces a new resource to be created.",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			keyRSAKey: dataCenterRSAKeySchema(true, "PEM encoded private RSA key. Cannot be used with immutable "+
+				"archival locations. Changing this forces a new resource to be created.", keyEncryptionPassword),
+			keySecretKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Secret key of the S3 compatible object store.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of data center archival location.",
+			},
+			keySyncStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Synchronization status of S3 compatible target.",
+			},
+		},
+	}
+}
+
+func dataCenterCreateArchivalLocationS3Compatible(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterCreateArchivalLocationS3Compatible")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	id, err := createDataCenterTarget(ctx, client.GQL, createS3CompatibleTargetQuery, struct {
+		Input s3CompatibleTargetParams `json:"input"`
+	}{Input: s3CompatibleTargetParams{
+		Name:                   d.Get(keyName).(string),
+		ClusterID:              clusterID,
+		Endpoint:               d.Get(keyEndpoint).(string),
+		BucketPrefix:           d.Get(keyBucketPrefix).(string),
+		NumberOfBuckets:        d.Get(keyNumberOfBuckets).(int),
+		AccessKey:              secret.String(d.Get(keyAccessKey).(string)),
+		SecretKey:              secret.String(d.Get(keySecretKey).(string)),
+		CACertificate:          d.Get(keyCACertificate).(string),
+		RSAKey:                 secret.String(d.Get(keyRSAKey).(string)),
+		EncryptionPassword:     secret.String(d.Get(keyEncryptionPassword).(string)),
+		IsConsolidationEnabled: d.Get(keyArchivalConsolidation).(bool),
+		ProxySettings:          archivalProxySettings,
+		BypassProxy:            bypassProxy,
+		ImmutabilitySettings:   fromImmutabilitySettings(d),
+	}}, targetTypeS3Compatible)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	dataCenterReadArchivalLocationS3Compatible(ctx, d, m)
+	return nil
+}
+
+func dataCenterReadArchivalLocationS3Compatible(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterReadArchivalLocationS3Compatible")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	target, err := dataCenterTargetByID(ctx, client.GQL, id, targetTypeS3Compatible)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyName, target.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyClusterID, target.Cluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, target.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySyncStatus, target.SyncStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyEndpoint, target.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyBucketPrefix, target.BucketPrefix); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyNumberOfBuckets, target.NumberOfBuckets); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalConsolidation, target.IsConsolidationEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyArchivalProxySettings, toArchivalProxySettings(target.ProxySettings, target.BypassProxy)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyImmutabilitySettings, toImmutabilitySettings(target.ImmutabilitySettings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterUpdateArchivalLocationS3Compatible(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterUpdateArchivalLocationS3Compatible")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	archivalProxySettings, bypassProxy := fromArchivalProxySettings(d)
+	err = updateDataCenterTarget(ctx, client.GQL, id, updateS3CompatibleTargetQuery, struct {
+		Input s3CompatibleTargetParams `json:"input"`
+	}{Input: s3CompatibleTargetParams{
+		ID:                     id,
+		Name:                   d.Get(keyName).(string),
+		AccessKey:              secret.String(d.Get(keyAccessKey).(string)),
+		SecretKey:              secret.String(d.Get(keySecretKey).(string)),
+		CACertificate:          d.Get(keyCACertificate).(string),
+		IsConsolidationEnabled: d.Get(keyArchivalConsolidation).(bool),
+		ProxySettings:          archivalProxySettings,
+		BypassProxy:            bypassProxy,
+		ImmutabilitySettings:   fromImmutabilitySettings(d),
+	}}, targetTypeS3Compatible)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func dataCenterDeleteArchivalLocationS3Compatible(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "dataCenterDeleteArchivalLocationS3Compatible")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := archival.Wrap(client).DeleteTarget(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -56,6 +57,17 @@ region must be specified. For ´SOURCE_REGION´, a customer managed key block fo
 each source region should be specified, source regions not having a customer
 managed key block will have its data encrypted with platform managed keys.
 
+Archiving to the archival location can be paused by setting ´archival_state´ to
+´PAUSED´, and resumed by setting it back to ´ACTIVE´. When ´validate_connection´
+is true, the connection to the archival location is tested when it's created.
+If the connection status doesn't become ´CONNECTED´ before the create timeout
+expires, the apply fails and the archival location is marked as tainted. The
+default create timeout is 10 minutes and can be overridden with a ´timeouts´
+block.
+
+To move the archival of an SLA domain from one archival location to another,
+use the ´polaris_sla_archival_location_migration´ resource.
+
 -> **Note:** When using ´SOURCE_REGION´ the GCP bucket isn't created until the
    first protected object is archived.
 `
@@ -67,6 +79,10 @@ func resourceGcpArchivalLocation() *schema.Resource {
 		UpdateContext: gcpUpdateArchivalLocation,
 		DeleteContext: gcpDeleteArchivalLocation,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Description: description(resourceGCPArchivalLocationDescription),
 		Schema: map[string]*schema.Schema{
 			keyID: {
@@ -97,6 +113,14 @@ func resourceGcpArchivalLocation() *schema.Resource {
 					"will always be prepended to the prefix. Changing this forces a new resource to be created.",
 				ValidateFunc: validation.StringLenBetween(1, 19),
 			},
+			keyArchivalState: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  archivalStateActive,
+				Description: "State of the cloud native archival location. Possible values are `ACTIVE` and `PAUSED`. " +
+					"RSC doesn't archive snapshots to a paused archival location. Default value is `ACTIVE`.",
+				ValidateFunc: validation.StringInSlice([]string{archivalStateActive, archivalStatePaused}, false),
+			},
 			keyConnectionStatus: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -142,6 +166,14 @@ func resourceGcpArchivalLocation() *schema.Resource {
 					"ARCHIVE", "COLDLINE", "NEARLINE", "STANDARD", "DURABLE_REDUCED_AVAILABILITY",
 				}, false),
 			},
+			keyValidateConnection: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the connection to the cloud native archival location is tested when the " +
+					"archival location is created. The apply fails if the connection status of the archival location " +
+					"doesn't become `CONNECTED` before the create timeout expires. Default value is `false`.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
@@ -182,6 +214,30 @@ func gcpCreateArchivalLocation(ctx context.Context, d *schema.ResourceData, m an
 	}
 
 	d.SetId(targetMappingID.String())
+
+	// Test the connection to the archival location. If the test fails, the
+	// archival location is left in place and marked as tainted.
+	if d.Get(keyValidateConnection).(bool) {
+		err := waitForArchivalLocationConnection(ctx, targetMappingID, func(ctx context.Context) (string, error) {
+			targetMapping, err := archival.Wrap(client).GCPTargetMappingByID(ctx, targetMappingID)
+			if err != nil {
+				return "", err
+			}
+			return targetMapping.ConnectionStatus.Status, nil
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Pause the archival location, archival locations are created in the
+	// active state.
+	if state := d.Get(keyArchivalState).(string); state == archivalStatePaused {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, state); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	gcpReadArchivalLocation(ctx, d, m)
 	return nil
 }
@@ -217,6 +273,9 @@ func gcpReadArchivalLocation(ctx context.Context, d *schema.ResourceData, m any)
 	if err := d.Set(keyCloudAccountID, targetTemplate.CloudAccount.ID.String()); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set(keyArchivalState, archivalStateFromConnectionStatus(targetMapping.ConnectionStatus.Status)); err != nil {
+		return diag.FromErr(err)
+	}
 	if err := d.Set(keyConnectionStatus, targetMapping.ConnectionStatus.Status); err != nil {
 		return diag.FromErr(err)
 	}
@@ -267,6 +326,13 @@ func gcpUpdateArchivalLocation(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
+	// Pause or resume the archival location.
+	if d.HasChange(keyArchivalState) {
+		if err := setArchivalLocationState(ctx, client.GQL, targetMappingID, d.Get(keyArchivalState).(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return nil
 }
 
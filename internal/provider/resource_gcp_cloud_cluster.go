@@ -0,0 +1,928 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cloudcluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	gqlcloudcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cloudcluster"
+	gqlcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+)
+
+const resourceGCPCloudClusterDescription = `
+The ´polaris_gcp_cloud_cluster´ resource creates a GCP cloud cluster using RSC.
+
+This resource creates a Rubrik Cloud Data Management (CDM) cluster with elastic storage
+in GCP using the specified configuration. The cluster will be deployed with the specified
+number of nodes, instance types, and network configuration. The cluster data is stored
+in a GCS bucket, which must be in the same region as the cluster.
+
+~> **Note:** This resource creates actual GCP infrastructure. Destroying the
+   resource will attempt to clean up the created resources, but manual cleanup
+   may be required.
+
+~> **Note:** The GCP project must be onboarded to RSC with the Server and Apps
+   feature enabled before creating a cloud cluster.
+
+~> **Note:** This resource requires **Terraform v1.11.0 or later** due to the use of write-only attributes for
+   ´admin_email´ and ´admin_password´.
+
+~> **Note:** When destroying this resource, the cluster will be removed from
+   RSC. If the cluster has blocking conditions (active SLAs, global SLAs, or RCV
+   locations), the deletion will fail and you must resolve these conditions
+   first. Use the 'force_cluster_delete_on_destroy' option to force removal when
+   eligible.
+
+~> **Note:** Increasing ´num_nodes´ scales out the cluster, changing
+   ´instance_type´ replaces the cluster nodes one node at a time and changing
+   ´cdm_version´ upgrades the cluster using a rolling upgrade. These operations
+   are performed in place and can take several hours, the update timeout
+   defaults to 8 hours. The upgrade compatibility of the new CDM version is
+   checked when planning. If an operation fails, the planned values
+   aren't saved. The number of nodes and the instance type are refreshed from
+   RSC, an instance type which doesn't match the vCPUs of the nodes is read as
+   empty.
+`
+
+// This resource uses a template for its documentation due to a bug in the TF
+// docs generator. Remember to update the template if the documentation for any
+// fields are changed.
+func resourceGcpCloudCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: gcpCreateCloudCluster,
+		ReadContext:   gcpReadCloudCluster,
+		UpdateContext: gcpUpdateCloudCluster,
+		DeleteContext: gcpDeleteCloudCluster,
+		Description:   description(resourceGCPCloudClusterDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cloud cluster ID (UUID).",
+			},
+			keyCloudAccountID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "RSC cloud account ID (UUID) of the GCP project. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyRegion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "GCP region to deploy the cluster in, e.g. `us-west1`. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyZone: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "GCP zone to deploy the cluster nodes in, e.g. `us-west1-a`. The zone must belong to the region. Required when `az_resilient` is false. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyAzResilient: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Whether to deploy the cluster across multiple zones for zone resiliency. When enabled, `subnet_az_config` blocks must be specified in `vm_config` and `zone` must not be specified. Requires at least 3 nodes. Changing this forces a new resource to be created.",
+			},
+			keyClusterConfig: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration for the cloud cluster. Changing this forces a new resource to be created.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyClusterName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Unique name to assign to the cloud cluster.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyAdminEmail: {
+							Type:         schema.TypeString,
+							Required:     true,
+							WriteOnly:    true,
+							Description:  "Email address for the cluster admin user. Changing this value will have no effect on the cluster.",
+							ValidateFunc: validateEmailAddress,
+						},
+						keyAdminPassword: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Sensitive:    true,
+							WriteOnly:    true,
+							Description:  "Password for the cluster admin user. Changing this value will have no effect on the cluster.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyNumNodes: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Number of nodes in the cluster. Increasing the number of nodes scales out the cluster in place. The number of nodes cannot be decreased.",
+							ValidateFunc: validateNumNodes,
+						},
+						keyDNSNameServers: {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Required:    true,
+							MinItems:    1,
+							Description: "DNS name servers for the cluster.",
+						},
+						keyDNSSearchDomains: {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Optional:    true,
+							MinItems:    1,
+							Description: "DNS search domains for the cluster.",
+						},
+						keyNTPServers: {
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+							Required:    true,
+							MinItems:    1,
+							Description: "NTP servers for the cluster.",
+						},
+						keyBucketName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "Name of the GCS bucket to use for the cluster. The bucket must be in the same region as the cluster. Changing this forces a new resource to be created.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyKeepClusterOnFailure: {
+							Type:        schema.TypeBool,
+							Required:    true,
+							ForceNew:    true,
+							Description: "Whether to keep the cluster on failure (can be useful for troubleshooting). Changing this forces a new resource to be created.",
+						},
+						keyForceClusterDeleteOnDestroy: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to force delete the cluster on destroy.",
+						},
+						keyDynamicScalingEnabled: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to enable dynamic scaling for the cluster. Requires CDM Version 9.4.3+. Changing this forces a new resource to be created.",
+							ForceNew:    true,
+						},
+						keyTimezone: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "Timezone for the cluster using IANA standard format e.g. America/Los_Angeles, Europe/Paris, etc.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyLocation: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							Description:  "Location for the cluster. This is free text, RSC will map it to the closest possible location e.g. Palo Alto, CA.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			keyVMConfig: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "VM configuration for the cluster nodes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyCDMVersion: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "CDM version to use. Changing this upgrades the cluster in place using a rolling upgrade orchestrated by RSC. The upgrade compatibility is checked when planning.",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						keyCDMProduct: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CDM Product Code. This is a read-only field and computed based on the CDM version.",
+						},
+						keyInstanceType: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "GCP instance type for the cluster nodes. Changing this replaces the cluster nodes in place, one node at a time. Supported values are `N2_STANDARD_8`, `N2_STANDARD_16`, `N2_HIGHMEM_16`, `N2D_STANDARD_8`, `N2D_STANDARD_16` and `N2D_HIGHMEM_16`.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(gqlcloudcluster.GcpInstanceTypeN2Standard8),
+								string(gqlcloudcluster.GcpInstanceTypeN2Standard16),
+								string(gqlcloudcluster.GcpInstanceTypeN2Highmem16),
+								string(gqlcloudcluster.GcpInstanceTypeN2DStandard8),
+								string(gqlcloudcluster.GcpInstanceTypeN2DStandard16),
+								string(gqlcloudcluster.GcpInstanceTypeN2DHighmem16),
+							}, false),
+						},
+						keyDeleteProtection: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     true,
+							Description: "Whether to enable deletion protection for the cluster node instances. Changing this forces a new resource to be created.",
+						},
+						keyNodeSizeGB: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Description:  "Size of the data disk of each cluster node in GB. If not specified, RSC picks the size based on the VM type. Changing this forces a new resource to be created.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						keyNetworkConfig: {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							Description: "VPC network and subnet for the cluster nodes. Specify a single block to deploy all nodes in the same subnet or one block per node. When `az_resilient` is true, only the network of the first block is used and the subnets are specified using `subnet_az_config` blocks. Changing this forces a new resource to be created.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									keyHostProject: {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ForceNew:     true,
+										Description:  "ID of the host project of a shared VPC network. Only required when the network is shared from another project.",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									keyNetwork: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Name of the VPC network.",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									keySubnet: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Name of the subnet. The subnet must be in the same region as the cluster.",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+								},
+							},
+						},
+						keySubnetAzConfigs: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "Subnet and zone pairs for zone resilient deployments. Required when `az_resilient` is true. Each block specifies a subnet and its zone, at least 3 blocks are required. Changing this forces a new resource to be created.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									keyAvailabilityZone: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Zone name.",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+									keySubnet: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Subnet name for this zone.",
+										ValidateFunc: validation.StringIsNotWhiteSpace,
+									},
+								},
+							},
+						},
+						keyServiceAccounts: {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							Description: "GCP service accounts attached to the cluster node instances. Changing this forces a new resource to be created.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									keyEmail: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Email address of the service account.",
+										ValidateFunc: validateEmailAddress,
+									},
+									keyScopes: {
+										Type: schema.TypeSet,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringIsNotWhiteSpace,
+										},
+										Optional:    true,
+										ForceNew:    true,
+										Description: "OAuth scopes granted to the service account, e.g. `https://www.googleapis.com/auth/cloud-platform`.",
+									},
+								},
+							},
+						},
+						keyVMType: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Default:     "DENSE",
+							Description: "VM type for the cluster. Changing this forces a new resource to be created. Possible values are `STANDARD`, `DENSE` and `EXTRA_DENSE`. `DENSE` is recommended for CCES.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(gqlcloudcluster.CCVmConfigStandard),
+								string(gqlcloudcluster.CCVmConfigDense),
+								string(gqlcloudcluster.CCVmConfigExtraDense),
+							}, false),
+						},
+					},
+				},
+			},
+			keyTaskID: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "ID of the RSC activity series of the cluster create job. Only set when the cluster is " +
+					"created without waiting for completion. Use with the `polaris_task_wait` resource to wait for " +
+					"the create job to finish.",
+			},
+			keyWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Flag to determine if Terraform should wait for the cluster create job to complete. " +
+					"When `false`, Terraform returns as soon as the create job has started. Default value is `true`.",
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
+			vmConfigList := diff.Get(keyVMConfig).([]any)
+			if len(vmConfigList) == 0 {
+				return nil
+			}
+			vmConfigMap := vmConfigList[0].(map[string]any)
+
+			hasSubnetAzConfigs := false
+			if configs, ok := vmConfigMap[keySubnetAzConfigs]; ok && len(configs.([]any)) > 0 {
+				hasSubnetAzConfigs = true
+			}
+			hasZone := diff.Get(keyZone).(string) != ""
+			if err := validateGcpCloudClusterZones(diff.Get(keyAzResilient).(bool), hasZone, hasSubnetAzConfigs); err != nil {
+				return err
+			}
+
+			return customizeDiffCloudClusterLifecycle(ctx, diff, meta)
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(60 * time.Minute),
+			Read:    schema.DefaultTimeout(20 * time.Minute),
+			Update:  schema.DefaultTimeout(8 * time.Hour),
+			Default: schema.DefaultTimeout(20 * time.Minute),
+		},
+	}
+}
+
+// gcpCreateCloudCluster creates the cloud cluster resource.
+func gcpCreateCloudCluster(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpCreateCloudCluster")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountID, err := uuid.Parse(d.Get(keyCloudAccountID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	vmConfigList := d.Get(keyVMConfig).([]any)
+	if len(vmConfigList) == 0 {
+		return diag.Errorf("%s is required", keyVMConfig)
+	}
+	vmConfigMap := vmConfigList[0].(map[string]any)
+	region := d.Get(keyRegion).(string)
+
+	vmConfig := toGcpVMConfig(vmConfigMap, region)
+
+	clusterConfigMap := d.Get(keyClusterConfig).([]any)[0].(map[string]any)
+
+	dnsNameServers := make([]string, 0)
+	if dnsNameServersSet, ok := clusterConfigMap[keyDNSNameServers].(*schema.Set); ok {
+		for _, dns := range dnsNameServersSet.List() {
+			dnsNameServers = append(dnsNameServers, dns.(string))
+		}
+	}
+
+	dnsSearchDomains := make([]string, 0)
+	if dnsSearchDomainsSet, ok := clusterConfigMap[keyDNSSearchDomains].(*schema.Set); ok {
+		for _, domain := range dnsSearchDomainsSet.List() {
+			dnsSearchDomains = append(dnsSearchDomains, domain.(string))
+		}
+	}
+
+	ntpServers := make([]string, 0)
+	if ntpServersSet, ok := clusterConfigMap[keyNTPServers].(*schema.Set); ok {
+		for _, ntp := range ntpServersSet.List() {
+			ntpServers = append(ntpServers, ntp.(string))
+		}
+	}
+
+	// WriteOnly fields are nulled in the planned state, so we must read
+	// them from the raw config.
+	rawConfig := d.GetRawConfig()
+	adminEmail := rawConfig.GetAttr(keyClusterConfig).AsValueSlice()[0].GetAttr(keyAdminEmail).AsString()
+	adminPassword := rawConfig.GetAttr(keyClusterConfig).AsValueSlice()[0].GetAttr(keyAdminPassword).AsString()
+
+	clusterConfig := gqlcloudcluster.GcpClusterConfig{
+		ClusterName:      clusterConfigMap[keyClusterName].(string),
+		UserEmail:        adminEmail,
+		AdminPassword:    secret.String(adminPassword),
+		DNSNameServers:   dnsNameServers,
+		DNSSearchDomains: dnsSearchDomains,
+		NTPServers:       ntpServers,
+		NumNodes:         clusterConfigMap[keyNumNodes].(int),
+		GcpEsConfig: gqlcloudcluster.GcpEsConfigInput{
+			BucketName:         clusterConfigMap[keyBucketName].(string),
+			Region:             region,
+			ShouldCreateBucket: false,
+		},
+		DynamicScalingEnabled: clusterConfigMap[keyDynamicScalingEnabled].(bool),
+	}
+
+	azResilient := d.Get(keyAzResilient).(bool)
+	input := gqlcloudcluster.CreateGcpClusterInput{
+		CloudAccountID:       cloudAccountID,
+		ClusterConfig:        clusterConfig,
+		IsAzResilient:        &azResilient,
+		IsEsType:             true,
+		KeepClusterOnFailure: clusterConfigMap[keyKeepClusterOnFailure].(bool),
+		Region:               region,
+		Validations:          []gqlcloudcluster.ClusterCreateValidations{gqlcloudcluster.AllChecks},
+		VMConfig:             vmConfig,
+		Zone:                 d.Get(keyZone).(string),
+	}
+
+	if d.Get(keyWaitForCompletion).(bool) {
+		cloudcluster, err := cloudcluster.Wrap(client).CreateGcpCloudCluster(ctx, input, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(cloudcluster.ID.String())
+
+		vmConfigList = d.Get(keyVMConfig).([]any)
+		if len(vmConfigList) > 0 {
+			vmConfigMap := vmConfigList[0].(map[string]any)
+			vmConfigMap[keyCDMProduct] = cloudcluster.CdmProduct
+			d.Set(keyVMConfig, []any{vmConfigMap})
+		}
+	} else {
+		// Only wait for the create job to start, the polaris_task_wait
+		// resource can be used to wait for the job to finish.
+		clusterID, taskID, err := startCloudClusterCreate(ctx, client.GQL, input.ClusterConfig.ClusterName,
+			func(ctx context.Context) (uuid.UUID, error) {
+				cluster, err := cloudcluster.Wrap(client).CreateGcpCloudCluster(ctx, input, false)
+				return cluster.ID, err
+			})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(clusterID.String())
+		if err := d.Set(keyTaskID, taskID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Read back the created resource to populate computed fields. A failed
+	// readback must not be returned as an error: the resource was successfully
+	// created and returning an error here would leave Terraform unable to
+	// manage it. A plan diff on the next run is an acceptable outcome.
+	if diags := gcpReadCloudCluster(ctx, d, m); diags.HasError() {
+		for _, diagnostic := range diags {
+			tflog.Warn(ctx, "failed to read back gcp cloud cluster after create", map[string]any{
+				"summary": diagnostic.Summary,
+				"detail":  diagnostic.Detail,
+			})
+		}
+	}
+	return nil
+}
+
+// validateGcpCloudClusterZones validates the zone placement of a GCP cloud
+// cluster. An AZ resilient cluster is placed using the subnet AZ configs of
+// the VM config, other clusters are placed in a single zone.
+func validateGcpCloudClusterZones(azResilient, hasZone, hasSubnetAzConfigs bool) error {
+	if azResilient {
+		if !hasSubnetAzConfigs {
+			return fmt.Errorf("%s is required in %s when %s is true", keySubnetAzConfigs, keyVMConfig, keyAzResilient)
+		}
+		if hasZone {
+			return fmt.Errorf("%s cannot be specified when %s is true, use %s in %s instead", keyZone, keyAzResilient, keySubnetAzConfigs, keyVMConfig)
+		}
+	} else {
+		if hasSubnetAzConfigs {
+			return fmt.Errorf("%s cannot be specified in %s when %s is false", keySubnetAzConfigs, keyVMConfig, keyAzResilient)
+		}
+		if !hasZone {
+			return fmt.Errorf("%s is required when %s is false", keyZone, keyAzResilient)
+		}
+	}
+
+	return nil
+}
+
+// toGcpVMConfig returns the VM configuration of a GCP cloud cluster from the
+// vm_config block. The subnets of the network config are in the region of the
+// cluster.
+func toGcpVMConfig(vmConfigMap map[string]any, region string) gqlcloudcluster.GcpVmConfig {
+	var networkConfig []gqlcloudcluster.GcpSubnetInput
+	for _, item := range vmConfigMap[keyNetworkConfig].([]any) {
+		configMap := item.(map[string]any)
+		networkConfig = append(networkConfig, gqlcloudcluster.GcpSubnetInput{
+			HostProject: configMap[keyHostProject].(string),
+			Name:        configMap[keySubnet].(string),
+			Network:     configMap[keyNetwork].(string),
+			Region:      region,
+		})
+	}
+
+	var serviceAccounts []gqlcloudcluster.GcpServiceAccountInput
+	for _, item := range vmConfigMap[keyServiceAccounts].([]any) {
+		accountMap := item.(map[string]any)
+		var scopes []string
+		for _, scope := range accountMap[keyScopes].(*schema.Set).List() {
+			scopes = append(scopes, scope.(string))
+		}
+		serviceAccounts = append(serviceAccounts, gqlcloudcluster.GcpServiceAccountInput{
+			Email:  accountMap[keyEmail].(string),
+			Scopes: scopes,
+		})
+	}
+
+	var subnetAzConfigs []gqlcloudcluster.SubnetAzConfig
+	if v, ok := vmConfigMap[keySubnetAzConfigs]; ok {
+		for _, item := range v.([]any) {
+			configMap := item.(map[string]any)
+			subnetAzConfigs = append(subnetAzConfigs, gqlcloudcluster.SubnetAzConfig{
+				AvailabilityZone: configMap[keyAvailabilityZone].(string),
+				Subnet:           configMap[keySubnet].(string),
+			})
+		}
+	}
+
+	return gqlcloudcluster.GcpVmConfig{
+		CDMVersion:       vmConfigMap[keyCDMVersion].(string),
+		DeleteProtection: vmConfigMap[keyDeleteProtection].(bool),
+		InstanceType:     gqlcloudcluster.GcpCCInstanceType(vmConfigMap[keyInstanceType].(string)),
+		NetworkConfig:    networkConfig,
+		NodeSizeGB:       vmConfigMap[keyNodeSizeGB].(int),
+		ServiceAccounts:  serviceAccounts,
+		SubnetAzConfigs:  subnetAzConfigs,
+		VMType:           gqlcloudcluster.VmConfigType(vmConfigMap[keyVMType].(string)),
+	}
+}
+
+// gcpReadCloudCluster reads the cloud cluster resource.
+func gcpReadCloudCluster(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpReadCloudCluster")
+
+	// For cloud clusters, the read operation is limited since the cluster
+	// creation is a long-running operation and the cluster state is managed
+	// by RSC. We mainly verify that the resource still exists in the state.
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A cluster created without waiting for completion might not be visible
+	// until the create job has finished.
+	if pending, err := cloudClusterCreatePending(ctx, client.GQL, d); err != nil {
+		return diag.FromErr(err)
+	} else if pending {
+		return nil
+	}
+
+	clusterFilter := gqlcluster.SearchFilter{
+		ID: []string{id.String()},
+	}
+
+	// Use AllCloudClusters and filter for cluster
+	//lint:ignore SA1019 temporary: migration to cluster.API.ListClusters/AllClusters pending
+	cloudClusters, err := gqlcloudcluster.Wrap(client.GQL).AllCloudClusters(ctx, 1, "", clusterFilter, gqlcluster.SortByClusterName, core.SortOrderDesc)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(cloudClusters) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	cloudCluster := cloudClusters[0]
+	// validate the cloud cluster ID
+	if cloudCluster.ID != id {
+		return diag.Errorf("Cloud cluster ID mismatch. Expected %q, got %q", id, cloudCluster.ID)
+	}
+
+	// Look up the CDM product code of the CDM version using the cloud account
+	// of the cluster.
+	cloudAccountID, err := uuid.Parse(d.Get(keyCloudAccountID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	cdmVersions, err := gqlcloudcluster.Wrap(client.GQL).AllGcpCdmVersions(ctx, cloudAccountID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var productCode string
+	for _, version := range cdmVersions {
+		if version.CdmVersion == cloudCluster.Version {
+			productCode = version.CdmProduct
+			break
+		}
+	}
+
+	// Get and update cluster_config block
+	clusterConfigList := d.Get(keyClusterConfig).([]any)
+	clusterConfigMap := clusterConfigList[0].(map[string]any)
+
+	// The node count is only known once the cluster nodes have registered.
+	if numNodes := len(cloudCluster.ClusterNodes.Edges); numNodes > 0 {
+		clusterConfigMap[keyNumNodes] = numNodes
+	}
+
+	// Check if the CDM version changed
+	vmConfigList := d.Get(keyVMConfig).([]any)
+	vmConfigMap := vmConfigList[0].(map[string]any)
+	vmConfigMap[keyCDMVersion] = cloudCluster.Version
+	instanceType, err := cloudClusterInstanceType(ctx, client.GQL, cloudCluster, vmConfigMap[keyInstanceType].(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vmConfigMap[keyInstanceType] = instanceType
+	if productCode != "" {
+		vmConfigMap[keyCDMProduct] = productCode
+	}
+
+	// Read DNS, NTP, and DNS Search Domains from API and check if they match the Terraform state
+	dnsServers, err := gqlcluster.Wrap(client.GQL).DNSServers(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dnsNameServersSet := schema.Set{F: schema.HashString}
+	for _, server := range dnsServers.Servers {
+		dnsNameServersSet.Add(server)
+	}
+	clusterConfigMap[keyDNSNameServers] = &dnsNameServersSet
+
+	dnsSearchDomainsSet := schema.Set{F: schema.HashString}
+	for _, domain := range dnsServers.Domains {
+		dnsSearchDomainsSet.Add(domain)
+	}
+	clusterConfigMap[keyDNSSearchDomains] = &dnsSearchDomainsSet
+
+	ntpServers, err := gqlcluster.Wrap(client.GQL).NTPServers(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ntpServersSet := schema.Set{F: schema.HashString}
+	for _, server := range ntpServers {
+		ntpServersSet.Add(server.Server)
+	}
+	clusterConfigMap[keyNTPServers] = &ntpServersSet
+
+	// Read cluster settings
+	clusterSettings, err := gqlcluster.Wrap(client.GQL).ClusterSettings(ctx, id)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterConfigMap[keyClusterName] = clusterSettings.Name
+	clusterConfigMap[keyTimezone] = clusterSettings.Timezone
+	clusterConfigMap[keyLocation] = clusterSettings.RawAddress
+
+	d.Set(keyClusterConfig, []any{clusterConfigMap})
+	d.Set(keyVMConfig, []any{vmConfigMap})
+
+	return nil
+}
+
+// gcpDeleteCloudCluster deletes the cloud cluster resource.
+func gcpDeleteCloudCluster(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpDeleteCloudCluster")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Get the force delete flag from the Terraform configuration
+	clusterConfigList := d.Get(keyClusterConfig).([]any)
+	clusterConfigMap := clusterConfigList[0].(map[string]any)
+	forceRemoval := clusterConfigMap[keyForceClusterDeleteOnDestroy].(bool)
+
+	// Attempt cluster removal
+	// The RemoveCluster function will handle all prechecks and validations
+	info, err := cluster.Wrap(client).RemoveCluster(ctx, clusterID, forceRemoval, 0)
+	if err != nil {
+		tflog.Error(ctx, "Failed to remove cloud cluster", map[string]any{
+			"cluster_id":             clusterID.String(),
+			"error":                  err.Error(),
+			"blocking_conditions":    info.BlockingConditions,
+			"force_removal_eligible": info.ForceRemovalEligible,
+		})
+		return diag.FromErr(err)
+	}
+
+	tflog.Info(ctx, "Cloud cluster removal initiated successfully", map[string]any{
+		"cluster_id": clusterID.String(),
+	})
+
+	d.SetId("")
+	return nil
+}
+
+// gcpUpdateCloudCluster updates the resource in-place. The following actions
+// are supported:
+//   - Scale out (add nodes)
+//   - Replace the instance type of the nodes
+//   - Upgrade the CDM version
+//   - Update Network DNS
+//   - Update Network DNS Search Domains
+//   - Update NTP
+//   - Update Cluster Name
+//   - Update Timezone
+//   - Update Location
+func gcpUpdateCloudCluster(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpUpdateCloudCluster")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateCloudClusterLifecycle(ctx, d, client, clusterID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	gqlCluster := gqlcluster.Wrap(client.GQL)
+
+	// Check if cluster_config block has changes
+	if d.HasChange(keyClusterConfig) {
+		clusterConfigList := d.Get(keyClusterConfig).([]any)
+		if len(clusterConfigList) == 0 {
+			return diag.Errorf("%s is required", keyClusterConfig)
+		}
+		clusterConfigMap := clusterConfigList[0].(map[string]any)
+
+		// Check for DNS name servers or DNS search domains change
+		if d.HasChange(keyClusterConfig+".0."+keyDNSNameServers) || d.HasChange(keyClusterConfig+".0."+keyDNSSearchDomains) {
+			dnsNameServers := make([]string, 0)
+			if dnsNameServersSet, ok := clusterConfigMap[keyDNSNameServers].(*schema.Set); ok {
+				for _, dns := range dnsNameServersSet.List() {
+					dnsNameServers = append(dnsNameServers, dns.(string))
+				}
+			}
+
+			dnsSearchDomains := make([]string, 0)
+			if dnsSearchDomainsSet, ok := clusterConfigMap[keyDNSSearchDomains].(*schema.Set); ok {
+				for _, domain := range dnsSearchDomainsSet.List() {
+					dnsSearchDomains = append(dnsSearchDomains, domain.(string))
+				}
+			}
+
+			tflog.Debug(ctx, "Updating DNS servers and search domains", map[string]any{
+				"cluster_id":     clusterID.String(),
+				"dns_servers":    dnsNameServers,
+				"search_domains": dnsSearchDomains,
+			})
+
+			input := gqlcluster.UpdateDNSServersAndSearchDomainsInput{
+				ClusterID:     clusterID,
+				DNSServers:    dnsNameServers,
+				SearchDomains: dnsSearchDomains,
+			}
+
+			if err := gqlCluster.UpdateDNSServersAndSearchDomains(ctx, input); err != nil {
+				return diag.FromErr(err)
+			}
+
+			tflog.Debug(ctx, "DNS name servers and search domains updated", map[string]any{
+				"cluster_id": clusterID.String(),
+			})
+		}
+
+		// Check for NTP servers change
+		if d.HasChange(keyClusterConfig + ".0." + keyNTPServers) {
+			input := gqlcluster.UpdateClusterNTPServersInput{
+				ClusterID: clusterID,
+			}
+
+			if ntpServersSet, ok := clusterConfigMap[keyNTPServers].(*schema.Set); ok {
+				for _, ntp := range ntpServersSet.List() {
+					input.Servers = append(input.Servers, struct {
+						Server       string                      `json:"server"`
+						SymmetricKey *gqlcluster.NTPSymmetricKey `json:"symmetricKey,omitempty"`
+					}{
+						Server: ntp.(string),
+						// SymmetricKey is nil, so it will be omitted from JSON
+					})
+				}
+			}
+
+			tflog.Debug(ctx, "Updating NTP servers", map[string]any{
+				"cluster_id":  clusterID.String(),
+				"ntp_servers": input.Servers,
+			})
+
+			if err := gqlCluster.UpdateNTPServers(ctx, input); err != nil {
+				return diag.FromErr(err)
+			}
+
+			tflog.Debug(ctx, "NTP servers updated", map[string]any{
+				"cluster_id": clusterID.String(),
+			})
+
+		}
+
+		// Check for cluster name change, timezone change or location change
+		// since these use the same API we need to update them together
+		if d.HasChanges(keyClusterConfig+".0."+keyClusterName, keyClusterConfig+".0."+keyTimezone, keyClusterConfig+".0."+keyLocation) {
+			clusterName := clusterConfigMap[keyClusterName].(string)
+			timezone := clusterConfigMap[keyTimezone].(string)
+			location := clusterConfigMap[keyLocation].(string)
+
+			var parsedTimezone gqlcluster.Timezone
+			if timezone != "" {
+				parsedTimezone, err = gqlcluster.ParseTimeZone(timezone)
+				if err != nil {
+					return diag.FromErr(err)
+				}
+			}
+
+			input := gqlcluster.UpdatedSettings{
+				ClusterID: clusterID,
+				Name:      clusterName,
+				Timezone:  parsedTimezone,
+				Address:   location,
+			}
+			if _, err := gqlCluster.UpdateSettings(ctx, input); err != nil {
+				return diag.FromErr(err)
+			}
+
+			tflog.Debug(ctx, "Cluster settings updated", map[string]any{
+				"cluster_id": clusterID.String(),
+				"name":       clusterName,
+				"timezone":   parsedTimezone,
+				"address":    location,
+			})
+		}
+	}
+
+	return gcpReadCloudCluster(ctx, d, m)
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gqlcloudcluster "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/cloudcluster"
+)
+
+// TestValidateGcpCloudClusterZones verifies that the zone and the subnet AZ
+// configs are validated against the AZ resilient flag.
+func TestValidateGcpCloudClusterZones(t *testing.T) {
+	tests := []struct {
+		name               string
+		azResilient        bool
+		hasZone            bool
+		hasSubnetAzConfigs bool
+		wantErr            bool
+	}{{
+		name:    "SingleZone",
+		hasZone: true,
+	}, {
+		name:    "SingleZoneWithoutZone",
+		wantErr: true,
+	}, {
+		name:               "SingleZoneWithSubnetAzConfigs",
+		hasZone:            true,
+		hasSubnetAzConfigs: true,
+		wantErr:            true,
+	}, {
+		name:               "AzResilient",
+		azResilient:        true,
+		hasSubnetAzConfigs: true,
+	}, {
+		name:        "AzResilientWithoutSubnetAzConfigs",
+		azResilient: true,
+		wantErr:     true,
+	}, {
+		name:               "AzResilientWithZone",
+		azResilient:        true,
+		hasZone:            true,
+		hasSubnetAzConfigs: true,
+		wantErr:            true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateGcpCloudClusterZones(tc.azResilient, tc.hasZone, tc.hasSubnetAzConfigs)
+			if tc.wantErr && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tc.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+// TestToGcpVMConfig verifies that the VM config of the create input is read
+// from the vm_config block.
+func TestToGcpVMConfig(t *testing.T) {
+	res := resourceGcpCloudCluster()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyRegion:      "us-east1",
+		keyAzResilient: true,
+		keyVMConfig: []any{
+			map[string]any{
+				keyCDMVersion:       "9.4.0-p2-30507",
+				keyDeleteProtection: false,
+				keyInstanceType:     string(gqlcloudcluster.GcpInstanceTypeN2Standard8),
+				keyNodeSizeGB:       1024,
+				keyVMType:           string(gqlcloudcluster.CCVmConfigDense),
+				keyNetworkConfig: []any{
+					map[string]any{
+						keyHostProject: "host-project",
+						keyNetwork:     "my-vpc",
+						keySubnet:      "my-subnet",
+					},
+				},
+				keySubnetAzConfigs: []any{
+					map[string]any{
+						keyAvailabilityZone: "us-east1-b",
+						keySubnet:           "my-subnet-b",
+					},
+					map[string]any{
+						keyAvailabilityZone: "us-east1-c",
+						keySubnet:           "my-subnet-c",
+					},
+				},
+				keyServiceAccounts: []any{
+					map[string]any{
+						keyEmail:  "cces@my-project.iam.gserviceaccount.com",
+						keyScopes: []any{"https://www.googleapis.com/auth/cloud-platform"},
+					},
+				},
+			},
+		},
+	})
+
+	vmConfig := toGcpVMConfig(d.Get(keyVMConfig).([]any)[0].(map[string]any), d.Get(keyRegion).(string))
+	if vmConfig.CDMVersion != "9.4.0-p2-30507" {
+		t.Errorf("CDM version = %q, want 9.4.0-p2-30507", vmConfig.CDMVersion)
+	}
+	if vmConfig.DeleteProtection {
+		t.Error("expected delete protection to be disabled")
+	}
+	if vmConfig.InstanceType != gqlcloudcluster.GcpInstanceTypeN2Standard8 {
+		t.Errorf("instance type = %q, want %q", vmConfig.InstanceType, gqlcloudcluster.GcpInstanceTypeN2Standard8)
+	}
+	if vmConfig.NodeSizeGB != 1024 {
+		t.Errorf("node size = %d, want 1024", vmConfig.NodeSizeGB)
+	}
+	if vmConfig.VMType != gqlcloudcluster.CCVmConfigDense {
+		t.Errorf("VM type = %q, want %q", vmConfig.VMType, gqlcloudcluster.CCVmConfigDense)
+	}
+
+	wantNetwork := []gqlcloudcluster.GcpSubnetInput{{
+		HostProject: "host-project",
+		Name:        "my-subnet",
+		Network:     "my-vpc",
+		Region:      "us-east1",
+	}}
+	if !slices.Equal(vmConfig.NetworkConfig, wantNetwork) {
+		t.Errorf("network config = %+v, want %+v", vmConfig.NetworkConfig, wantNetwork)
+	}
+
+	wantSubnetAzConfigs := []gqlcloudcluster.SubnetAzConfig{
+		{AvailabilityZone: "us-east1-b", Subnet: "my-subnet-b"},
+		{AvailabilityZone: "us-east1-c", Subnet: "my-subnet-c"},
+	}
+	if !slices.Equal(vmConfig.SubnetAzConfigs, wantSubnetAzConfigs) {
+		t.Errorf("subnet AZ configs = %+v, want %+v", vmConfig.SubnetAzConfigs, wantSubnetAzConfigs)
+	}
+
+	if n := len(vmConfig.ServiceAccounts); n != 1 {
+		t.Fatalf("expected 1 service account, got %d", n)
+	}
+	account := vmConfig.ServiceAccounts[0]
+	if account.Email != "cces@my-project.iam.gserviceaccount.com" {
+		t.Errorf("service account email = %q", account.Email)
+	}
+	if !slices.Equal(account.Scopes, []string{"https://www.googleapis.com/auth/cloud-platform"}) {
+		t.Errorf("service account scopes = %v", account.Scopes)
+	}
+}
@@ -23,6 +23,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -36,12 +37,34 @@ import (
 
 const resourceGCPExocomputeDescription = `
 The ´polaris_gcp_exocompute´ resource creates an RSC Exocompute configuration
-for GCP workloads. This resource should only be used with customer managed
-networking. Customer managed networking is used when the ´EXOCOMPUTE´ feature
-of the GCP project was onboarded without the ´AUTOMATED_NETWORKING_SETUP´
-permission group. If the GCP project was onboarded with the
-´AUTOMATED_NETWORKING_SETUP´ permission group, RSC will automatically create
-and manage the networking resources for Exocompute.
+for GCP workloads.
+
+There are 2 types of Exocompute configurations:
+ 1. *RSC Managed Host* - When an RSC managed host configuration is created, RSC
+    will automatically deploy the necessary resources in the specified GCP
+    region to run the Exocompute service. The networking resources can be
+    managed by RSC or by the customer.
+ 2. *Customer Managed Host* - When a customer managed host configuration is
+    created, RSC will not deploy any resources. Instead it will use the GCP GKE
+    cluster attached by the customer, using the
+    ´polaris_gcp_exocompute_cluster_attachment´ resource, for all operations.
+
+A ´regional_config´ block with the ´region´, ´subnet_name´ and ´vpc_name´ fields
+creates an RSC managed host configuration with customer managed networking.
+Customer managed networking is used when the ´EXOCOMPUTE´ feature of the GCP
+project was onboarded without the ´AUTOMATED_NETWORKING_SETUP´ permission group.
+If the GCP project was onboarded with the ´AUTOMATED_NETWORKING_SETUP´ permission
+group, RSC will automatically create and manage the networking resources for
+Exocompute.
+
+A ´regional_config´ block with the ´region´ field and ´customer_managed´ set to
+´true´ creates a customer managed host configuration. The ´subnet_name´ and
+´vpc_name´ fields cannot be specified for a customer managed host configuration.
+Note, the ´polaris_gcp_exocompute_cluster_attachment´ resource must be used to
+attach a GCP GKE cluster to the Exocompute configuration.
+
+-> **Note:** Customer managed Exocompute is sometimes referred to as Bring Your
+   Own Kubernetes (BYOK).
 `
 
 // This resource uses a template for its documentation, remember to update the
@@ -80,6 +103,7 @@ func resourceGcpExocompute() *schema.Resource {
 				Description: "Trigger a health check for the Exocompute configuration. Defaults to `false`.",
 			},
 		},
+		CustomizeDiff: gcpCustomizeDiffExocompute,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -89,6 +113,14 @@ func resourceGcpExocompute() *schema.Resource {
 func gcpRegionalConfigResource() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
+			keyCustomerManaged: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: "If true, the regional configuration is a customer managed host configuration, the " +
+					"GCP GKE cluster is attached using the `polaris_gcp_exocompute_cluster_attachment` resource. " +
+					"Conflicts with `subnet_name` and `vpc_name`. Defaults to `false`.",
+			},
 			keyRegion: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -97,15 +129,17 @@ func gcpRegionalConfigResource() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(gqlgcp.AllRegionNames(), false),
 			},
 			keySubnetName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Name of the GCP subnet to run the exocompute service in.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of the GCP subnet to run the exocompute service in. Required unless " +
+					"`customer_managed` is true.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 			keyVPCName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "Name of the GCP VPC to run the exocompute service in.",
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Name of the GCP VPC to run the exocompute service in. Required unless " +
+					"`customer_managed` is true.",
 				ValidateFunc: validation.StringIsNotWhiteSpace,
 			},
 		},
@@ -125,8 +159,12 @@ func gcpCreateExocompute(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
+	regionalConfigs, err := fromRegionalConfig(d.Get(keyRegionalConfig).(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	healthCheck := d.Get(keyTriggerHealthCheck).(bool)
-	err = exocompute.Wrap(client).UpdateGCPConfiguration(ctx, cloudAccountID, fromRegionalConfig(d), healthCheck)
+	err = exocompute.Wrap(client).UpdateGCPConfiguration(ctx, cloudAccountID, regionalConfigs, healthCheck)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -180,8 +218,12 @@ func gcpUpdateExocompute(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
+	regionalConfigs, err := fromRegionalConfig(d.Get(keyRegionalConfig).(*schema.Set))
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	healthCheck := d.Get(keyTriggerHealthCheck).(bool)
-	err = exocompute.Wrap(client).UpdateGCPConfiguration(ctx, cloudAccountID, fromRegionalConfig(d), healthCheck)
+	err = exocompute.Wrap(client).UpdateGCPConfiguration(ctx, cloudAccountID, regionalConfigs, healthCheck)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -211,27 +253,58 @@ func gcpDeleteExocompute(ctx context.Context, d *schema.ResourceData, m interfac
 	return nil
 }
 
-func fromRegionalConfig(d *schema.ResourceData) []exocompute.RegionalConfig {
+// gcpCustomizeDiffExocompute validates the regional configurations when they
+// are known at plan time.
+func gcpCustomizeDiffExocompute(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "gcpCustomizeDiffExocompute")
+
+	if !diff.NewValueKnown(keyRegionalConfig) {
+		return nil
+	}
+	_, err := fromRegionalConfig(diff.Get(keyRegionalConfig).(*schema.Set))
+	return err
+}
+
+// fromRegionalConfig converts the regional config blocks to SDK regional
+// configurations. A customer managed host configuration cannot have a subnet
+// or VPC, while an RSC managed host configuration requires both.
+func fromRegionalConfig(set *schema.Set) ([]exocompute.RegionalConfig, error) {
 	var configs []exocompute.RegionalConfig
-	for _, config := range d.Get(keyRegionalConfig).(*schema.Set).List() {
+	for _, config := range set.List() {
 		config := config.(map[string]any)
+		region := config[keyRegion].(string)
+		subnetName := config[keySubnetName].(string)
+		vpcName := config[keyVPCName].(string)
+		if config[keyCustomerManaged].(bool) {
+			if subnetName != "" || vpcName != "" {
+				return nil, fmt.Errorf("regional config for region %q: %q and %q cannot be specified for a customer managed host configuration",
+					region, keySubnetName, keyVPCName)
+			}
+		} else if subnetName == "" || vpcName == "" {
+			return nil, fmt.Errorf("regional config for region %q: %q and %q are required unless %q is true",
+				region, keySubnetName, keyVPCName, keyCustomerManaged)
+		}
 		configs = append(configs, exocompute.RegionalConfig{
-			Region:         gqlgcp.RegionFromName(config[keyRegion].(string)),
-			SubnetName:     config[keySubnetName].(string),
-			VPCNetworkName: config[keyVPCName].(string),
+			Region:         gqlgcp.RegionFromName(region),
+			SubnetName:     subnetName,
+			VPCNetworkName: vpcName,
 		})
 	}
 
-	return configs
+	return configs, nil
 }
 
+// toRegionalConfig converts the SDK Exocompute configurations to regional
+// config blocks. A configuration without a subnet and VPC is a customer managed
+// host configuration.
 func toRegionalConfig(exoConfigs []exocompute.GCPConfiguration) *schema.Set {
 	configs := &schema.Set{F: schema.HashResource(gcpRegionalConfigResource())}
 	for _, exoConfig := range exoConfigs {
 		configs.Add(map[string]any{
-			keyRegion:     exoConfig.Config.Region.Name(),
-			keySubnetName: exoConfig.Config.SubnetName,
-			keyVPCName:    exoConfig.Config.VPCNetworkName,
+			keyCustomerManaged: exoConfig.Config.SubnetName == "" && exoConfig.Config.VPCNetworkName == "",
+			keyRegion:          exoConfig.Config.Region.Name(),
+			keySubnetName:      exoConfig.Config.SubnetName,
+			keyVPCName:         exoConfig.Config.VPCNetworkName,
 		})
 	}
 
@@ -0,0 +1,273 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/exocompute"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlgcp "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/gcp"
+)
+
+const gcpExocomputeClusterAttachmentDescription = `
+The ´polaris_gcp_exocompute_cluster_attachment´ resource attaches a GCP GKE
+cluster to a customer managed host Exocompute configuration, allowing RSC to use
+the cluster for Exocompute operations.
+
+A customer managed host Exocompute configuration is created using the
+´polaris_gcp_exocompute´ resource with a ´regional_config´ block which has
+´customer_managed´ set to ´true´. The cluster is attached to the Exocompute
+configuration of the region.
+`
+
+// gcpExocomputeClusterConnectQuery is the GraphQL mutation used to connect a
+// GKE cluster to a GCP Exocompute configuration.
+const gcpExocomputeClusterConnectQuery = `mutation SdkGolangExocomputeClusterConnect($clusterName: String!, $exocomputeConfigId: UUID!) {
+    result: exocomputeClusterConnect(input: {
+        clusterName:        $clusterName,
+        cloudType:          GCP,
+        exocomputeConfigId: $exocomputeConfigId
+    }) {
+        clusterSetupYaml
+        clusterUuid
+    }
+}`
+
+// gcpExocomputeGetClusterConnectionInfoQuery is the GraphQL query used to
+// look up the connection information of a GKE cluster connected to a GCP
+// Exocompute configuration.
+const gcpExocomputeGetClusterConnectionInfoQuery = `query SdkGolangExocomputeGetClusterConnectionInfo($clusterName: String!, $exocomputeConfigId: UUID!) {
+    result: exocomputeGetClusterConnectionInfo(input: {
+        clusterName:        $clusterName,
+        cloudType:          GCP,
+        exocomputeConfigId: $exocomputeConfigId
+    }) {
+        clusterSetupYaml
+    }
+}`
+
+// gcpDisconnectExocomputeClusterQuery is the GraphQL mutation used to
+// disconnect a GKE cluster from a GCP Exocompute configuration.
+const gcpDisconnectExocomputeClusterQuery = `mutation SdkGolangDisconnectExocomputeCluster($clusterId: UUID!) {
+    result: disconnectExocomputeCluster(input: {
+        clusterId: $clusterId
+        cloudType: GCP
+    })
+}`
+
+// gcpClusterConnection holds the result of connecting a GKE cluster to a GCP
+// Exocompute configuration.
+type gcpClusterConnection struct {
+	ClusterID uuid.UUID `json:"clusterUuid"`
+	Manifest  string    `json:"clusterSetupYaml"`
+}
+
+type gcpClusterConnectionParams struct {
+	ClusterName string    `json:"clusterName"`
+	ConfigID    uuid.UUID `json:"exocomputeConfigId"`
+}
+
+// gcpExocomputeConfigIDByRegion returns the ID of the Exocompute configuration
+// for the specified region of the cloud account.
+func gcpExocomputeConfigIDByRegion(ctx context.Context, exoAPI exocompute.API, cloudAccountID uuid.UUID, region string) (uuid.UUID, error) {
+	exoConfigs, err := exoAPI.GCPConfigurationsByCloudAccountID(ctx, cloudAccountID, false)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, exoConfig := range exoConfigs {
+		if exoConfig.Config.Region.Name() == region {
+			return exoConfig.ID, nil
+		}
+	}
+
+	return uuid.Nil, fmt.Errorf("exocompute configuration for region %s: %w", region, graphql.ErrNotFound)
+}
+
+func resourceGcpExocomputeClusterAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: gcpCreateExocomputeClusterAttachment,
+		ReadContext:   gcpReadExocomputeClusterAttachment,
+		UpdateContext: gcpUpdateExocomputeClusterAttachment,
+		DeleteContext: gcpDeleteExocomputeClusterAttachment,
+
+		Description: description(gcpExocomputeClusterAttachmentDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RSC cluster ID (UUID).",
+			},
+			keyCloudAccountID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "RSC cloud account ID. This is the ID of the `polaris_gcp_exocompute` resource hosting " +
+					"the Exocompute configuration. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "GCP GKE cluster name. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyExocomputeID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RSC exocompute configuration ID (UUID).",
+			},
+			keyManifest: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Kubernetes manifest which can be passed to `kubectl apply` to create a connection " +
+					"between the cluster and RSC.",
+			},
+			keyRegion: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "GCP region of the Exocompute configuration. Should be specified in the standard GCP " +
+					"style, e.g. `us-east1`. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice(gqlgcp.AllRegionNames(), false),
+			},
+			keyTokenRefresh: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Description: "To force a refresh of the authentication token, part of the manifest, increase the " +
+					"value of this field. The token is valid for 24 hours.",
+			},
+		},
+	}
+}
+
+func gcpCreateExocomputeClusterAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpCreateExocomputeClusterAttachment")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudAccountID, err := uuid.Parse(d.Get(keyCloudAccountID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	region := d.Get(keyRegion).(string)
+	configID, err := gcpExocomputeConfigIDByRegion(ctx, exocompute.Wrap(client), cloudAccountID, region)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterName := d.Get(keyClusterName).(string)
+
+	var info gcpClusterConnection
+	err = gqlRequest(ctx, client.GQL, gcpExocomputeClusterConnectQuery, gcpClusterConnectionParams{
+		ClusterName: clusterName,
+		ConfigID:    configID,
+	}, &info)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// We initialize the resource fields in create instead of calling read,
+	// this is because the read operation will fail until the customer applies
+	// the manifest.
+	if err := d.Set(keyExocomputeID, configID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyManifest, info.Manifest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(info.ClusterID.String())
+	return nil
+}
+
+func gcpReadExocomputeClusterAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpReadExocomputeClusterAttachment")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configID, err := uuid.Parse(d.Get(keyExocomputeID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterName := d.Get(keyClusterName).(string)
+
+	var info gcpClusterConnection
+	err = gqlRequest(ctx, client.GQL, gcpExocomputeGetClusterConnectionInfoQuery, gcpClusterConnectionParams{
+		ClusterName: clusterName,
+		ConfigID:    configID,
+	}, &info)
+	if err != nil {
+		tflog.Warn(ctx, "failed to read cluster attachment", map[string]any{"err": err.Error()})
+		return nil
+	}
+	if err := d.Set(keyManifest, info.Manifest); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func gcpUpdateExocomputeClusterAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpUpdateExocomputeClusterAttachment")
+
+	if d.HasChange(keyTokenRefresh) {
+		return gcpCreateExocomputeClusterAttachment(ctx, d, m)
+	}
+
+	return nil
+}
+
+func gcpDeleteExocomputeClusterAttachment(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpDeleteExocomputeClusterAttachment")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct{}
+	err = gqlRequest(ctx, client.GQL, gcpDisconnectExocomputeClusterQuery, struct {
+		ClusterID uuid.UUID `json:"clusterId"`
+	}{ClusterID: id}, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,167 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	testGCPCloudAccountID = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01"
+	testGCPExoConfigID    = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a02"
+	testGCPClusterID      = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a03"
+)
+
+// gcpExocomputeConfigsResponse returns a gcpExocomputeConfigs response with a
+// single customer managed host configuration in the us-east1 region.
+func gcpExocomputeConfigsResponse() any {
+	return map[string]any{
+		"exocomputeConfigs": []any{map[string]any{
+			"configId": testGCPExoConfigID,
+			"regionalExocomputeConfig": map[string]any{
+				"region": "US_EAST1",
+			},
+		}},
+	}
+}
+
+func TestGCPCreateExocomputeClusterAttachment(t *testing.T) {
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		switch req.Operation {
+		case "SdkGolangGcpExocomputeConfigs":
+			return gcpExocomputeConfigsResponse(), nil
+		case "SdkGolangExocomputeClusterConnect":
+			return map[string]any{"clusterUuid": testGCPClusterID, "clusterSetupYaml": "manifest"}, nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpExocomputeClusterAttachment().Schema, map[string]any{
+		keyCloudAccountID: testGCPCloudAccountID,
+		keyClusterName:    "my-cluster",
+		keyRegion:         "us-east1",
+	})
+	if diags := gcpCreateExocomputeClusterAttachment(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if id := d.Id(); id != testGCPClusterID {
+		t.Errorf("expected ID %q, got %q", testGCPClusterID, id)
+	}
+	if id := d.Get(keyExocomputeID).(string); id != testGCPExoConfigID {
+		t.Errorf("expected exocompute ID %q, got %q", testGCPExoConfigID, id)
+	}
+	if manifest := d.Get(keyManifest).(string); manifest != "manifest" {
+		t.Errorf("expected manifest %q, got %q", "manifest", manifest)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(reqs))
+	}
+	if vars := reqs[1].Variables; vars["clusterName"] != "my-cluster" || vars["exocomputeConfigId"] != testGCPExoConfigID {
+		t.Errorf("unexpected connect variables: %v", vars)
+	}
+}
+
+func TestGCPCreateExocomputeClusterAttachmentNoRegionalConfig(t *testing.T) {
+	c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		if req.Operation == "SdkGolangGcpExocomputeConfigs" {
+			return gcpExocomputeConfigsResponse(), nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpExocomputeClusterAttachment().Schema, map[string]any{
+		keyCloudAccountID: testGCPCloudAccountID,
+		keyClusterName:    "my-cluster",
+		keyRegion:         "us-west1",
+	})
+	diags := gcpCreateExocomputeClusterAttachment(context.Background(), d, c)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "region us-west1") {
+		t.Fatalf("expected not found error for region us-west1, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no ID, got %q", d.Id())
+	}
+}
+
+func TestGCPReadExocomputeClusterAttachment(t *testing.T) {
+	tests := []struct {
+		name         string
+		err          error
+		wantManifest string
+	}{
+		{name: "Connected", wantManifest: "new-manifest"},
+		{name: "NotConnected", err: errors.New("cluster not connected"), wantManifest: "old-manifest"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				if tc.err != nil {
+					return nil, tc.err
+				}
+				return map[string]any{"clusterSetupYaml": "new-manifest"}, nil
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceGcpExocomputeClusterAttachment().Schema, map[string]any{
+				keyCloudAccountID: testGCPCloudAccountID,
+				keyClusterName:    "my-cluster",
+				keyExocomputeID:   testGCPExoConfigID,
+				keyManifest:       "old-manifest",
+				keyRegion:         "us-east1",
+			})
+			d.SetId(testGCPClusterID)
+			if diags := gcpReadExocomputeClusterAttachment(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if manifest := d.Get(keyManifest).(string); manifest != tc.wantManifest {
+				t.Errorf("expected manifest %q, got %q", tc.wantManifest, manifest)
+			}
+			if vars := srv.Requests()[0].Variables; vars["clusterName"] != "my-cluster" || vars["exocomputeConfigId"] != testGCPExoConfigID {
+				t.Errorf("unexpected variables: %v", vars)
+			}
+		})
+	}
+}
+
+func TestGCPDeleteExocomputeClusterAttachment(t *testing.T) {
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return nil, nil
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpExocomputeClusterAttachment().Schema, map[string]any{})
+	d.SetId(testGCPClusterID)
+	if diags := gcpDeleteExocomputeClusterAttachment(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected ID to be cleared, got %q", d.Id())
+	}
+	if vars := srv.Requests()[0].Variables; vars["clusterId"] != testGCPClusterID {
+		t.Errorf("unexpected variables: %v", vars)
+	}
+}
@@ -22,9 +22,14 @@ package provider
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/exocompute"
+	gqlexocompute "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/exocompute"
+	gqlgcp "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/regions/gcp"
 )
 
 var gcpExocomputeTmpl = `
@@ -103,12 +108,123 @@ func TestAccPolarisGCPExocompute_basic(t *testing.T) {
 				resource.TestCheckResourceAttrPair("polaris_gcp_exocompute.default", "cloud_account_id", "polaris_gcp_project.default", "id"),
 				resource.TestCheckResourceAttr("polaris_gcp_exocompute.default", "regional_config.#", "1"),
 				resource.TestCheckTypeSetElemNestedAttrs("polaris_gcp_exocompute.default", "regional_config.*", map[string]string{
-					"%":           "3",
-					"region":      project.Exocompute.Region,
-					"subnet_name": project.Exocompute.SubnetName,
-					"vpc_name":    project.Exocompute.VPCName,
+					"%":                "4",
+					"customer_managed": "false",
+					"region":           project.Exocompute.Region,
+					"subnet_name":      project.Exocompute.SubnetName,
+					"vpc_name":         project.Exocompute.VPCName,
 				}),
 			),
 		}},
 	})
 }
+
+func TestFromRegionalConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  map[string]any
+		want    exocompute.RegionalConfig
+		wantErr string
+	}{
+		{
+			name: "RSCManagedHost",
+			config: map[string]any{
+				keyRegion:     "us-east1",
+				keySubnetName: "subnet",
+				keyVPCName:    "vpc",
+			},
+			want: exocompute.RegionalConfig{
+				Region:         gqlgcp.RegionFromName("us-east1"),
+				SubnetName:     "subnet",
+				VPCNetworkName: "vpc",
+			},
+		},
+		{
+			name: "CustomerManagedHost",
+			config: map[string]any{
+				keyCustomerManaged: true,
+				keyRegion:          "us-east1",
+			},
+			want: exocompute.RegionalConfig{
+				Region: gqlgcp.RegionFromName("us-east1"),
+			},
+		},
+		{
+			name: "CustomerManagedHostWithSubnet",
+			config: map[string]any{
+				keyCustomerManaged: true,
+				keyRegion:          "us-east1",
+				keySubnetName:      "subnet",
+			},
+			wantErr: "cannot be specified for a customer managed host configuration",
+		},
+		{
+			name: "RSCManagedHostWithoutVPC",
+			config: map[string]any{
+				keyRegion:     "us-east1",
+				keySubnetName: "subnet",
+			},
+			wantErr: "are required unless",
+		},
+		{
+			name: "RSCManagedHostWithoutSubnetAndVPC",
+			config: map[string]any{
+				keyRegion: "us-east1",
+			},
+			wantErr: "are required unless",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceGcpExocompute().Schema, map[string]any{
+				keyCloudAccountID: "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01",
+				keyRegionalConfig: []any{tc.config},
+			})
+			configs, err := fromRegionalConfig(d.Get(keyRegionalConfig).(*schema.Set))
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(configs) != 1 {
+				t.Fatalf("expected 1 regional config, got %d", len(configs))
+			}
+			if configs[0] != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, configs[0])
+			}
+		})
+	}
+}
+
+func TestToRegionalConfig(t *testing.T) {
+	var rscManaged, customerManaged exocompute.GCPConfiguration
+	rscManaged.Config = gqlexocompute.GCPRegionalConfig{
+		Region:         gqlgcp.RegionFromName("us-east1").ToCloudAccountRegionEnum(),
+		SubnetName:     "subnet",
+		VPCNetworkName: "vpc",
+	}
+	customerManaged.Config = gqlexocompute.GCPRegionalConfig{
+		Region: gqlgcp.RegionFromName("us-west1").ToCloudAccountRegionEnum(),
+	}
+
+	configs := toRegionalConfig([]exocompute.GCPConfiguration{rscManaged, customerManaged})
+	if n := configs.Len(); n != 2 {
+		t.Fatalf("expected 2 regional configs, got %d", n)
+	}
+	byRegion := make(map[string]map[string]any)
+	for _, config := range configs.List() {
+		config := config.(map[string]any)
+		byRegion[config[keyRegion].(string)] = config
+	}
+	if config := byRegion["us-east1"]; config[keyCustomerManaged] != false || config[keySubnetName] != "subnet" || config[keyVPCName] != "vpc" {
+		t.Errorf("unexpected RSC managed host config: %v", config)
+	}
+	if config := byRegion["us-west1"]; config[keyCustomerManaged] != true || config[keySubnetName] != "" || config[keyVPCName] != "" {
+		t.Errorf("unexpected customer managed host config: %v", config)
+	}
+}
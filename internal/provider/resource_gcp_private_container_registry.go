@@ -0,0 +1,219 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+package provider
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/pcr"
+)
+
+const gcpPrivateContainerRegistryDescription = `
+The ´polaris_gcp_private_container_registry´ resource enables the private
+container registry (PCR) feature for the RSC customer account. This disables the
+standard Rubrik container registry.
+
+~> **Note:** Even though the ´polaris_gcp_private_container_registry´ resource ID
+   is an RSC cloud account ID, there can only be a single PCR per RSC customer
+   account.
+
+## Exocompute Image Bundles
+The ´polaris_exocompute_image_bundle´ data source can be used to retrieve
+information about the image bundles used by RSC for exocompute, e.g. the images
+which must be mirrored into the private container registry. The ´repo_url´ field
+holds the URL to the RSC container registry from where the RSC images can be
+pulled.
+`
+
+// gcpSetPrivateContainerRegistryQuery is the GraphQL mutation used to set the
+// private container registry for a GCP cloud account.
+const gcpSetPrivateContainerRegistryQuery = `mutation SdkGolangSetPrivateContainerRegistry($exocomputeAccountId: UUID!, $registryUrl: String!) {
+    result: setPrivateContainerRegistry(input: {
+        cloudType:           GCP,
+        exocomputeAccountId: $exocomputeAccountId,
+        registryUrl:         $registryUrl
+    })
+}`
+
+// gcpPrivateContainerRegistryQuery is the GraphQL query used to look up the
+// private container registry for a GCP cloud account.
+const gcpPrivateContainerRegistryQuery = `query SdkGolangPrivateContainerRegistry($exocomputeAccountId: UUID!) {
+    result: privateContainerRegistry(input: {
+        exocomputeAccountId: $exocomputeAccountId
+    }) {
+        pcrLatestApprovedBundleVersion
+        pcrDetails {
+            registryUrl
+        }
+    }
+}`
+
+type gcpRegistryParams struct {
+	CloudAccountID uuid.UUID `json:"exocomputeAccountId"`
+	RegistryURL    string    `json:"registryUrl,omitempty"`
+}
+
+// gcpRegistry holds the result of the privateContainerRegistry query for a GCP
+// cloud account.
+type gcpRegistry struct {
+	LatestApprovedBundleVersion string `json:"pcrLatestApprovedBundleVersion"`
+	PCRDetails                  struct {
+		RegistryURL string `json:"registryUrl"`
+	} `json:"pcrDetails"`
+}
+
+func resourceGcpPrivateContainerRegistry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: gcpCreatePrivateContainerRegistry,
+		ReadContext:   gcpReadPrivateContainerRegistry,
+		UpdateContext: gcpUpdatePrivateContainerRegistry,
+		DeleteContext: gcpDeletePrivateContainerRegistry,
+
+		Description: description(gcpPrivateContainerRegistryDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "RSC cloud account ID (UUID).",
+			},
+			keyCloudAccountID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "RSC cloud account ID (UUID) of the GCP project hosting the Exocompute. Changing " +
+					"this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyURL: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "URL for customer provided private container registry.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func gcpCreatePrivateContainerRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpCreatePrivateContainerRegistry")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Get(keyCloudAccountID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var result struct{}
+	err = gqlRequest(ctx, client.GQL, gcpSetPrivateContainerRegistryQuery, gcpRegistryParams{
+		CloudAccountID: id,
+		RegistryURL:    d.Get(keyURL).(string),
+	}, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(id.String())
+	gcpReadPrivateContainerRegistry(ctx, d, m)
+	return nil
+}
+
+func gcpReadPrivateContainerRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpReadPrivateContainerRegistry")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var pcrInfo gcpRegistry
+	err = gqlRequest(ctx, client.GQL, gcpPrivateContainerRegistryQuery, gcpRegistryParams{CloudAccountID: id}, &pcrInfo)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(keyCloudAccountID, id.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyURL, pcrInfo.PCRDetails.RegistryURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func gcpUpdatePrivateContainerRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpUpdatePrivateContainerRegistry")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var result struct{}
+	err = gqlRequest(ctx, client.GQL, gcpSetPrivateContainerRegistryQuery, gcpRegistryParams{
+		CloudAccountID: id,
+		RegistryURL:    d.Get(keyURL).(string),
+	}, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func gcpDeletePrivateContainerRegistry(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "gcpDeletePrivateContainerRegistry")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := pcr.Wrap(client).RemoveRegistry(ctx, id); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
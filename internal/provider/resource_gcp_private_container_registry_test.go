@@ -0,0 +1,110 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGCPCreatePrivateContainerRegistry(t *testing.T) {
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		switch req.Operation {
+		case "SdkGolangSetPrivateContainerRegistry":
+			return nil, nil
+		case "SdkGolangPrivateContainerRegistry":
+			return map[string]any{
+				"pcrLatestApprovedBundleVersion": "1.0",
+				"pcrDetails":                     map[string]any{"registryUrl": "gcr.io/my-project"},
+			}, nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpPrivateContainerRegistry().Schema, map[string]any{
+		keyCloudAccountID: testGCPCloudAccountID,
+		keyURL:            "gcr.io/my-project",
+	})
+	if diags := gcpCreatePrivateContainerRegistry(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if id := d.Id(); id != testGCPCloudAccountID {
+		t.Errorf("expected ID %q, got %q", testGCPCloudAccountID, id)
+	}
+	if url := d.Get(keyURL).(string); url != "gcr.io/my-project" {
+		t.Errorf("expected URL %q, got %q", "gcr.io/my-project", url)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(reqs))
+	}
+	if vars := reqs[0].Variables; vars["exocomputeAccountId"] != testGCPCloudAccountID || vars["registryUrl"] != "gcr.io/my-project" {
+		t.Errorf("unexpected set variables: %v", vars)
+	}
+	if _, ok := reqs[1].Variables["registryUrl"]; ok {
+		t.Errorf("expected no registryUrl variable for the query, got %v", reqs[1].Variables)
+	}
+}
+
+func TestGCPCreatePrivateContainerRegistryError(t *testing.T) {
+	c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return nil, errors.New("invalid registry URL")
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpPrivateContainerRegistry().Schema, map[string]any{
+		keyCloudAccountID: testGCPCloudAccountID,
+		keyURL:            "invalid",
+	})
+	diags := gcpCreatePrivateContainerRegistry(context.Background(), d, c)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "invalid registry URL") {
+		t.Fatalf("expected invalid registry URL error, got %v", diags)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no ID, got %q", d.Id())
+	}
+}
+
+func TestGCPReadPrivateContainerRegistry(t *testing.T) {
+	c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return map[string]any{
+			"pcrDetails": map[string]any{"registryUrl": "gcr.io/other-project"},
+		}, nil
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceGcpPrivateContainerRegistry().Schema, map[string]any{
+		keyURL: "gcr.io/my-project",
+	})
+	d.SetId(testGCPCloudAccountID)
+	if diags := gcpReadPrivateContainerRegistry(context.Background(), d, c); diags.HasError() {
+		t.Fatal(diags)
+	}
+	if id := d.Get(keyCloudAccountID).(string); id != testGCPCloudAccountID {
+		t.Errorf("expected cloud account ID %q, got %q", testGCPCloudAccountID, id)
+	}
+	if url := d.Get(keyURL).(string); url != "gcr.io/other-project" {
+		t.Errorf("expected URL %q, got %q", "gcr.io/other-project", url)
+	}
+}
@@ -0,0 +1,742 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/cluster"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core/secret"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+const resourceReplicationPairDescription = `
+The ´polaris_replication_pair´ resource creates a replication pair between two
+Rubrik clusters registered with RSC. The source cluster replicates snapshots to
+the target cluster, which makes the target cluster available as a replication
+target in the replication specification of an SLA domain.
+
+There are two setup types:
+  * ´NAT´ - The clusters communicate through NAT gateways. The gateway of both
+    the source and the target cluster must be specified.
+  * ´PRIVATE_NETWORK´ - The clusters communicate directly over a private
+    network. The address of the target cluster must be specified.
+
+The credentials of the target cluster are only used to set up the replication
+pair and are never read back from RSC.
+
+-> **Note:** A replication pair cannot be removed while SLA domains replicate to
+   the target cluster. Remove the replication specification from the SLA
+   domains before destroying the replication pair.
+`
+
+const (
+	replicationSetupTypeNAT            = "NAT"
+	replicationSetupTypePrivateNetwork = "PRIVATE_NETWORK"
+)
+
+// replicationPairsQuery is the GraphQL query used to list replication pairs
+// between Rubrik clusters.
+const replicationPairsQuery = `query SdkGolangReplicationPairs($after: String, $filter: ReplicationPairsQueryFilter) {
+    result: replicationPairs(after: $after, filter: $filter) {
+        edges {
+            node {
+                sourceCluster {
+                    id
+                    name
+                    version
+                }
+                targetCluster {
+                    id
+                    name
+                    version
+                }
+                status
+                setupType
+                targetClusterAddress
+                sourceGateway {
+                    address
+                    ports
+                }
+                targetGateway {
+                    address
+                    ports
+                }
+                bandwidthThrottle {
+                    defaultLimitMbps
+                    schedules {
+                        dayOfWeek
+                        startTime
+                        endTime
+                        limitMbps
+                    }
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// addReplicationPairQuery is the GraphQL mutation used to add a replication
+// pair between two Rubrik clusters.
+const addReplicationPairQuery = `mutation SdkGolangAddReplicationPair($input: AddReplicationPairInput!) {
+    result: addReplicationPair(input: $input) {
+        sourceClusterUuid
+        targetClusterUuid
+    }
+}`
+
+// updateReplicationPairQuery is the GraphQL mutation used to update a
+// replication pair between two Rubrik clusters.
+const updateReplicationPairQuery = `mutation SdkGolangUpdateReplicationPair($input: UpdateReplicationPairInput!) {
+    result: updateReplicationPair(input: $input) {
+        sourceClusterUuid
+        targetClusterUuid
+    }
+}`
+
+// removeReplicationPairQuery is the GraphQL mutation used to remove a
+// replication pair between two Rubrik clusters.
+const removeReplicationPairQuery = `mutation SdkGolangRemoveReplicationPair($input: RemoveReplicationPairInput!) {
+    result: removeReplicationPair(input: $input) {
+        sourceClusterUuid
+        targetClusterUuid
+    }
+}`
+
+// replicationCluster holds the cluster information of a replication pair.
+type replicationCluster struct {
+	ID      uuid.UUID `json:"id"`
+	Name    string    `json:"name"`
+	Version string    `json:"version"`
+}
+
+// replicationGateway holds the NAT gateway of a cluster in a replication
+// pair.
+type replicationGateway struct {
+	Address string `json:"address"`
+	Ports   []int  `json:"ports"`
+}
+
+// replicationThrottleSchedule holds a scheduled bandwidth limit of a
+// replication pair.
+type replicationThrottleSchedule struct {
+	DayOfWeek string `json:"dayOfWeek"`
+	StartTime string `json:"startTime"`
+	EndTime   string `json:"endTime"`
+	LimitMbps int    `json:"limitMbps"`
+}
+
+// replicationBandwidthThrottle holds the bandwidth throttle of a replication
+// pair.
+type replicationBandwidthThrottle struct {
+	DefaultLimitMbps int                           `json:"defaultLimitMbps"`
+	Schedules        []replicationThrottleSchedule `json:"schedules"`
+}
+
+// replicationPair holds a replication pair between two Rubrik clusters.
+type replicationPair struct {
+	SourceCluster        replicationCluster            `json:"sourceCluster"`
+	TargetCluster        replicationCluster            `json:"targetCluster"`
+	Status               string                        `json:"status"`
+	SetupType            string                        `json:"setupType"`
+	TargetClusterAddress string                        `json:"targetClusterAddress"`
+	SourceGateway        *replicationGateway           `json:"sourceGateway"`
+	TargetGateway        *replicationGateway           `json:"targetGateway"`
+	BandwidthThrottle    *replicationBandwidthThrottle `json:"bandwidthThrottle"`
+}
+
+// replicationPairParams holds the parameters for a replication pair add and
+// update operation. The update operation doesn't support changing the
+// clusters or the setup type.
+type replicationPairParams struct {
+	SourceClusterID      uuid.UUID                     `json:"sourceClusterUuid"`
+	TargetClusterID      uuid.UUID                     `json:"targetClusterUuid"`
+	SetupType            string                        `json:"setupType,omitempty"`
+	TargetClusterAddress string                        `json:"targetClusterAddress,omitempty"`
+	Username             string                        `json:"username"`
+	Password             secret.String                 `json:"password"`
+	SourceGateway        *replicationGateway           `json:"sourceGateway,omitempty"`
+	TargetGateway        *replicationGateway           `json:"targetGateway,omitempty"`
+	BandwidthThrottle    *replicationBandwidthThrottle `json:"bandwidthThrottle"`
+}
+
+// replicationPairs returns the replication pairs matching the specified
+// source and target cluster IDs. A nil cluster ID matches all clusters.
+func replicationPairs(ctx context.Context, gql *graphql.Client, sourceClusterID, targetClusterID *uuid.UUID) ([]replicationPair, error) {
+	type filter struct {
+		SourceClusterIDs []uuid.UUID `json:"sourceClusterUuids,omitempty"`
+		TargetClusterIDs []uuid.UUID `json:"targetClusterUuids,omitempty"`
+	}
+	var f filter
+	if sourceClusterID != nil {
+		f.SourceClusterIDs = []uuid.UUID{*sourceClusterID}
+	}
+	if targetClusterID != nil {
+		f.TargetClusterIDs = []uuid.UUID{*targetClusterID}
+	}
+
+	var pairs []replicationPair
+	var cursor string
+	for {
+		var result struct {
+			Edges []struct {
+				Node replicationPair `json:"node"`
+			} `json:"edges"`
+			PageInfo struct {
+				EndCursor   string `json:"endCursor"`
+				HasNextPage bool   `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		}
+		if err := gqlRequest(ctx, gql, replicationPairsQuery, struct {
+			After  string `json:"after,omitempty"`
+			Filter filter `json:"filter"`
+		}{After: cursor, Filter: f}, &result); err != nil {
+			return nil, err
+		}
+		for _, edge := range result.Edges {
+			pairs = append(pairs, edge.Node)
+		}
+		if !result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = result.PageInfo.EndCursor
+	}
+
+	return pairs, nil
+}
+
+// replicationPairByClusterIDs returns the replication pair between the
+// specified source and target clusters. If no replication pair exists between
+// the clusters, graphql.ErrNotFound is returned.
+func replicationPairByClusterIDs(ctx context.Context, gql *graphql.Client, sourceClusterID, targetClusterID uuid.UUID) (replicationPair, error) {
+	pairs, err := replicationPairs(ctx, gql, &sourceClusterID, &targetClusterID)
+	if err != nil {
+		return replicationPair{}, fmt.Errorf("failed to list replication pairs: %s", err)
+	}
+	for _, pair := range pairs {
+		if pair.SourceCluster.ID == sourceClusterID && pair.TargetCluster.ID == targetClusterID {
+			return pair, nil
+		}
+	}
+
+	return replicationPair{}, fmt.Errorf("replication pair from %q to %q %w", sourceClusterID, targetClusterID,
+		graphql.ErrNotFound)
+}
+
+func resourceReplicationPair() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createReplicationPair,
+		ReadContext:   readReplicationPair,
+		UpdateContext: updateReplicationPair,
+		DeleteContext: deleteReplicationPair,
+
+		CustomizeDiff: customizeDiffReplicationPair,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Description: description(resourceReplicationPairDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:     schema.TypeString,
+				Computed: true,
+				Description: "Replication pair ID. The ID is the source cluster ID and the target cluster ID " +
+					"separated by a colon, e.g. `<source_cluster_id>:<target_cluster_id>`.",
+			},
+			keyBandwidthThrottle: {
+				Type:     schema.TypeList,
+				Elem:     replicationBandwidthThrottleResource(),
+				MaxItems: 1,
+				Optional: true,
+				Description: "Bandwidth throttle for the replication traffic from the source cluster to the " +
+					"target cluster. If not specified, the replication traffic isn't throttled.",
+			},
+			keyPassword: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Password of the target cluster user.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keySetupType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Replication setup type. Possible values are `NAT` and `PRIVATE_NETWORK`. Changing " +
+					"this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice([]string{
+					replicationSetupTypeNAT, replicationSetupTypePrivateNetwork,
+				}, false),
+			},
+			keySourceClusterID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Source Rubrik cluster ID (UUID). Changing this forces a new resource to be " +
+					"created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keySourceClusterName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Source Rubrik cluster name.",
+			},
+			keySourceGateway: replicationGatewaySchema("NAT gateway of the source cluster. Required when "+
+				"`setup_type` is `NAT`.", keyTargetClusterAddress),
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Connection status of the replication pair.",
+			},
+			keyTargetClusterAddress: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "IP address or FQDN of the target cluster on the private network. Required when " +
+					"`setup_type` is `PRIVATE_NETWORK`.",
+				ConflictsWith: []string{keySourceGateway, keyTargetGateway},
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+			},
+			keyTargetClusterID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Target Rubrik cluster ID (UUID). Changing this forces a new resource to be " +
+					"created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyTargetClusterName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Target Rubrik cluster name.",
+			},
+			keyTargetGateway: replicationGatewaySchema("NAT gateway of the target cluster. Required when "+
+				"`setup_type` is `NAT`.", keyTargetClusterAddress),
+			keyUsername: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Username of a target cluster user with permission to set up replication.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+		},
+	}
+}
+
+// replicationGatewaySchema returns the schema for the NAT gateway of a
+// cluster in a replication pair.
+func replicationGatewaySchema(description string, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeList,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				keyAddress: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "Public IP address or FQDN of the NAT gateway.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				keyPorts: {
+					Type: schema.TypeList,
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IsPortNumber,
+					},
+					Required:    true,
+					MinItems:    1,
+					Description: "Ports forwarded by the NAT gateway to the cluster.",
+				},
+			},
+		},
+		MaxItems:      1,
+		Optional:      true,
+		Description:   description,
+		ConflictsWith: conflictsWith,
+	}
+}
+
+// replicationBandwidthThrottleResource returns the schema resource for the
+// bandwidth throttle of a replication pair.
+func replicationBandwidthThrottleResource() *schema.Resource {
+	timeOfDay := validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+		"must be a time of day in 24-hour format, e.g. 22:30")
+
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			keyDefaultLimit: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+				Description: "Default bandwidth limit in Mbps. Applies outside of the scheduled limits. A value " +
+					"of `0` means that the bandwidth isn't limited. Default value is `0`.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			keySchedule: {
+				Type: schema.TypeList,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						keyDayOfWeek: {
+							Type:     schema.TypeString,
+							Required: true,
+							Description: "Day of week. Possible values are `MONDAY`, `TUESDAY`, `WEDNESDAY`, " +
+								"`THURSDAY`, `FRIDAY`, `SATURDAY` and `SUNDAY`.",
+							ValidateFunc: validation.StringInSlice([]string{
+								string(gqlsla.Monday),
+								string(gqlsla.Tuesday),
+								string(gqlsla.Wednesday),
+								string(gqlsla.Thursday),
+								string(gqlsla.Friday),
+								string(gqlsla.Saturday),
+								string(gqlsla.Sunday),
+							}, false),
+						},
+						keyEndTime: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "End time of the scheduled limit in 24-hour format, e.g. `06:00`.",
+							ValidateFunc: timeOfDay,
+						},
+						keyLimit: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  "Bandwidth limit in Mbps during the scheduled time.",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						keyStartTime: {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Start time of the scheduled limit in 24-hour format, e.g. `22:00`.",
+							ValidateFunc: timeOfDay,
+						},
+					},
+				},
+				Optional:    true,
+				Description: "Scheduled bandwidth limits, overriding the default limit during the scheduled time.",
+			},
+		},
+	}
+}
+
+func createReplicationPair(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "createReplicationPair")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceClusterID, err := uuid.Parse(d.Get(keySourceClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetClusterID, err := uuid.Parse(d.Get(keyTargetClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := fromReplicationPair(d, sourceClusterID, targetClusterID)
+	params.SetupType = d.Get(keySetupType).(string)
+	var result any
+	if err := gqlRequest(ctx, client.GQL, addReplicationPairQuery, struct {
+		Input replicationPairParams `json:"input"`
+	}{Input: params}, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(sourceClusterID.String() + ":" + targetClusterID.String())
+	readReplicationPair(ctx, d, m)
+	return nil
+}
+
+func readReplicationPair(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "readReplicationPair")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceClusterID, targetClusterID, err := parseReplicationPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	pair, err := replicationPairByClusterIDs(ctx, client.GQL, sourceClusterID, targetClusterID)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(keySourceClusterID, pair.SourceCluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySourceClusterName, pair.SourceCluster.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyTargetClusterID, pair.TargetCluster.ID.String()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyTargetClusterName, pair.TargetCluster.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySetupType, pair.SetupType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, pair.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyTargetClusterAddress, pair.TargetClusterAddress); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keySourceGateway, toReplicationGateway(pair.SourceGateway)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyTargetGateway, toReplicationGateway(pair.TargetGateway)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyBandwidthThrottle, toReplicationBandwidthThrottle(pair.BandwidthThrottle)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateReplicationPair(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "updateReplicationPair")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceClusterID, targetClusterID, err := parseReplicationPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(keyBandwidthThrottle, keyPassword, keySourceGateway, keyTargetClusterAddress, keyTargetGateway,
+		keyUsername) {
+		var result any
+		if err := gqlRequest(ctx, client.GQL, updateReplicationPairQuery, struct {
+			Input replicationPairParams `json:"input"`
+		}{Input: fromReplicationPair(d, sourceClusterID, targetClusterID)}, &result); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	readReplicationPair(ctx, d, m)
+	return nil
+}
+
+func deleteReplicationPair(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "deleteReplicationPair")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sourceClusterID, targetClusterID, err := parseReplicationPairID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Removing the replication pair while SLA domains replicate to the target
+	// cluster would break the replication of those SLA domains.
+	info, err := cluster.Wrap(client).VerifySLAReplication(ctx, targetClusterID, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if info.IsActiveSLA {
+		return diag.Errorf("cannot remove replication pair: SLA domains replicate to target cluster %q, remove "+
+			"the replication specification from the SLA domains first", targetClusterID)
+	}
+
+	var result any
+	if err := gqlRequest(ctx, client.GQL, removeReplicationPairQuery, struct {
+		Input replicationPairParams `json:"input"`
+	}{Input: replicationPairParams{
+		SourceClusterID: sourceClusterID,
+		TargetClusterID: targetClusterID,
+	}}, &result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func customizeDiffReplicationPair(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffReplicationPair")
+
+	sourceClusterID := diff.Get(keySourceClusterID).(string)
+	targetClusterID := diff.Get(keyTargetClusterID).(string)
+	if sourceClusterID != "" && sourceClusterID == targetClusterID {
+		return fmt.Errorf("%s and %s must refer to different clusters", keySourceClusterID, keyTargetClusterID)
+	}
+
+	switch diff.Get(keySetupType).(string) {
+	case replicationSetupTypeNAT:
+		if len(diff.Get(keySourceGateway).([]any)) == 0 || len(diff.Get(keyTargetGateway).([]any)) == 0 {
+			return fmt.Errorf("%s and %s are required when %s is %s", keySourceGateway, keyTargetGateway,
+				keySetupType, replicationSetupTypeNAT)
+		}
+	case replicationSetupTypePrivateNetwork:
+		if diff.Get(keyTargetClusterAddress).(string) == "" && diff.NewValueKnown(keyTargetClusterAddress) {
+			return fmt.Errorf("%s is required when %s is %s", keyTargetClusterAddress, keySetupType,
+				replicationSetupTypePrivateNetwork)
+		}
+	}
+
+	return nil
+}
+
+// parseReplicationPairID parses the replication pair ID into the source and
+// target cluster IDs.
+func parseReplicationPairID(id string) (uuid.UUID, uuid.UUID, error) {
+	sourceID, targetID, ok := strings.Cut(id, ":")
+	if !ok {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid replication pair ID %q, expected "+
+			"<source_cluster_id>:<target_cluster_id>", id)
+	}
+	sourceClusterID, err := uuid.Parse(sourceID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid source cluster ID %q: %s", sourceID, err)
+	}
+	targetClusterID, err := uuid.Parse(targetID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("invalid target cluster ID %q: %s", targetID, err)
+	}
+
+	return sourceClusterID, targetClusterID, nil
+}
+
+// fromReplicationPair returns the replication pair parameters of the resource
+// configuration. The setup type is not included since it cannot be updated.
+func fromReplicationPair(d *schema.ResourceData, sourceClusterID, targetClusterID uuid.UUID) replicationPairParams {
+	return replicationPairParams{
+		SourceClusterID:      sourceClusterID,
+		TargetClusterID:      targetClusterID,
+		TargetClusterAddress: d.Get(keyTargetClusterAddress).(string),
+		Username:             d.Get(keyUsername).(string),
+		Password:             secret.String(d.Get(keyPassword).(string)),
+		SourceGateway:        fromReplicationGateway(d.Get(keySourceGateway).([]any)),
+		TargetGateway:        fromReplicationGateway(d.Get(keyTargetGateway).([]any)),
+		BandwidthThrottle:    fromReplicationBandwidthThrottle(d.Get(keyBandwidthThrottle).([]any)),
+	}
+}
+
+// fromReplicationGateway converts the gateway block of the resource
+// configuration to a replication gateway.
+func fromReplicationGateway(block []any) *replicationGateway {
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+
+	gateway := block[0].(map[string]any)
+	var ports []int
+	for _, port := range gateway[keyPorts].([]any) {
+		ports = append(ports, port.(int))
+	}
+	return &replicationGateway{
+		Address: gateway[keyAddress].(string),
+		Ports:   ports,
+	}
+}
+
+// toReplicationGateway converts the replication gateway to the gateway block
+// of the resource.
+func toReplicationGateway(gateway *replicationGateway) []any {
+	if gateway == nil {
+		return nil
+	}
+
+	ports := make([]any, 0, len(gateway.Ports))
+	for _, port := range gateway.Ports {
+		ports = append(ports, port)
+	}
+	return []any{map[string]any{
+		keyAddress: gateway.Address,
+		keyPorts:   ports,
+	}}
+}
+
+// fromReplicationBandwidthThrottle converts the bandwidth throttle block of
+// the resource configuration to a replication bandwidth throttle.
+func fromReplicationBandwidthThrottle(block []any) *replicationBandwidthThrottle {
+	if len(block) == 0 || block[0] == nil {
+		return nil
+	}
+
+	throttle := block[0].(map[string]any)
+	var schedules []replicationThrottleSchedule
+	for _, schedule := range throttle[keySchedule].([]any) {
+		schedule := schedule.(map[string]any)
+		schedules = append(schedules, replicationThrottleSchedule{
+			DayOfWeek: schedule[keyDayOfWeek].(string),
+			StartTime: schedule[keyStartTime].(string),
+			EndTime:   schedule[keyEndTime].(string),
+			LimitMbps: schedule[keyLimit].(int),
+		})
+	}
+	return &replicationBandwidthThrottle{
+		DefaultLimitMbps: throttle[keyDefaultLimit].(int),
+		Schedules:        schedules,
+	}
+}
+
+// toReplicationBandwidthThrottle converts the replication bandwidth throttle
+// to the bandwidth throttle block of the resource. A throttle without limits
+// is treated as no throttle.
+func toReplicationBandwidthThrottle(throttle *replicationBandwidthThrottle) []any {
+	if throttle == nil || (throttle.DefaultLimitMbps == 0 && len(throttle.Schedules) == 0) {
+		return nil
+	}
+
+	schedules := make([]any, 0, len(throttle.Schedules))
+	for _, schedule := range throttle.Schedules {
+		schedules = append(schedules, map[string]any{
+			keyDayOfWeek: schedule.DayOfWeek,
+			keyStartTime: schedule.StartTime,
+			keyEndTime:   schedule.EndTime,
+			keyLimit:     schedule.LimitMbps,
+		})
+	}
+	return []any{map[string]any{
+		keyDefaultLimit: throttle.DefaultLimitMbps,
+		keySchedule:     schedules,
+	}}
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// TestParseReplicationPairID verifies that the replication pair ID is parsed
+// into the source and target cluster IDs.
+func TestParseReplicationPairID(t *testing.T) {
+	sourceClusterID := uuid.MustParse("0b22f6b6-6ac0-4d5c-8e21-12ab0d5ab3c2")
+	targetClusterID := uuid.MustParse("a9e1f58d-1b52-4a3f-b1d4-54f1d6c1e8b7")
+
+	source, target, err := parseReplicationPairID(sourceClusterID.String() + ":" + targetClusterID.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if source != sourceClusterID {
+		t.Errorf("source cluster ID = %s, want %s", source, sourceClusterID)
+	}
+	if target != targetClusterID {
+		t.Errorf("target cluster ID = %s, want %s", target, targetClusterID)
+	}
+
+	for _, id := range []string{"", sourceClusterID.String(), "not-a-uuid:" + targetClusterID.String()} {
+		if _, _, err := parseReplicationPairID(id); err == nil {
+			t.Errorf("expected error for ID %q", id)
+		}
+	}
+}
+
+// TestReplicationBandwidthThrottle verifies that the bandwidth throttle block
+// is converted to and from the replication bandwidth throttle.
+func TestReplicationBandwidthThrottle(t *testing.T) {
+	res := resourceReplicationPair()
+
+	d := schema.TestResourceDataRaw(t, res.Schema, map[string]any{
+		keyBandwidthThrottle: []any{
+			map[string]any{
+				keyDefaultLimit: 500,
+				keySchedule: []any{
+					map[string]any{
+						keyDayOfWeek: "MONDAY",
+						keyStartTime: "08:00",
+						keyEndTime:   "18:00",
+						keyLimit:     100,
+					},
+				},
+			},
+		},
+	})
+
+	throttle := fromReplicationBandwidthThrottle(d.Get(keyBandwidthThrottle).([]any))
+	if throttle == nil {
+		t.Fatal("expected a bandwidth throttle")
+	}
+	if throttle.DefaultLimitMbps != 500 {
+		t.Errorf("default limit = %d, want 500", throttle.DefaultLimitMbps)
+	}
+	if len(throttle.Schedules) != 1 || throttle.Schedules[0].LimitMbps != 100 {
+		t.Fatalf("unexpected schedules: %v", throttle.Schedules)
+	}
+
+	if err := d.Set(keyBandwidthThrottle, toReplicationBandwidthThrottle(throttle)); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get(keyBandwidthThrottle + ".0." + keySchedule + ".0." + keyStartTime); got != "08:00" {
+		t.Errorf("start time = %v, want 08:00", got)
+	}
+
+	if block := toReplicationBandwidthThrottle(&replicationBandwidthThrottle{}); block != nil {
+		t.Errorf("expected no bandwidth throttle block, got %v", block)
+	}
+}
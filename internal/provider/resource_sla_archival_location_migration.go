@@ -0,0 +1,330 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+const resourceSLAArchivalLocationMigrationDescription = `
+The ´polaris_sla_archival_location_migration´ resource migrates the archival of
+an SLA domain from one archival location to another. After the migration, new
+snapshots of objects protected by the SLA domain are archived to the target
+archival location.
+
+How the existing archived snapshots are handled is specified by the
+´existing_snapshots´ field:
+  * ´REPOINT´ - The existing archived snapshots are re-pointed to the target
+    archival location. The data of the source archival location must have been
+    copied to the target archival location before the migration, e.g., using
+    bucket replication. After the migration, RSC recovers and expires the
+    snapshots using the target archival location. This is the default.
+  * ´RETAIN´ - The existing archived snapshots remain in the source archival
+    location and are expired according to the retention of the SLA domain. The
+    source archival location must not be removed until all snapshots have
+    expired.
+
+The migration is performed when the resource is created. All fields, except
+´wait_for_completion´, are ´ForceNew´, so changing any field performs a new
+migration. If the SLA domain no longer archives to the target archival
+location, the resource is removed from the state and the migration is performed
+again on the next apply. Destroying the resource doesn't revert the migration.
+
+When ´wait_for_completion´ is ´false´, the resource returns as soon as the
+migration has started. The ID of the resource is the task chain ID of the
+migration, which can be waited for using the ´polaris_task_wait´ resource with
+´task_type´ set to ´TASK_CHAIN´.
+
+-> **Note:** If the SLA domain is managed by a ´polaris_sla_domain´ resource,
+   the ´archival_location_id´ field of the SLA domain should be updated to the
+   target archival location after the migration.
+
+The default create timeout is 60 minutes and can be overridden with a
+´timeouts´ block.
+`
+
+// Existing snapshot handling for archival location migrations.
+const (
+	existingSnapshotsRepoint = "REPOINT"
+	existingSnapshotsRetain  = "RETAIN"
+)
+
+// migrateSLAArchivalLocationQuery is the GraphQL mutation used to migrate the
+// archival of an SLA domain from one archival location to another.
+const migrateSLAArchivalLocationQuery = `mutation SdkGolangMigrateSlaArchivalLocation($slaId: UUID!, $sourceLocationId: UUID!, $targetLocationId: UUID!, $existingSnapshots: ExistingSnapshotsActionType!) {
+    result: migrateSlaArchivalLocation(input: {
+        slaId: $slaId
+        sourceLocationId: $sourceLocationId
+        targetLocationId: $targetLocationId
+        existingSnapshotsAction: $existingSnapshots
+    }) {
+        taskchainId
+    }
+}`
+
+// slaArchivalLocationMigrationParams holds the parameters of an SLA archival
+// location migration.
+type slaArchivalLocationMigrationParams struct {
+	SLAID             uuid.UUID `json:"slaId"`
+	SourceLocationID  uuid.UUID `json:"sourceLocationId"`
+	TargetLocationID  uuid.UUID `json:"targetLocationId"`
+	ExistingSnapshots string    `json:"existingSnapshots"`
+}
+
+// migrateSLAArchivalLocation starts the migration of the archival of an SLA
+// domain from one archival location to another. Returns the task chain ID of
+// the migration.
+func migrateSLAArchivalLocation(ctx context.Context, gql *graphql.Client, params slaArchivalLocationMigrationParams) (uuid.UUID, error) {
+	var result struct {
+		TaskChainID uuid.UUID `json:"taskchainId"`
+	}
+	if err := gqlRequest(ctx, gql, migrateSLAArchivalLocationQuery, params, &result); err != nil {
+		return uuid.Nil, err
+	}
+	if result.TaskChainID == uuid.Nil {
+		return uuid.Nil, fmt.Errorf("migration of SLA domain %s didn't return a task chain ID", params.SLAID)
+	}
+
+	return result.TaskChainID, nil
+}
+
+func resourceSLAArchivalLocationMigration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createSLAArchivalLocationMigration,
+		ReadContext:   readSLAArchivalLocationMigration,
+		UpdateContext: updateSLAArchivalLocationMigration,
+		DeleteContext: deleteSLAArchivalLocationMigration,
+		CustomizeDiff: customizeDiffSLAArchivalLocationMigration,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Description: description(resourceSLAArchivalLocationMigrationDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Task chain ID (UUID) of the migration.",
+			},
+			keyExistingSnapshots: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  existingSnapshotsRepoint,
+				Description: "How the existing archived snapshots are handled. Possible values are `REPOINT` and " +
+					"`RETAIN`. Default value is `REPOINT`. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice([]string{existingSnapshotsRepoint, existingSnapshotsRetain}, false),
+			},
+			keySLADomainID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "SLA domain ID (UUID). Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keySourceLocationID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Archival location ID (UUID) of the archival location to migrate from. Changing this " +
+					"forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyTargetLocationID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Archival location ID (UUID) of the archival location to migrate to. Changing this " +
+					"forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyWaitForCompletion: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: "Flag to determine if Terraform should wait for the migration to complete. When " +
+					"`false`, Terraform returns as soon as the migration has started. Default value is `true`.",
+			},
+		},
+	}
+}
+
+func createSLAArchivalLocationMigration(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "createSLAArchivalLocationMigration")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slaID, err := uuid.Parse(d.Get(keySLADomainID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sourceLocationID, err := uuid.Parse(d.Get(keySourceLocationID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetLocationID, err := uuid.Parse(d.Get(keyTargetLocationID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Make sure the SLA domain archives to the source archival location before
+	// starting the migration.
+	slaDomain, err := sla.Wrap(client).DomainByID(ctx, slaID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !slaDomainArchivesTo(slaDomain, sourceLocationID) {
+		return diag.Errorf("SLA domain %q doesn't archive to archival location %s", slaDomain.Name, sourceLocationID)
+	}
+
+	taskChainID, err := migrateSLAArchivalLocation(ctx, client.GQL, slaArchivalLocationMigrationParams{
+		SLAID:             slaID,
+		SourceLocationID:  sourceLocationID,
+		TargetLocationID:  targetLocationID,
+		ExistingSnapshots: d.Get(keyExistingSnapshots).(string),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get(keyWaitForCompletion).(bool) {
+		state, err := core.Wrap(client.GQL).WaitForTaskChain(ctx, taskChainID, 10*time.Second)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if state != core.TaskChainSucceeded {
+			return diag.Errorf("migration of SLA domain %q from archival location %s to %s failed with task "+
+				"chain state %s", slaDomain.Name, sourceLocationID, targetLocationID, state)
+		}
+	}
+
+	d.SetId(taskChainID.String())
+	readSLAArchivalLocationMigration(ctx, d, m)
+	return nil
+}
+
+func readSLAArchivalLocationMigration(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "readSLAArchivalLocationMigration")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slaID, err := uuid.Parse(d.Get(keySLADomainID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	targetLocationID, err := uuid.Parse(d.Get(keyTargetLocationID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// If the SLA domain has been removed or no longer archives to the target
+	// archival location, we remove the migration from the local state.
+	slaDomain, err := sla.Wrap(client).DomainByID(ctx, slaID)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !slaDomainArchivesTo(slaDomain, targetLocationID) {
+		// A migration which hasn't finished yet is kept in the local state.
+		state, err := taskStatus(ctx, client.GQL, taskTypeTaskChain, d.Id(), "")
+		if err != nil && !errors.Is(err, graphql.ErrNotFound) {
+			return diag.FromErr(err)
+		}
+		if err == nil && !state.Done {
+			return nil
+		}
+		d.SetId("")
+		return nil
+	}
+
+	return nil
+}
+
+func updateSLAArchivalLocationMigration(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "updateSLAArchivalLocationMigration")
+
+	// Only wait_for_completion can be updated, which doesn't affect a migration
+	// which has already been started.
+	return readSLAArchivalLocationMigration(ctx, d, m)
+}
+
+func deleteSLAArchivalLocationMigration(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "deleteSLAArchivalLocationMigration")
+
+	// The migration can't be reverted, so we only remove the migration from
+	// the local state.
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffSLAArchivalLocationMigration validates the archival location
+// migration.
+func customizeDiffSLAArchivalLocationMigration(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffSLAArchivalLocationMigration")
+
+	sourceLocationID := diff.Get(keySourceLocationID).(string)
+	targetLocationID := diff.Get(keyTargetLocationID).(string)
+	if sourceLocationID != "" && sourceLocationID == targetLocationID {
+		return fmt.Errorf("%s and %s must refer to different archival locations", keySourceLocationID, keyTargetLocationID)
+	}
+
+	return nil
+}
+
+// slaDomainArchivesTo returns true if the SLA domain archives to the archival
+// location with the specified ID.
+func slaDomainArchivesTo(slaDomain gqlsla.Domain, locationID uuid.UUID) bool {
+	for _, spec := range slaDomain.ArchivalSpecs {
+		if spec.StorageSetting.ID == locationID.String() {
+			return true
+		}
+		for _, mapping := range spec.ArchivalLocationToClusterMapping {
+			if mapping.Location.ID == locationID.String() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+const (
+	testMigrationSLAID       = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01"
+	testMigrationSourceID    = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a02"
+	testMigrationTargetID    = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a03"
+	testMigrationTaskChainID = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a04"
+)
+
+// testMigrationSLADomain returns an SLA domain response archiving to the
+// archival location with the specified ID.
+func testMigrationSLADomain(locationID string) map[string]any {
+	return map[string]any{
+		"id":   testMigrationSLAID,
+		"name": "gold",
+		"archivalSpecs": []any{map[string]any{
+			"storageSetting": map[string]any{"id": locationID},
+		}},
+	}
+}
+
+func TestMigrateSLAArchivalLocation(t *testing.T) {
+	params := slaArchivalLocationMigrationParams{
+		SLAID:             uuid.MustParse(testMigrationSLAID),
+		SourceLocationID:  uuid.MustParse(testMigrationSourceID),
+		TargetLocationID:  uuid.MustParse(testMigrationTargetID),
+		ExistingSnapshots: existingSnapshotsRetain,
+	}
+
+	tests := []struct {
+		name    string
+		result  any
+		err     error
+		wantErr string
+	}{
+		{name: "Started", result: map[string]any{"taskchainId": testMigrationTaskChainID}},
+		{name: "NoTaskChain", result: map[string]any{}, wantErr: "didn't return a task chain ID"},
+		{name: "Error", err: errors.New("target location is paused"), wantErr: "target location is paused"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				return tc.result, tc.err
+			})
+
+			taskChainID, err := migrateSLAArchivalLocation(context.Background(), c.polarisClient.GQL, params)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if taskChainID.String() != testMigrationTaskChainID {
+				t.Errorf("expected task chain ID %q, got %q", testMigrationTaskChainID, taskChainID)
+			}
+
+			vars := srv.Requests()[0].Variables
+			if vars["slaId"] != testMigrationSLAID || vars["sourceLocationId"] != testMigrationSourceID ||
+				vars["targetLocationId"] != testMigrationTargetID || vars["existingSnapshots"] != existingSnapshotsRetain {
+				t.Errorf("unexpected variables: %v", vars)
+			}
+		})
+	}
+}
+
+func TestCreateSLAArchivalLocationMigrationNotArchivingToSource(t *testing.T) {
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		if req.Operation == "SdkGolangSlaDomain" {
+			return testMigrationSLADomain(testMigrationTargetID), nil
+		}
+		return nil, errors.New("unexpected operation: " + req.Operation)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceSLAArchivalLocationMigration().Schema, map[string]any{
+		keySLADomainID:       testMigrationSLAID,
+		keySourceLocationID:  testMigrationSourceID,
+		keyTargetLocationID:  testMigrationTargetID,
+		keyWaitForCompletion: false,
+	})
+	diags := createSLAArchivalLocationMigration(context.Background(), d, c)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "doesn't archive to archival location") {
+		t.Fatalf("expected source archival location error, got %v", diags)
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("expected only the SLA domain to be read, got %d requests", n)
+	}
+}
+
+func TestReadSLAArchivalLocationMigration(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain map[string]any
+		wantID string
+	}{
+		{
+			name:   "ArchivesToTarget",
+			domain: testMigrationSLADomain(testMigrationTargetID),
+			wantID: testMigrationTaskChainID,
+		},
+		{
+			name:   "SLADomainRemoved",
+			domain: map[string]any{"id": testMigrationSLAID, "isArchived": true},
+			wantID: "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				return tc.domain, nil
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceSLAArchivalLocationMigration().Schema, map[string]any{
+				keySLADomainID:      testMigrationSLAID,
+				keySourceLocationID: testMigrationSourceID,
+				keyTargetLocationID: testMigrationTargetID,
+			})
+			d.SetId(testMigrationTaskChainID)
+			if diags := readSLAArchivalLocationMigration(context.Background(), d, c); diags.HasError() {
+				t.Fatal(diags)
+			}
+			if id := d.Id(); id != tc.wantID {
+				t.Errorf("expected ID %q, got %q", tc.wantID, id)
+			}
+		})
+	}
+}
+
+func TestSLADomainArchivesTo(t *testing.T) {
+	var slaDomain gqlsla.Domain
+	err := json.Unmarshal([]byte(`{
+		"archivalSpecs": [{
+			"storageSetting": {"id": "`+testMigrationSourceID+`"},
+			"archivalLocationToClusterMapping": [{"location": {"id": "`+testMigrationTargetID+`"}}]
+		}]
+	}`), &slaDomain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, locationID := range []string{testMigrationSourceID, testMigrationTargetID} {
+		if !slaDomainArchivesTo(slaDomain, uuid.MustParse(locationID)) {
+			t.Errorf("expected SLA domain to archive to %s", locationID)
+		}
+	}
+	if slaDomainArchivesTo(slaDomain, uuid.MustParse(testMigrationSLAID)) {
+		t.Errorf("expected SLA domain not to archive to %s", testMigrationSLAID)
+	}
+}
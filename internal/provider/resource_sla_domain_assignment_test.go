@@ -60,7 +60,7 @@ resource "polaris_sla_domain" "test" {
 	description  = "SLA Domain for assignment testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 4
 		retention      = 24
 		retention_unit = "HOURS"
@@ -109,7 +109,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: protectWithSla,
 			Check: resource.ComposeTestCheckFunc(
@@ -168,7 +168,7 @@ func TestAccPolarisSLADomainAssignment_doNotProtect(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: doNotProtect,
 			Check: resource.ComposeTestCheckFunc(
@@ -212,7 +212,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			Config: protectWithSla,
 			Check: resource.ComposeTestCheckFunc(
@@ -261,7 +261,7 @@ resource "polaris_sla_domain" "test" {
 	description  = "SLA Domain for multiple objects testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 4
 		retention      = 24
 		retention_unit = "HOURS"
@@ -291,7 +291,7 @@ resource "polaris_sla_domain" "test" {
 	description  = "SLA Domain for add/remove object testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 4
 		retention      = 24
 		retention_unit = "HOURS"
@@ -349,7 +349,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Start with single object assigned
 			Config: step1,
@@ -434,7 +434,7 @@ resource "polaris_sla_domain_assignment" "second" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Assign multiple objects to SLA with single assignment resource
 			Config: multipleObjects,
@@ -474,7 +474,7 @@ resource "polaris_sla_domain" "first" {
 	description  = "First SLA Domain for change testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 4
 		retention      = 24
 		retention_unit = "HOURS"
@@ -486,7 +486,7 @@ resource "polaris_sla_domain" "second" {
 	description  = "Second SLA Domain for change testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 6
 		retention      = 48
 		retention_unit = "HOURS"
@@ -539,7 +539,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Assign first SLA domain
 			Config: step1,
@@ -594,7 +594,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Start with RETAIN_SNAPSHOTS
 			Config: retainSnapshots,
@@ -643,7 +643,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Create doNotProtect without retention policy
 			Config: noRetention,
@@ -688,7 +688,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Create with apply_changes_to_existing_snapshots = false
 			Config: applyFalse,
@@ -740,7 +740,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Start with doNotProtect
 			Config: doNotProtect,
@@ -779,7 +779,7 @@ resource "polaris_sla_domain" "test" {
 	description  = "SLA Domain for import testing"
 	object_types = ["AWS_EC2_EBS_OBJECT_TYPE"]
 
-	hourly_schedule {
+	hourly_schedule = {
 		frequency      = 4
 		retention      = 24
 		retention_unit = "HOURS"
@@ -799,7 +799,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Create the resources
 			Config: importConfig,
@@ -853,7 +853,7 @@ resource "polaris_sla_domain_assignment" "default" {
 	}
 
 	resource.Test(t, resource.TestCase{
-		ProviderFactories: providerFactories,
+		ProtoV6ProviderFactories: protoV6ProviderFactories,
 		Steps: []resource.TestStep{{
 			// Step 1: Create the doNotProtect assignment
 			Config: doNotProtectConfig,
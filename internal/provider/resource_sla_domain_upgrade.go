@@ -0,0 +1,217 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+const resourceSLADomainUpgradeDescription = `
+The ´polaris_sla_domain_upgrade´ resource converts an SLA domain local to a
+Rubrik cluster into a global SLA domain managed by RSC. The SLA domain keeps its
+ID, name, schedules and protected objects. Once converted, the SLA domain can be
+managed using the ´polaris_sla_domain´ resource.
+
+The conversion is performed when the resource is created. All fields are
+´ForceNew´, so changing any field performs a new conversion. If the SLA domain
+is no longer a global SLA domain, the resource is removed from the state and the
+conversion is performed again on the next apply. Destroying the resource doesn't
+revert the conversion.
+
+The following steps bring a local SLA domain managed by a
+´polaris_cdm_sla_domain´ resource under RSC management:
+  1. Create a ´polaris_sla_domain_upgrade´ resource for the SLA domain.
+  2. Remove the ´polaris_cdm_sla_domain´ resource from the configuration using
+     a ´removed´ block, so that the SLA domain isn't destroyed.
+  3. Import the SLA domain into a ´polaris_sla_domain´ resource using an
+     ´import´ block with the ID of the SLA domain.
+
+The default create timeout is 10 minutes and can be overridden with a
+´timeouts´ block.
+`
+
+// upgradeSLADomainQuery is the GraphQL mutation used to convert SLA domains
+// local to a Rubrik cluster into global SLA domains.
+const upgradeSLADomainQuery = `mutation SdkGolangUpgradeSlas($clusterUuid: UUID!, $slaIds: [String!]!) {
+    result: upgradeSlas(input: {
+        clusterUuid: $clusterUuid
+        slaIds:      $slaIds
+    }) {
+        success
+    }
+}`
+
+func resourceSLADomainUpgrade() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createSLADomainUpgrade,
+		ReadContext:   readSLADomainUpgrade,
+		DeleteContext: deleteSLADomainUpgrade,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Description: description(resourceSLADomainUpgradeDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SLA domain ID (UUID).",
+			},
+			keyClusterID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "ID (UUID) of the Rubrik cluster owning the local SLA domain. Changing this forces a " +
+					"new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the global SLA domain.",
+			},
+			keySLADomainID: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "ID (UUID) of the local SLA domain to convert. Changing this forces a new resource to " +
+					"be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func createSLADomainUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "createSLADomainUpgrade")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	clusterID, err := uuid.Parse(d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	slaID, err := uuid.Parse(d.Get(keySLADomainID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	err = gqlRequest(ctx, client.GQL, upgradeSLADomainQuery, struct {
+		ClusterID uuid.UUID `json:"clusterUuid"`
+		SLAIDs    []string  `json:"slaIds"`
+	}{
+		ClusterID: clusterID,
+		SLAIDs:    []string{slaID.String()},
+	}, &result)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !result.Success {
+		return diag.Errorf("failed to convert SLA domain %s on cluster %s to a global SLA domain", slaID, clusterID)
+	}
+
+	// The conversion is asynchronous, wait for the global SLA domain to show
+	// up in RSC.
+	if _, err := waitForGlobalSLADomain(ctx, sla.Wrap(client), slaID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(slaID.String())
+	return readSLADomainUpgrade(ctx, d, m)
+}
+
+func readSLADomainUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "readSLADomainUpgrade")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	slaID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// If the SLA domain is no longer a global SLA domain, we remove the
+	// conversion from the local state.
+	slaDomain, err := sla.Wrap(client).DomainByID(ctx, slaID)
+	if errors.Is(err, graphql.ErrNotFound) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(keyName, slaDomain.Name); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func deleteSLADomainUpgrade(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "deleteSLADomainUpgrade")
+
+	// The conversion can't be reverted, so we only remove the conversion from
+	// the local state.
+	d.SetId("")
+	return nil
+}
+
+// waitForGlobalSLADomain blocks until the global SLA domain with the specified
+// ID exists or the context is done.
+func waitForGlobalSLADomain(ctx context.Context, slaAPI sla.API, slaID uuid.UUID) (gqlsla.Domain, error) {
+	for {
+		slaDomain, err := slaAPI.DomainByID(ctx, slaID)
+		if err == nil {
+			return slaDomain, nil
+		}
+		if !errors.Is(err, graphql.ErrNotFound) {
+			return gqlsla.Domain{}, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return gqlsla.Domain{}, ctx.Err()
+		case <-time.After(10 * time.Second):
+		}
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+const resourceTaskWaitDescription = `
+The ´polaris_task_wait´ resource waits for an RSC asynchronous task to finish.
+Together with the ´wait_for_completion´ field of the long-running resources, it
+allows the long-running operations of large parallel rollouts to be started in
+one Terraform run and waited for in another, or in a separate part of the
+dependency graph, so that no single Terraform run has to wait for all the
+operations to finish.
+
+The following types of tasks are supported:
+  * ´ACTIVITY_SERIES´ - An RSC activity series, e.g., the ´task_id´ of a cloud
+    cluster created without waiting for completion. Requires ´cluster_id´.
+  * ´AWS_CLOUD_ACCOUNT´ - An RSC AWS cloud account, the task is done when all
+    features of the account are connected, e.g., the ´task_id´ of an
+    RSC-managed AWS account onboarded without waiting for completion.
+  * ´TASK_CHAIN´ - An RSC task chain, e.g., the ID of an SLA archival location
+    migration performed without waiting for completion.
+
+The resource waits for the task when created. If the task fails, an error is
+returned. The default create timeout is 60 minutes and can be overridden with a
+´timeouts´ block. RSC expires old tasks, once a task has expired the status of
+the task is kept in the state. Destroying the resource only removes it from the
+local state.
+`
+
+func resourceTaskWait() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: createTaskWait,
+		ReadContext:   readTaskWait,
+		DeleteContext: deleteTaskWait,
+		CustomizeDiff: customizeDiffTaskWait,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Description: description(resourceTaskWaitDescription),
+		Schema: map[string]*schema.Schema{
+			keyID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Task ID.",
+			},
+			keyClusterID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: "Cluster ID (UUID) of the cluster the task runs on. Required when `task_type` is " +
+					"`ACTIVITY_SERIES`. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.IsUUID,
+			},
+			keyMessage: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status message of the task.",
+			},
+			keyStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the task.",
+			},
+			keyTaskID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Task ID. Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			keyTaskType: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				Description: "Task type. Possible values are `ACTIVITY_SERIES`, `AWS_CLOUD_ACCOUNT` and `TASK_CHAIN`. " +
+					"Changing this forces a new resource to be created.",
+				ValidateFunc: validation.StringInSlice([]string{
+					taskTypeActivitySeries, taskTypeAWSCloudAccount, taskTypeTaskChain,
+				}, false),
+			},
+		},
+	}
+}
+
+func createTaskWait(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "createTaskWait")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	taskType := d.Get(keyTaskType).(string)
+	taskID := d.Get(keyTaskID).(string)
+	state, err := waitForTask(ctx, client.GQL, taskType, taskID, d.Get(keyClusterID).(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if state.Failed {
+		if state.Message != "" {
+			return diag.Errorf("%s task %q failed with status %s: %s", taskType, taskID, state.Status, state.Message)
+		}
+		return diag.Errorf("%s task %q failed with status %s", taskType, taskID, state.Status)
+	}
+
+	d.SetId(taskID)
+	return readTaskWait(ctx, d, m)
+}
+
+func readTaskWait(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "readTaskWait")
+
+	client, err := m.(*client).polaris()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	state, err := taskStatus(ctx, client.GQL, d.Get(keyTaskType).(string), d.Id(), d.Get(keyClusterID).(string))
+	if errors.Is(err, graphql.ErrNotFound) {
+		// RSC expires old tasks. The task was waited for when the resource was
+		// created, so the status in the state is kept.
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Task not found",
+			Detail: fmt.Sprintf("%s task %q not found in RSC, keeping the status %q from the state", d.Get(keyTaskType),
+				d.Id(), d.Get(keyStatus)),
+		}}
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyStatus, state.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(keyMessage, state.Message); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func deleteTaskWait(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	tflog.Trace(ctx, "deleteTaskWait")
+
+	// Waiting for a task can't be undone, so we only remove the resource from
+	// the local state.
+	d.SetId("")
+	return nil
+}
+
+// customizeDiffTaskWait validates the task wait.
+func customizeDiffTaskWait(ctx context.Context, diff *schema.ResourceDiff, m any) error {
+	tflog.Trace(ctx, "customizeDiffTaskWait")
+
+	if diff.Get(keyTaskType).(string) == taskTypeActivitySeries && diff.NewValueKnown(keyClusterID) &&
+		diff.Get(keyClusterID).(string) == "" {
+		return fmt.Errorf("%s is required when %s is %s", keyClusterID, keyTaskType, taskTypeActivitySeries)
+	}
+
+	return nil
+}
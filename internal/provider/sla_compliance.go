@@ -0,0 +1,139 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// slaComplianceQuery is the GraphQL query used to read the compliance state of
+// the objects protected by SLA domains.
+const slaComplianceQuery = `query SdkGolangSlaCompliance($after: String, $filter: SnappableFilterInput) {
+    result: snappableConnection(after: $after, filter: $filter) {
+        edges {
+            node {
+                fid
+                name
+                objectType
+                complianceStatus
+                lastSnapshot
+                missedSnapshots
+                archivalSnapshotLag
+                slaDomain {
+                    id
+                    name
+                }
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// Compliance states reported for objects.
+const (
+	slaComplianceInCompliance    = "IN_COMPLIANCE"
+	slaComplianceOutOfCompliance = "OUT_OF_COMPLIANCE"
+	slaComplianceNotYetRun       = "NOT_YET_RUN"
+)
+
+// slaComplianceObject holds the compliance state of an object protected by an
+// SLA domain.
+type slaComplianceObject struct {
+	ID                  uuid.UUID  `json:"fid"`
+	Name                string     `json:"name"`
+	ObjectType          string     `json:"objectType"`
+	ComplianceStatus    string     `json:"complianceStatus"`
+	LastSnapshot        *time.Time `json:"lastSnapshot"`
+	MissedSnapshots     int        `json:"missedSnapshots"`
+	ArchivalSnapshotLag int        `json:"archivalSnapshotLag"`
+	SLADomain           struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"slaDomain"`
+}
+
+// status returns the compliance state of the object. RSC reports objects which
+// haven't had a snapshot taken yet as not available or empty, these are
+// returned as NOT_YET_RUN.
+func (o slaComplianceObject) status() string {
+	switch o.ComplianceStatus {
+	case slaComplianceInCompliance, slaComplianceOutOfCompliance:
+		return o.ComplianceStatus
+	}
+	if o.LastSnapshot == nil {
+		return slaComplianceNotYetRun
+	}
+
+	return o.ComplianceStatus
+}
+
+// slaComplianceObjects returns the compliance state of the objects protected
+// by the specified SLA domain and with the specified object IDs. A nil SLA
+// domain ID or an empty list of object IDs matches all.
+func slaComplianceObjects(ctx context.Context, gql *graphql.Client, slaID *uuid.UUID, objectIDs []uuid.UUID) ([]slaComplianceObject, error) {
+	type slaDomainFilter struct {
+		ID []string `json:"id"`
+	}
+	type filter struct {
+		SLADomain *slaDomainFilter `json:"slaDomain,omitempty"`
+		ObjectIDs []uuid.UUID      `json:"objectFid,omitempty"`
+	}
+	f := filter{ObjectIDs: objectIDs}
+	if slaID != nil {
+		f.SLADomain = &slaDomainFilter{ID: []string{slaID.String()}}
+	}
+
+	var objects []slaComplianceObject
+	var cursor string
+	for {
+		var result struct {
+			Edges []struct {
+				Node slaComplianceObject `json:"node"`
+			} `json:"edges"`
+			PageInfo struct {
+				EndCursor   string `json:"endCursor"`
+				HasNextPage bool   `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		}
+		if err := gqlRequest(ctx, gql, slaComplianceQuery, struct {
+			After  string `json:"after,omitempty"`
+			Filter filter `json:"filter"`
+		}{After: cursor, Filter: f}, &result); err != nil {
+			return nil, err
+		}
+		for _, edge := range result.Edges {
+			objects = append(objects, edge.Node)
+		}
+		if !result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = result.PageInfo.EndCursor
+	}
+
+	return objects, nil
+}
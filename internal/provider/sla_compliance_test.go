@@ -0,0 +1,75 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSLAComplianceObjectStatus(t *testing.T) {
+	lastSnapshot := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		object slaComplianceObject
+		want   string
+	}{
+		{
+			name:   "InCompliance",
+			object: slaComplianceObject{ComplianceStatus: "IN_COMPLIANCE", LastSnapshot: &lastSnapshot},
+			want:   slaComplianceInCompliance,
+		},
+		{
+			name:   "OutOfCompliance",
+			object: slaComplianceObject{ComplianceStatus: "OUT_OF_COMPLIANCE", LastSnapshot: &lastSnapshot},
+			want:   slaComplianceOutOfCompliance,
+		},
+		{
+			name:   "OutOfComplianceWithoutSnapshot",
+			object: slaComplianceObject{ComplianceStatus: "OUT_OF_COMPLIANCE"},
+			want:   slaComplianceOutOfCompliance,
+		},
+		{
+			name:   "NotAvailableWithoutSnapshot",
+			object: slaComplianceObject{ComplianceStatus: "NOT_AVAILABLE"},
+			want:   slaComplianceNotYetRun,
+		},
+		{
+			name:   "EmptyWithoutSnapshot",
+			object: slaComplianceObject{ComplianceStatus: "EMPTY"},
+			want:   slaComplianceNotYetRun,
+		},
+		{
+			name:   "NotAvailableWithSnapshot",
+			object: slaComplianceObject{ComplianceStatus: "NOT_AVAILABLE", LastSnapshot: &lastSnapshot},
+			want:   "NOT_AVAILABLE",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.object.status(); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
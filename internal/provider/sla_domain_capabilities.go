@@ -0,0 +1,262 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+// slaObjectTypeCapabilities holds the restrictions RSC places on SLA domains
+// protecting an object type. The zero value means no restrictions.
+type slaObjectTypeCapabilities struct {
+	// name is the human-readable name of the object type, used in error
+	// messages.
+	name string
+
+	// noArchival and noReplication are true if the object type doesn't
+	// support archival and replication, respectively.
+	noArchival    bool
+	noReplication bool
+
+	// archivalRequired is true if the object type requires an archival
+	// location. The requirement doesn't apply to SLA domains using a backup
+	// location or a long-term retention configuration, which replace
+	// archival when the CNP_AZURE_SQL_SLA_REVAMP feature is enabled.
+	archivalRequired bool
+
+	// noSnapshotWindows is true if the object type doesn't support snapshot
+	// windows and first full snapshot windows.
+	noSnapshotWindows bool
+
+	// exclusive is true if the object type cannot be combined with other
+	// object types.
+	exclusive bool
+
+	// minFrequencyHours is the minimum snapshot frequency in hours, 0 means
+	// no minimum.
+	minFrequencyHours int
+
+	// cloudNative is true for cloud-native workloads, for which the hourly
+	// retention must be a multiple of 24 hours.
+	cloudNative bool
+}
+
+// slaObjectTypeCapabilityTable holds the capabilities of the object types
+// with restrictions. Object types not in the table have no restrictions
+// enforced when planning. Restrictions which depend on RSC feature flags, e.g.
+// for Azure SQL, are enforced when the SLA domain is created or updated.
+var slaObjectTypeCapabilityTable = map[gqlsla.ObjectType]slaObjectTypeCapabilities{
+	gqlsla.ObjectActiveDirectory: {
+		name:              "Active Directory",
+		minFrequencyHours: 4,
+	},
+	gqlsla.ObjectAWSDynamoDB: {
+		name:          "AWS DynamoDB",
+		noReplication: true,
+		cloudNative:   true,
+	},
+	gqlsla.ObjectAWSEC2EBS: {
+		name:        "AWS EC2/EBS",
+		cloudNative: true,
+	},
+	gqlsla.ObjectAWSRDS: {
+		name:        "AWS RDS",
+		cloudNative: true,
+	},
+	gqlsla.ObjectAWSS3: {
+		name:          "AWS S3",
+		noArchival:    true,
+		noReplication: true,
+		exclusive:     true,
+	},
+	gqlsla.ObjectAzure: {
+		name:        "Azure",
+		cloudNative: true,
+	},
+	gqlsla.ObjectAzureBlob: {
+		name:          "Azure Blob",
+		noArchival:    true,
+		noReplication: true,
+	},
+	gqlsla.ObjectAzureSQLDatabase: {
+		name:             "Azure SQL Database",
+		noReplication:    true,
+		archivalRequired: true,
+	},
+	gqlsla.ObjectAzureSQLManagedInstance: {
+		name:          "Azure SQL Managed Instance",
+		noArchival:    true,
+		noReplication: true,
+	},
+	gqlsla.ObjectCassandra: {
+		name:          "Cassandra",
+		noArchival:    true,
+		noReplication: true,
+	},
+	gqlsla.ObjectGCP: {
+		name:          "GCE Instance/Disk",
+		noReplication: true,
+		cloudNative:   true,
+	},
+	gqlsla.ObjectMicrosoft365: {
+		name:              "Microsoft 365",
+		noArchival:        true,
+		noReplication:     true,
+		noSnapshotWindows: true,
+		minFrequencyHours: 8,
+	},
+	gqlsla.ObjectMongoDB: {
+		name:          "MongoDB",
+		noReplication: true,
+	},
+	gqlsla.ObjectOkta: {
+		name:          "Okta",
+		noArchival:    true,
+		noReplication: true,
+	},
+	gqlsla.ObjectOLVM: {
+		name:       "OLVM",
+		noArchival: true,
+	},
+}
+
+// slaDomainCapabilityConfig holds the parts of an SLA domain configuration
+// which are validated against the object type capabilities. Values which are
+// unknown when planning, e.g. when cloned from another SLA domain, are treated
+// as not set, except for archivalUnknown.
+type slaDomainCapabilityConfig struct {
+	objectTypes         []gqlsla.ObjectType
+	archival            bool
+	archivalUnknown     bool
+	backupService       bool
+	replication         bool
+	snapshotWindows     bool
+	hourlyFrequency     int
+	hourlyRetention     int
+	hourlyRetentionUnit gqlsla.RetentionUnit
+	minuteSchedule      bool
+}
+
+// validateSLAObjectTypeCapabilities validates the SLA domain configuration
+// against the capabilities of the object types protected by the SLA domain.
+// The object types are validated in lexical order, so that the same error is
+// always returned for the same configuration.
+func validateSLAObjectTypeCapabilities(config slaDomainCapabilityConfig) error {
+	objectTypes := slices.Clone(config.objectTypes)
+	slices.Sort(objectTypes)
+	for _, objectType := range objectTypes {
+		caps, ok := slaObjectTypeCapabilityTable[objectType]
+		if !ok {
+			continue
+		}
+
+		if caps.exclusive && len(objectTypes) > 1 {
+			return fmt.Errorf("%s object type cannot be combined with other object types", caps.name)
+		}
+		if caps.noArchival && config.archival {
+			return fmt.Errorf("%s object type does not support archival, remove the %s field", caps.name, keyArchival)
+		}
+		if caps.archivalRequired && !config.archival && !config.archivalUnknown && !config.backupService {
+			return fmt.Errorf("%s object type requires archival, add the %s field", caps.name, keyArchival)
+		}
+		if caps.noReplication && config.replication {
+			return fmt.Errorf("%s object type does not support replication, remove the %s field", caps.name,
+				keyReplicationSpec)
+		}
+		if caps.noSnapshotWindows && config.snapshotWindows {
+			return fmt.Errorf("%s object type does not support snapshot windows, remove the %s and %s fields",
+				caps.name, keySnapshotWindow, keyFirstFullSnapshot)
+		}
+		if caps.minFrequencyHours > 0 {
+			if config.minuteSchedule {
+				return fmt.Errorf("%s object type requires minimum of %d hours SLA, remove the %s field", caps.name,
+					caps.minFrequencyHours, keyMinuteSchedule)
+			}
+			if config.hourlyFrequency > 0 && config.hourlyFrequency < caps.minFrequencyHours {
+				return fmt.Errorf("%s object type requires minimum of %d hours SLA, got %s frequency of %d hours",
+					caps.name, caps.minFrequencyHours, keyHourlySchedule, config.hourlyFrequency)
+			}
+		}
+		if caps.cloudNative && config.hourlyRetentionUnit == gqlsla.Hours && config.hourlyRetention%24 != 0 {
+			return fmt.Errorf("%s object type requires the %s retention to be a multiple of 24 hours, got %d hours",
+				caps.name, keyHourlySchedule, config.hourlyRetention)
+		}
+	}
+
+	return nil
+}
+
+// slaDomainCapabilityConfigFromPlan returns the capability configuration of
+// the planned SLA domain. Returns false if the object types are not yet
+// known.
+func slaDomainCapabilityConfigFromPlan(ctx context.Context, plan tfsdk.Plan) (slaDomainCapabilityConfig, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var objectTypes types.Set
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyObjectTypes), &objectTypes)...)
+	if diags.HasError() || objectTypes.IsUnknown() {
+		return slaDomainCapabilityConfig{}, false, diags
+	}
+
+	var archival, backupLocation, replication, snapshotWindow, firstFullSnapshot types.List
+	var azureSQLDatabaseLTR types.Object
+	var minuteSchedule types.Object
+	var hourlyFrequency, hourlyRetention types.Int64
+	var hourlyRetentionUnit types.String
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyArchival), &archival)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyBackupLocation), &backupLocation)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyAzureSQLDatabaseConfig).AtName(keyLTRConfig), &azureSQLDatabaseLTR)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyReplicationSpec), &replication)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keySnapshotWindow), &snapshotWindow)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyFirstFullSnapshot), &firstFullSnapshot)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyMinuteSchedule), &minuteSchedule)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyHourlySchedule).AtName(keyFrequency), &hourlyFrequency)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyHourlySchedule).AtName(keyRetention), &hourlyRetention)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyHourlySchedule).AtName(keyRetentionUnit), &hourlyRetentionUnit)...)
+	if diags.HasError() {
+		return slaDomainCapabilityConfig{}, false, diags
+	}
+
+	var config slaDomainCapabilityConfig
+	for _, objectType := range stringsFromSet(objectTypes) {
+		config.objectTypes = append(config.objectTypes, gqlsla.ObjectType(objectType))
+	}
+	config.archival = len(archival.Elements()) > 0
+	config.archivalUnknown = archival.IsUnknown()
+	config.backupService = len(backupLocation.Elements()) > 0 || backupLocation.IsUnknown() ||
+		!azureSQLDatabaseLTR.IsNull()
+	config.replication = len(replication.Elements()) > 0
+	config.snapshotWindows = len(snapshotWindow.Elements()) > 0 || len(firstFullSnapshot.Elements()) > 0
+	config.minuteSchedule = !minuteSchedule.IsNull() && !minuteSchedule.IsUnknown()
+	config.hourlyFrequency = int(hourlyFrequency.ValueInt64())
+	config.hourlyRetention = int(hourlyRetention.ValueInt64())
+	config.hourlyRetentionUnit = gqlsla.RetentionUnit(hourlyRetentionUnit.ValueString())
+
+	return config, true, diags
+}
@@ -21,11 +21,15 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"testing"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
 )
 
@@ -106,7 +110,7 @@ func TestValidateAzureSQLSLA(t *testing.T) {
 			schedule:        withSchedule,
 			backupLocations: backupLoc,
 			archivalSpecs:   archival,
-			wantErr:         "remove the archival block",
+			wantErr:         "remove the archival field",
 		},
 	}
 
@@ -137,8 +141,8 @@ func TestValidateAzureSQLObjectTypeFeatureGate(t *testing.T) {
 	delayedArchival := []gqlsla.ArchivalSpec{{Threshold: 1}}
 	backupLoc := []gqlsla.BackupLocationSpec{{ArchivalGroupID: uuid.New()}}
 	withSchedule := gqlsla.SnapshotSchedule{Daily: &gqlsla.DailySnapshotSchedule{}}
-	dbTypes := []any{string(gqlsla.ObjectAzureSQLDatabase)}
-	miTypes := []any{string(gqlsla.ObjectAzureSQLManagedInstance)}
+	dbTypes := []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase}
+	miTypes := []gqlsla.ObjectType{gqlsla.ObjectAzureSQLManagedInstance}
 
 	t.Run("DB legacy: instant archival accepted", func(t *testing.T) {
 		if err := validateAzureSQLDatabaseObjectType(false, dbTypes, plain, gqlsla.SnapshotSchedule{}, nil, instantArchival, nil); err != nil {
@@ -182,30 +186,26 @@ func TestValidateAzureSQLObjectTypeFeatureGate(t *testing.T) {
 }
 
 // TestConfigHasLTRConfig verifies detection of a V1 (Azure-managed) config —
-// an azure_sql_*_config block carrying a non-empty ltr_config.
+// an azure_sql_*_config attribute carrying an ltr_config.
 func TestConfigHasLTRConfig(t *testing.T) {
-	v1 := []any{map[string]any{
-		keyLogRetention: 7,
-		keyLTRConfig:    []any{map[string]any{keyWeeklyRetention: []any{}}},
-	}}
-	v2 := []any{map[string]any{
-		keyLogRetention: 7,
-		keyLTRConfig:    []any{},
-	}}
 	tests := []struct {
 		name string
-		in   any
+		in   *slaAzureSQLConfigModel
 		want bool
 	}{
-		{"V1 with ltr_config", v1, true},
-		{"V2 without ltr_config", v2, false},
-		{"empty block", []any{}, false},
+		{"V1 with ltr_config", &slaAzureSQLConfigModel{
+			LogRetention: types.Int64Value(7),
+			LTRConfig:    &slaLTRConfigModel{},
+		}, true},
+		{"V2 without ltr_config", &slaAzureSQLConfigModel{
+			LogRetention: types.Int64Value(7),
+		}, false},
 		{"nil", nil, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := configHasLTRConfig(tt.in); got != tt.want {
-				t.Fatalf("configHasLTRConfig(%v) = %v, want %v", tt.in, got, tt.want)
+			if got := tt.in.hasLTRConfig(); got != tt.want {
+				t.Fatalf("hasLTRConfig() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -216,14 +216,14 @@ func TestConfigHasLTRConfig(t *testing.T) {
 func TestOnlyAzureSQLObjectTypes(t *testing.T) {
 	tests := []struct {
 		name        string
-		objectTypes []any
+		objectTypes []gqlsla.ObjectType
 		want        bool
 	}{
-		{"DB only", []any{string(gqlsla.ObjectAzureSQLDatabase)}, true},
-		{"MI only", []any{string(gqlsla.ObjectAzureSQLManagedInstance)}, true},
-		{"DB + MI", []any{string(gqlsla.ObjectAzureSQLDatabase), string(gqlsla.ObjectAzureSQLManagedInstance)}, true},
-		{"DB + Blob", []any{string(gqlsla.ObjectAzureSQLDatabase), string(gqlsla.ObjectAzureBlob)}, false},
-		{"MI + other", []any{string(gqlsla.ObjectAzureSQLManagedInstance), "AWS_EC2_EBS_OBJECT_TYPE"}, false},
+		{"DB only", []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase}, true},
+		{"MI only", []gqlsla.ObjectType{gqlsla.ObjectAzureSQLManagedInstance}, true},
+		{"DB + MI", []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase, gqlsla.ObjectAzureSQLManagedInstance}, true},
+		{"DB + Blob", []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase, gqlsla.ObjectAzureBlob}, false},
+		{"MI + other", []gqlsla.ObjectType{gqlsla.ObjectAzureSQLManagedInstance, gqlsla.ObjectAWSEC2EBS}, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -255,3 +255,150 @@ func TestScheduleEmpty(t *testing.T) {
 		}
 	}
 }
+
+// TestValidateSLAObjectTypeCapabilities covers the object type capability
+// table enforced when planning.
+func TestValidateSLAObjectTypeCapabilities(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  slaDomainCapabilityConfig
+		wantErr string // substring; "" means no error expected
+	}{
+		{
+			name:   "no restrictions",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectVSphereVM}, archival: true, replication: true},
+		},
+		{
+			name:    "S3 combined with other object types",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAWSS3, gqlsla.ObjectAWSEC2EBS}},
+			wantErr: "AWS S3 object type cannot be combined with other object types",
+		},
+		{
+			name:    "S3 with archival",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAWSS3}, archival: true},
+			wantErr: "AWS S3 object type does not support archival",
+		},
+		{
+			name:    "Azure Blob with replication",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzureBlob}, replication: true},
+			wantErr: "Azure Blob object type does not support replication",
+		},
+		{
+			name:    "Okta with archival",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectOkta}, archival: true},
+			wantErr: "Okta object type does not support archival",
+		},
+		{
+			name:    "M365 with snapshot window",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectMicrosoft365}, snapshotWindows: true},
+			wantErr: "Microsoft 365 object type does not support snapshot windows",
+		},
+		{
+			name:    "M365 below minimum frequency",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectMicrosoft365}, hourlyFrequency: 4},
+			wantErr: "Microsoft 365 object type requires minimum of 8 hours SLA",
+		},
+		{
+			name:    "M365 with minute schedule",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectMicrosoft365}, minuteSchedule: true},
+			wantErr: "remove the minute_schedule field",
+		},
+		{
+			name:   "M365 at minimum frequency",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectMicrosoft365}, hourlyFrequency: 8},
+		},
+		{
+			name:    "Active Directory below minimum frequency",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectActiveDirectory}, hourlyFrequency: 2},
+			wantErr: "Active Directory object type requires minimum of 4 hours SLA",
+		},
+		{
+			name: "cloud-native hourly retention not a multiple of 24",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAWSEC2EBS}, hourlyFrequency: 4,
+				hourlyRetention: 36, hourlyRetentionUnit: gqlsla.Hours},
+			wantErr: "retention to be a multiple of 24 hours",
+		},
+		{
+			name: "cloud-native hourly retention a multiple of 24",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectGCP}, hourlyFrequency: 4,
+				hourlyRetention: 48, hourlyRetentionUnit: gqlsla.Hours},
+		},
+		{
+			name: "cloud-native hourly retention in days",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzure}, hourlyFrequency: 4,
+				hourlyRetention: 3, hourlyRetentionUnit: gqlsla.Days},
+		},
+		{
+			name:    "Azure SQL Database without archival",
+			config:  slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase}},
+			wantErr: "Azure SQL Database object type requires archival, add the archival field",
+		},
+		{
+			name:   "Azure SQL Database with archival",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase}, archival: true},
+		},
+		{
+			name: "Azure SQL Database with unknown archival",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase},
+				archivalUnknown: true},
+		},
+		{
+			name: "Azure SQL Database with backup service",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectAzureSQLDatabase},
+				backupService: true},
+		},
+		{
+			name: "first violation in lexical order",
+			config: slaDomainCapabilityConfig{objectTypes: []gqlsla.ObjectType{gqlsla.ObjectOLVM, gqlsla.ObjectCassandra},
+				archival: true},
+			wantErr: "Cassandra object type does not support archival",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSLAObjectTypeCapabilities(tt.config)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Fatalf("expected no error, got: %v", err)
+			case tt.wantErr != "" && err == nil:
+				t.Fatalf("expected error containing %q, got nil", tt.wantErr)
+			case tt.wantErr != "" && !strings.Contains(err.Error(), tt.wantErr):
+				t.Fatalf("expected error containing %q, got: %q", tt.wantErr, err.Error())
+			}
+		})
+	}
+}
+
+// TestSLADomainCapabilityConfigFromPlanUnknown verifies that archival and the
+// minute schedule being unknown when planning, e.g. when cloned from another
+// SLA domain, doesn't fail the object type capability checks.
+func TestSLADomainCapabilityConfigFromPlanUnknown(t *testing.T) {
+	ctx := context.Background()
+	s := slaDomainSchema()
+
+	model := testSLADomainModel()
+	model.ObjectTypes = setFromStrings([]string{string(gqlsla.ObjectMicrosoft365)})
+	var values map[string]tftypes.Value
+	if err := slaDomainRawValue(t, model).As(&values); err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{keyArchival, keyMinuteSchedule} {
+		values[key] = tftypes.NewValue(values[key].Type(), tftypes.UnknownValue)
+	}
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), values)}
+
+	config, ok, diags := slaDomainCapabilityConfigFromPlan(ctx, plan)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !ok {
+		t.Fatal("expected the object types to be known")
+	}
+	if config.archival || !config.archivalUnknown || config.minuteSchedule {
+		t.Errorf("unexpected capability config: %+v", config)
+	}
+	if err := validateSLAObjectTypeCapabilities(config); err != nil {
+		t.Errorf("expected no error, got: %v", err)
+	}
+}
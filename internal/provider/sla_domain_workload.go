@@ -0,0 +1,325 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/sla"
+)
+
+// createSLADomainQuery is the GraphQL mutation used to create an SLA domain.
+// It's the same mutation as used by the SDK, but the object specific
+// configurations are extended with the workload configurations not yet
+// supported by the SDK. Only used when workload configurations are set.
+const createSLADomainQuery = `mutation SdkGolangCreateGlobalSla(
+    $archivalSpecs:              [ArchivalSpecInput!],
+    $backupLocationSpecs:        [BackupLocationSpecInput!],
+    $backupWindows:              [BackupWindowInput!],
+    $description:                String,
+    $firstFullBackupWindows:     [BackupWindowInput!],
+    $isRetentionLockedSla:       Boolean,
+    $localRetentionLimit:        SlaDurationInput,
+    $name:                       String!,
+    $objectSpecificConfigsInput: ObjectSpecificConfigsInput,
+    $objectTypes:                [SlaObjectType!]!,
+    $replicationSpecsV2:         [ReplicationSpecV2Input!],
+    $retentionLockMode:          RetentionLockMode,
+    $snapshotSchedule:           GlobalSnapshotScheduleInput!
+) {
+    result: createGlobalSla(input: {
+        archivalSpecs:              $archivalSpecs,
+        backupLocationSpecs:        $backupLocationSpecs,
+        backupWindows:              $backupWindows,
+        description:                $description,
+        firstFullBackupWindows:     $firstFullBackupWindows,
+        isRetentionLockedSla:       $isRetentionLockedSla,
+        localRetentionLimit:        $localRetentionLimit,
+        name:                       $name,
+        objectSpecificConfigsInput: $objectSpecificConfigsInput,
+        objectTypes:                $objectTypes,
+        replicationSpecsV2:         $replicationSpecsV2,
+        retentionLockMode:          $retentionLockMode,
+        snapshotSchedule:           $snapshotSchedule
+    }) {
+        id
+    }
+}`
+
+// updateSLADomainQuery is the GraphQL mutation used to update an SLA domain.
+// See createSLADomainQuery.
+const updateSLADomainQuery = `mutation SdkGolangUpdateGlobalSla(
+    $archivalSpecs:                   [ArchivalSpecInput!],
+    $backupLocationSpecs:             [BackupLocationSpecInput!],
+    $backupWindows:                   [BackupWindowInput!],
+    $description:                     String,
+    $firstFullBackupWindows:          [BackupWindowInput!],
+    $id:                              String!,
+    $isRetentionLockedSla:            Boolean,
+    $localRetentionLimit:             SlaDurationInput,
+    $name:                            String!,
+    $objectSpecificConfigsInput:      ObjectSpecificConfigsInput,
+    $objectTypes:                     [SlaObjectType!]!,
+    $replicationSpecsV2:              [ReplicationSpecV2Input!],
+    $retentionLockMode:               RetentionLockMode,
+    $shouldApplyToExistingSnapshots:  ShouldApplyToExistingSnapshots,
+    $shouldApplyToNonPolicySnapshots: ShouldApplyToNonPolicySnapshots,
+    $snapshotSchedule:                GlobalSnapshotScheduleInput!
+) {
+    result: updateGlobalSla(input: {
+        archivalSpecs:                   $archivalSpecs,
+        backupLocationSpecs:             $backupLocationSpecs,
+        backupWindows:                   $backupWindows,
+        description:                     $description,
+        firstFullBackupWindows:          $firstFullBackupWindows,
+        id:                              $id,
+        isRetentionLockedSla:            $isRetentionLockedSla,
+        localRetentionLimit:             $localRetentionLimit,
+        name:                            $name,
+        objectSpecificConfigsInput:      $objectSpecificConfigsInput,
+        objectTypes:                     $objectTypes,
+        replicationSpecsV2:              $replicationSpecsV2,
+        retentionLockMode:               $retentionLockMode,
+        shouldApplyToExistingSnapshots:  $shouldApplyToExistingSnapshots,
+        shouldApplyToNonPolicySnapshots: $shouldApplyToNonPolicySnapshots,
+        snapshotSchedule:                $snapshotSchedule
+    }) {
+        id
+    }
+}`
+
+// slaWorkloadConfigsQuery is the GraphQL query used to read the workload
+// configurations of an SLA domain not yet read by the SDK.
+const slaWorkloadConfigsQuery = `query SdkGolangSlaWorkloadConfigs($id: UUID!) {
+    result: slaDomain(id: $id) {
+        ... on GlobalSlaReply {
+            objectSpecificConfigs {
+                exchangeConfig {
+                    logFrequency {
+                        duration
+                        unit
+                    }
+                    logRetention {
+                        duration
+                        unit
+                    }
+                }
+                k8sConfig {
+                    shouldIncludeClusterScopedResources
+                    shouldIncludePvcs
+                }
+                o365Config {
+                    archivalTieringSpec {
+                        isInstantTieringEnabled
+                        minAccessibleDurationInSeconds
+                        coldStorageClass
+                        shouldTierExistingSnapshots
+                    }
+                    backupWindows {
+                        durationInHours
+                        startTimeAttributes {
+                            dayOfWeek {
+                                day
+                            }
+                            hour
+                            minute
+                        }
+                    }
+                }
+                vmwareVmConfig {
+                    logRetentionSeconds
+                    snapshotConsistencyMandate
+                }
+            }
+        }
+    }
+}`
+
+// Snapshot consistencies of VMware vSphere virtual machines.
+const (
+	slaSnapshotConsistencyCrash      = "CRASH_CONSISTENT"
+	slaSnapshotConsistencyFileSystem = "FILE_SYSTEM_CONSISTENT"
+	slaSnapshotConsistencyApp        = "APP_CONSISTENT"
+)
+
+var slaSnapshotConsistencies = []string{
+	slaSnapshotConsistencyCrash,
+	slaSnapshotConsistencyFileSystem,
+	slaSnapshotConsistencyApp,
+}
+
+// slaExchangeConfig holds the Microsoft Exchange configuration of an SLA
+// domain.
+type slaExchangeConfig struct {
+	LogFrequency gqlsla.RetentionDuration `json:"logFrequency"`
+	LogRetention gqlsla.RetentionDuration `json:"logRetention"`
+}
+
+// slaK8sConfig holds the Kubernetes configuration of an SLA domain.
+type slaK8sConfig struct {
+	IncludeClusterScopedResources bool `json:"shouldIncludeClusterScopedResources"`
+	IncludePVCs                   bool `json:"shouldIncludePvcs"`
+}
+
+// slaO365ConfigInput holds the Microsoft 365 configuration of an SLA domain
+// when creating or updating the SLA domain.
+type slaO365ConfigInput struct {
+	ArchivalTieringSpec *gqlsla.ArchivalTieringSpec `json:"archivalTieringSpecInput,omitempty"`
+	BackupWindows       []gqlsla.BackupWindow       `json:"backupWindows,omitempty"`
+}
+
+// slaO365Config holds the Microsoft 365 configuration of an SLA domain.
+type slaO365Config struct {
+	ArchivalTieringSpec *gqlsla.ArchivalTieringSpec `json:"archivalTieringSpec"`
+	BackupWindows       []gqlsla.BackupWindow       `json:"backupWindows"`
+}
+
+// slaVMwareVMConfig holds the VMware vSphere VM configuration of an SLA
+// domain. Extends the SDK configuration, which only holds the log retention.
+type slaVMwareVMConfig struct {
+	LogRetentionSeconds        int64  `json:"logRetentionSeconds,omitempty"`
+	SnapshotConsistencyMandate string `json:"snapshotConsistencyMandate,omitempty"`
+}
+
+// slaWorkloadConfigs holds the workload configurations of an SLA domain not
+// yet supported by the SDK. A nil configuration is not set.
+type slaWorkloadConfigs struct {
+	ExchangeConfig *slaExchangeConfig
+	K8sConfig      *slaK8sConfig
+	O365Config     *slaO365ConfigInput
+	VMwareVMConfig *slaVMwareVMConfig
+}
+
+// isEmpty returns true if none of the workload configurations are set.
+func (w slaWorkloadConfigs) isEmpty() bool {
+	return w.ExchangeConfig == nil && w.K8sConfig == nil && w.O365Config == nil && w.VMwareVMConfig == nil
+}
+
+// slaWorkloadConfigsReply holds the workload configurations of an SLA domain
+// not yet read by the SDK.
+type slaWorkloadConfigsReply struct {
+	ExchangeConfig *slaExchangeConfig `json:"exchangeConfig"`
+	K8sConfig      *slaK8sConfig      `json:"k8sConfig"`
+	O365Config     *slaO365Config     `json:"o365Config"`
+	VMwareVMConfig *slaVMwareVMConfig `json:"vmwareVmConfig"`
+}
+
+// slaObjectSpecificConfigsInput extends the SDK object specific configurations
+// with the workload configurations. The VMware vSphere VM configuration
+// replaces the configuration of the SDK. The SDK configurations are embedded
+// by value, since the request logging of the SDK can't handle nil embedded
+// pointers. All SDK configurations are omitted when empty.
+type slaObjectSpecificConfigsInput struct {
+	gqlsla.ObjectSpecificConfigs
+	ExchangeConfig *slaExchangeConfig  `json:"exchangeConfigInput,omitempty"`
+	K8sConfig      *slaK8sConfig       `json:"k8sConfigInput,omitempty"`
+	O365Config     *slaO365ConfigInput `json:"o365ConfigInput,omitempty"`
+	VMwareVMConfig *slaVMwareVMConfig  `json:"vmwareVmConfigInput,omitempty"`
+}
+
+// objectSpecificConfigsInput returns the SDK object specific configurations
+// extended with the workload configurations. The SDK configurations can be
+// nil.
+func (w slaWorkloadConfigs) objectSpecificConfigsInput(configs *gqlsla.ObjectSpecificConfigs) *slaObjectSpecificConfigsInput {
+	var sdkConfigs gqlsla.ObjectSpecificConfigs
+	if configs != nil {
+		sdkConfigs = *configs
+	}
+
+	return &slaObjectSpecificConfigsInput{
+		ObjectSpecificConfigs: sdkConfigs,
+		ExchangeConfig:        w.ExchangeConfig,
+		K8sConfig:             w.K8sConfig,
+		O365Config:            w.O365Config,
+		VMwareVMConfig:        w.VMwareVMConfig,
+	}
+}
+
+// createSLADomain creates an SLA domain with the workload configurations and
+// returns the ID of the SLA domain. The SDK is used when no workload
+// configurations are set.
+func createSLADomain(ctx context.Context, polarisClient *polaris.Client, params gqlsla.CreateDomainParams, workload slaWorkloadConfigs) (uuid.UUID, error) {
+	if workload.isEmpty() {
+		return sla.Wrap(polarisClient).CreateDomain(ctx, params)
+	}
+
+	var result struct {
+		ID uuid.UUID `json:"id"`
+	}
+	err := gqlRequest(ctx, polarisClient.GQL, createSLADomainQuery, struct {
+		gqlsla.CreateDomainParams
+		ObjectSpecificConfigs *slaObjectSpecificConfigsInput `json:"objectSpecificConfigsInput,omitempty"`
+	}{
+		CreateDomainParams:    params,
+		ObjectSpecificConfigs: workload.objectSpecificConfigsInput(params.ObjectSpecificConfigs),
+	}, &result)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create SLA domain: %w", err)
+	}
+
+	return result.ID, nil
+}
+
+// updateSLADomain updates the SLA domain with the workload configurations. The
+// SDK is used when no workload configurations are set.
+func updateSLADomain(ctx context.Context, polarisClient *polaris.Client, params gqlsla.UpdateDomainParams, workload slaWorkloadConfigs) error {
+	if workload.isEmpty() {
+		return sla.Wrap(polarisClient).UpdateDomain(ctx, params)
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	err := gqlRequest(ctx, polarisClient.GQL, updateSLADomainQuery, struct {
+		gqlsla.UpdateDomainParams
+		ObjectSpecificConfigs *slaObjectSpecificConfigsInput `json:"objectSpecificConfigsInput,omitempty"`
+	}{
+		UpdateDomainParams:    params,
+		ObjectSpecificConfigs: workload.objectSpecificConfigsInput(params.ObjectSpecificConfigs),
+	}, &result)
+	if err != nil {
+		return fmt.Errorf("failed to update SLA domain %s: %w", params.ID, err)
+	}
+
+	return nil
+}
+
+// slaDomainWorkloadConfigs returns the workload configurations of the SLA
+// domain with the specified ID.
+func slaDomainWorkloadConfigs(ctx context.Context, gql *graphql.Client, slaID uuid.UUID) (slaWorkloadConfigsReply, error) {
+	var result struct {
+		ObjectSpecificConfigs slaWorkloadConfigsReply `json:"objectSpecificConfigs"`
+	}
+	err := gqlRequest(ctx, gql, slaWorkloadConfigsQuery, struct {
+		ID uuid.UUID `json:"id"`
+	}{ID: slaID}, &result)
+	if err != nil {
+		return slaWorkloadConfigsReply{}, fmt.Errorf("failed to get workload configurations of SLA domain %s: %w",
+			slaID, err)
+	}
+
+	return result.ObjectSpecificConfigs, nil
+}
@@ -0,0 +1,177 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+func TestCreateSLADomainWorkloadConfigs(t *testing.T) {
+	slaID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a04")
+
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return map[string]any{"id": slaID}, nil
+	})
+
+	id, err := createSLADomain(context.Background(), c.polarisClient, gqlsla.CreateDomainParams{
+		Name: "workload",
+		ObjectSpecificConfigs: &gqlsla.ObjectSpecificConfigs{
+			VMwareVMConfig: &gqlsla.VMwareVMConfig{LogRetentionSeconds: 3600},
+		},
+	}, slaWorkloadConfigs{
+		K8sConfig:      &slaK8sConfig{IncludePVCs: true},
+		VMwareVMConfig: &slaVMwareVMConfig{LogRetentionSeconds: 3600, SnapshotConsistencyMandate: slaSnapshotConsistencyApp},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != slaID {
+		t.Errorf("expected SLA domain ID %s, got %s", slaID, id)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 1 || reqs[0].Operation != "SdkGolangCreateGlobalSla" {
+		t.Fatalf("expected a single SdkGolangCreateGlobalSla request, got %+v", reqs)
+	}
+	if name := reqs[0].Variables["name"]; name != "workload" {
+		t.Errorf("expected name variable %q, got %v", "workload", name)
+	}
+	configs, ok := reqs[0].Variables["objectSpecificConfigsInput"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected objectSpecificConfigsInput variable, got %v", reqs[0].Variables)
+	}
+	k8s, ok := configs["k8sConfigInput"].(map[string]any)
+	if !ok || k8s["shouldIncludePvcs"] != true || k8s["shouldIncludeClusterScopedResources"] != false {
+		t.Errorf("unexpected k8sConfigInput %v", configs["k8sConfigInput"])
+	}
+	vmware, ok := configs["vmwareVmConfigInput"].(map[string]any)
+	if !ok || vmware["snapshotConsistencyMandate"] != slaSnapshotConsistencyApp || vmware["logRetentionSeconds"] != 3600.0 {
+		t.Errorf("unexpected vmwareVmConfigInput %v", configs["vmwareVmConfigInput"])
+	}
+	if _, ok := configs["o365ConfigInput"]; ok {
+		t.Errorf("expected no o365ConfigInput, got %v", configs["o365ConfigInput"])
+	}
+
+	// Without object specific configurations from the SDK, only the workload
+	// configurations are sent.
+	_, err = createSLADomain(context.Background(), c.polarisClient, gqlsla.CreateDomainParams{Name: "workload"},
+		slaWorkloadConfigs{K8sConfig: &slaK8sConfig{IncludePVCs: true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reqs = srv.Requests()
+	configs, ok = reqs[len(reqs)-1].Variables["objectSpecificConfigsInput"].(map[string]any)
+	if !ok || len(configs) != 1 || configs["k8sConfigInput"] == nil {
+		t.Errorf("unexpected objectSpecificConfigsInput %v", reqs[len(reqs)-1].Variables["objectSpecificConfigsInput"])
+	}
+}
+
+// TestSLADomainWithoutWorkloadConfigs verifies that the SLA domain is created
+// and updated by the SDK when no workload configurations are set, without
+// object specific configurations.
+func TestSLADomainWithoutWorkloadConfigs(t *testing.T) {
+	slaID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a04")
+
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return map[string]any{"id": slaID.String()}, nil
+	})
+
+	params := gqlsla.CreateDomainParams{Name: "gold"}
+	id, err := createSLADomain(context.Background(), c.polarisClient, params, slaWorkloadConfigs{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != slaID {
+		t.Errorf("expected SLA domain ID %s, got %s", slaID, id)
+	}
+	err = updateSLADomain(context.Background(), c.polarisClient, gqlsla.UpdateDomainParams{
+		ID:                 slaID,
+		CreateDomainParams: params,
+	}, slaWorkloadConfigs{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(reqs))
+	}
+	for i, op := range []string{"SdkGolangCreateGlobalSla", "SdkGolangUpdateGlobalSla"} {
+		if reqs[i].Operation != op {
+			t.Errorf("expected operation %q, got %q", op, reqs[i].Operation)
+		}
+		if configs, ok := reqs[i].Variables["objectSpecificConfigsInput"]; ok {
+			t.Errorf("expected no objectSpecificConfigsInput, got %v", configs)
+		}
+	}
+}
+
+func TestSLADomainWorkloadConfigs(t *testing.T) {
+	slaID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a04")
+
+	c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return map[string]any{
+			"objectSpecificConfigs": map[string]any{
+				"exchangeConfig": map[string]any{
+					"logFrequency": map[string]any{"duration": 30, "unit": "MINUTES"},
+					"logRetention": map[string]any{"duration": 7, "unit": "DAYS"},
+				},
+				"o365Config": map[string]any{
+					"backupWindows": []map[string]any{{
+						"durationInHours": 8,
+						"startTimeAttributes": map[string]any{
+							"dayOfWeek": map[string]any{"day": "MONDAY"},
+							"hour":      22,
+							"minute":    0,
+						},
+					}},
+				},
+				"vmwareVmConfig": map[string]any{"snapshotConsistencyMandate": slaSnapshotConsistencyCrash},
+			},
+		}, nil
+	})
+
+	configs, err := slaDomainWorkloadConfigs(context.Background(), c.polarisClient.GQL, slaID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reqs := srv.Requests(); len(reqs) != 1 || reqs[0].Variables["id"] != slaID.String() {
+		t.Fatalf("expected a single request for SLA domain %s, got %+v", slaID, reqs)
+	}
+
+	if c := configs.ExchangeConfig; c == nil || c.LogFrequency.Duration != 30 || c.LogRetention.Unit != gqlsla.Days {
+		t.Errorf("unexpected exchange config %+v", configs.ExchangeConfig)
+	}
+	if configs.K8sConfig != nil {
+		t.Errorf("expected no k8s config, got %+v", configs.K8sConfig)
+	}
+	if c := configs.O365Config; c == nil || len(c.BackupWindows) != 1 ||
+		c.BackupWindows[0].StartTime.DayOfWeek.Day != gqlsla.Monday || c.ArchivalTieringSpec != nil {
+		t.Errorf("unexpected o365 config %+v", configs.O365Config)
+	}
+	if c := configs.VMwareVMConfig; c == nil || c.SnapshotConsistencyMandate != slaSnapshotConsistencyCrash {
+		t.Errorf("unexpected vmware config %+v", configs.VMwareVMConfig)
+	}
+}
@@ -0,0 +1,369 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+// slaEstimateQuery is the GraphQL query used to read the storage used by the
+// objects an SLA domain estimate is made for.
+const slaEstimateQuery = `query SdkGolangSlaEstimate($after: String, $filter: SnappableFilterInput) {
+    result: snappableConnection(after: $after, filter: $filter) {
+        edges {
+            node {
+                fid
+                name
+                objectType
+                logicalBytes
+                physicalBytes
+                localSnapshots
+            }
+        }
+        pageInfo {
+            endCursor
+            hasNextPage
+        }
+    }
+}`
+
+// slaEstimateObject holds the storage used by an object.
+type slaEstimateObject struct {
+	ID             uuid.UUID `json:"fid"`
+	Name           string    `json:"name"`
+	ObjectType     string    `json:"objectType"`
+	LogicalBytes   int64     `json:"logicalBytes"`
+	PhysicalBytes  int64     `json:"physicalBytes"`
+	LocalSnapshots int64     `json:"localSnapshots"`
+}
+
+// snapshotBytes returns the estimated physical storage used by a snapshot of
+// the object. This is the average physical storage used by the local snapshots
+// of the object. Objects without local snapshots use the logical size, i.e.
+// a full snapshot without data reduction.
+func (o slaEstimateObject) snapshotBytes() int64 {
+	if o.LocalSnapshots > 0 {
+		return o.PhysicalBytes / o.LocalSnapshots
+	}
+
+	return o.LogicalBytes
+}
+
+// slaEstimateObjects returns the storage used by the objects with the
+// specified object IDs.
+func slaEstimateObjects(ctx context.Context, gql *graphql.Client, objectIDs []uuid.UUID) ([]slaEstimateObject, error) {
+	type filter struct {
+		ObjectIDs []uuid.UUID `json:"objectFid"`
+	}
+
+	var objects []slaEstimateObject
+	var cursor string
+	for {
+		var result struct {
+			Edges []struct {
+				Node slaEstimateObject `json:"node"`
+			} `json:"edges"`
+			PageInfo struct {
+				EndCursor   string `json:"endCursor"`
+				HasNextPage bool   `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		}
+		params := struct {
+			After  string `json:"after,omitempty"`
+			Filter filter `json:"filter"`
+		}{After: cursor, Filter: filter{ObjectIDs: objectIDs}}
+		if err := gqlRequest(ctx, gql, slaEstimateQuery, params, &result); err != nil {
+			return nil, err
+		}
+		for _, edge := range result.Edges {
+			objects = append(objects, edge.Node)
+		}
+		if !result.PageInfo.HasNextPage {
+			break
+		}
+		cursor = result.PageInfo.EndCursor
+	}
+
+	return objects, nil
+}
+
+// slaRetentionUnitMinutes holds the length of the retention units in minutes.
+// Months, quarters and years use their average length.
+var slaRetentionUnitMinutes = map[gqlsla.RetentionUnit]int64{
+	gqlsla.Minute:   1,
+	gqlsla.Hours:    60,
+	gqlsla.Days:     24 * 60,
+	gqlsla.Weeks:    7 * 24 * 60,
+	gqlsla.Months:   43830,
+	gqlsla.Quarters: 3 * 43830,
+	gqlsla.Years:    12 * 43830,
+}
+
+func slaDurationMinutes(duration int, unit gqlsla.RetentionUnit) int64 {
+	return int64(duration) * slaRetentionUnitMinutes[unit]
+}
+
+// slaSnapshotTier holds the snapshots taken for one frequency of an SLA domain
+// snapshot schedule. Snapshots are taken every interval and retained from the
+// age from until the age to, all durations being in minutes. Snapshots younger
+// than from are retained by a more frequent tier.
+type slaSnapshotTier struct {
+	frequency gqlsla.RetentionUnit
+	interval  int64
+	from      int64
+	to        int64
+}
+
+// count returns the number of snapshots of the tier with an age between start
+// and end. A negative end means no upper limit.
+func (t slaSnapshotTier) count(start, end int64) int64 {
+	start = max(start, t.from)
+	if end < 0 || end > t.to {
+		end = t.to
+	}
+	if end <= start {
+		return 0
+	}
+
+	return (end - start) / t.interval
+}
+
+// slaSnapshotTiers returns the snapshot tiers of the snapshot schedule,
+// ordered from the most frequent to the least frequent. A snapshot schedule
+// which doesn't retain snapshots beyond the more frequent schedules doesn't
+// add a tier.
+func slaSnapshotTiers(schedule gqlsla.SnapshotSchedule) []slaSnapshotTier {
+	var tiers []slaSnapshotTier
+	var horizon int64
+	add := func(frequency gqlsla.RetentionUnit, s gqlsla.BasicSnapshotSchedule) {
+		interval := slaDurationMinutes(s.Frequency, frequency)
+		retention := slaDurationMinutes(s.Retention, s.RetentionUnit)
+		if interval <= 0 || retention <= horizon {
+			return
+		}
+		tiers = append(tiers, slaSnapshotTier{frequency: frequency, interval: interval, from: horizon, to: retention})
+		horizon = retention
+	}
+	if s := schedule.Minute; s != nil {
+		add(gqlsla.Minute, s.BasicSchedule)
+	}
+	if s := schedule.Hourly; s != nil {
+		add(gqlsla.Hours, s.BasicSchedule)
+	}
+	if s := schedule.Daily; s != nil {
+		add(gqlsla.Days, s.BasicSchedule)
+	}
+	if s := schedule.Weekly; s != nil {
+		add(gqlsla.Weeks, s.BasicSchedule)
+	}
+	if s := schedule.Monthly; s != nil {
+		add(gqlsla.Months, s.BasicSchedule)
+	}
+	if s := schedule.Quarterly; s != nil {
+		add(gqlsla.Quarters, s.BasicSchedule)
+	}
+	if s := schedule.Yearly; s != nil {
+		add(gqlsla.Years, s.BasicSchedule)
+	}
+
+	return tiers
+}
+
+// slaEstimate holds the estimated number of snapshots retained per object by
+// an SLA domain.
+type slaEstimate struct {
+	Snapshots           int64
+	LocalSnapshots      int64
+	ReplicatedSnapshots int64
+	Archival            []slaArchivalEstimate
+}
+
+// slaArchivalEstimate holds the estimated number of snapshots per object
+// retained by an archival location. Cold snapshots have been tiered to the
+// cold storage class.
+type slaArchivalEstimate struct {
+	ArchivalLocationID uuid.UUID
+	ColdStorageClass   string
+	Snapshots          int64
+	ColdSnapshots      int64
+}
+
+// slaEstimateSnapshots returns the estimated number of snapshots retained per
+// object by the snapshot schedule, local retention, archival and replication
+// specifications of an SLA domain. Replicated snapshots are summed over all
+// replication specifications.
+func slaEstimateSnapshots(schedule gqlsla.SnapshotSchedule, localRetention *gqlsla.RetentionDuration, archivalSpecs []gqlsla.ArchivalSpec, replicationSpecs []gqlsla.ReplicationSpec) slaEstimate {
+	tiers := slaSnapshotTiers(schedule)
+	count := func(start, end int64, frequencies []gqlsla.RetentionUnit) int64 {
+		var n int64
+		for _, tier := range tiers {
+			if len(frequencies) == 0 || slices.Contains(frequencies, tier.frequency) {
+				n += tier.count(start, end)
+			}
+		}
+		return n
+	}
+
+	var estimate slaEstimate
+	estimate.Snapshots = count(0, -1, nil)
+	estimate.LocalSnapshots = estimate.Snapshots
+	if localRetention != nil && len(archivalSpecs) > 0 {
+		estimate.LocalSnapshots = count(0, slaDurationMinutes(localRetention.Duration, localRetention.Unit), nil)
+	}
+	for _, spec := range replicationSpecs {
+		if spec.RetentionDuration == nil {
+			continue
+		}
+		estimate.ReplicatedSnapshots += count(0, slaDurationMinutes(spec.RetentionDuration.Duration,
+			spec.RetentionDuration.Unit), nil)
+	}
+	for _, spec := range archivalSpecs {
+		threshold := slaDurationMinutes(spec.Threshold, spec.ThresholdUnit)
+		archival := slaArchivalEstimate{
+			ArchivalLocationID: spec.GroupID,
+			Snapshots:          count(threshold, -1, spec.Frequencies),
+		}
+		if tiering := spec.ArchivalTieringSpec; tiering != nil {
+			archival.ColdStorageClass = string(tiering.ColdStorageClass)
+			if tiering.InstantTiering {
+				archival.ColdSnapshots = archival.Snapshots
+			} else {
+				archival.ColdSnapshots = count(threshold+tiering.MinAccessibleDurationInSeconds/60, -1,
+					spec.Frequencies)
+			}
+		}
+		estimate.Archival = append(estimate.Archival, archival)
+	}
+
+	return estimate
+}
+
+// slaDomainModelFromConfiguration returns the SLA domain model of the SLA
+// domain configuration. The configuration uses the field names and structure
+// of the SLA domain resource, fields not specified are null.
+func slaDomainModelFromConfiguration(ctx context.Context, config tftypes.Value) (slaDomainModel, error) {
+	s := slaDomainSchema()
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	var configValues map[string]tftypes.Value
+	if !config.Type().Is(tftypes.Object{}) && !config.Type().Is(tftypes.Map{}) {
+		return slaDomainModel{}, fmt.Errorf("configuration must be an object")
+	}
+	if err := config.As(&configValues); err != nil {
+		return slaDomainModel{}, fmt.Errorf("failed to read configuration: %s", err)
+	}
+
+	keys := append([]string{keyObjectTypes}, slaDomainCloneKeys...)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for key, typ := range objectType.AttributeTypes {
+		values[key] = tftypes.NewValue(typ, nil)
+	}
+	for key, value := range configValues {
+		if !slices.Contains(keys, key) {
+			return slaDomainModel{}, fmt.Errorf("unsupported configuration field: %s", key)
+		}
+		var err error
+		if values[key], err = conformValue(key, objectType.AttributeTypes[key], value); err != nil {
+			return slaDomainModel{}, err
+		}
+	}
+
+	var model slaDomainModel
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, values)}
+	if diags := state.Get(ctx, &model); diags.HasError() {
+		return slaDomainModel{}, fmt.Errorf("failed to read configuration: %s", diags.Errors()[0].Detail())
+	}
+
+	return model, nil
+}
+
+// conformValue converts the value to the specified type. Tuples are converted
+// to lists and sets, and missing object attributes are set to null. The name
+// is used to identify the value in errors.
+func conformValue(name string, typ tftypes.Type, value tftypes.Value) (tftypes.Value, error) {
+	if value.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !value.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		if !value.Type().Is(tftypes.Object{}) && !value.Type().Is(tftypes.Map{}) {
+			return tftypes.Value{}, fmt.Errorf("%s must be an object", name)
+		}
+		var attrValues map[string]tftypes.Value
+		if err := value.As(&attrValues); err != nil {
+			return tftypes.Value{}, fmt.Errorf("failed to read %s: %s", name, err)
+		}
+		values := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+		for key, attrType := range typ.AttributeTypes {
+			values[key] = tftypes.NewValue(attrType, nil)
+		}
+		for key, attrValue := range attrValues {
+			attrType, ok := typ.AttributeTypes[key]
+			if !ok {
+				return tftypes.Value{}, fmt.Errorf("unsupported field %s.%s", name, key)
+			}
+			var err error
+			if values[key], err = conformValue(name+"."+key, attrType, attrValue); err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		return tftypes.NewValue(typ, values), nil
+	case tftypes.List, tftypes.Set:
+		var elemType tftypes.Type
+		if list, ok := typ.(tftypes.List); ok {
+			elemType = list.ElementType
+		} else {
+			elemType = typ.(tftypes.Set).ElementType
+		}
+		if !value.Type().Is(tftypes.List{}) && !value.Type().Is(tftypes.Set{}) && !value.Type().Is(tftypes.Tuple{}) {
+			return tftypes.Value{}, fmt.Errorf("%s must be a list", name)
+		}
+		var elems []tftypes.Value
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, fmt.Errorf("failed to read %s: %s", name, err)
+		}
+		for i, elem := range elems {
+			var err error
+			if elems[i], err = conformValue(fmt.Sprintf("%s[%d]", name, i), elemType, elem); err != nil {
+				return tftypes.Value{}, err
+			}
+		}
+		return tftypes.NewValue(typ, elems), nil
+	default:
+		if !value.Type().Equal(typ) {
+			return tftypes.Value{}, fmt.Errorf("%s must be a %s", name, strings.ToLower(strings.TrimPrefix(typ.String(), "tftypes.")))
+		}
+		return value, nil
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+func TestSLAEstimateObjectSnapshotBytes(t *testing.T) {
+	object := slaEstimateObject{LogicalBytes: 1000, PhysicalBytes: 1200, LocalSnapshots: 4}
+	if got := object.snapshotBytes(); got != 300 {
+		t.Errorf("expected 300, got %d", got)
+	}
+
+	object = slaEstimateObject{LogicalBytes: 1000}
+	if got := object.snapshotBytes(); got != 1000 {
+		t.Errorf("expected 1000, got %d", got)
+	}
+}
+
+func TestSLASnapshotTiers(t *testing.T) {
+	tiers := slaSnapshotTiers(gqlsla.SnapshotSchedule{
+		Daily: &gqlsla.DailySnapshotSchedule{BasicSchedule: gqlsla.BasicSnapshotSchedule{
+			Frequency: 1, Retention: 30, RetentionUnit: gqlsla.Days,
+		}},
+		Weekly: &gqlsla.WeeklySnapshotSchedule{BasicSchedule: gqlsla.BasicSnapshotSchedule{
+			Frequency: 1, Retention: 2, RetentionUnit: gqlsla.Weeks,
+		}},
+	})
+
+	// The weekly schedule doesn't retain snapshots beyond the daily schedule.
+	if len(tiers) != 1 {
+		t.Fatalf("expected 1 tier, got %d", len(tiers))
+	}
+	if tier := tiers[0]; tier.frequency != gqlsla.Days || tier.interval != 1440 || tier.from != 0 || tier.to != 43200 {
+		t.Errorf("unexpected tier: %+v", tier)
+	}
+}
+
+func TestSLAEstimateSnapshots(t *testing.T) {
+	schedule := gqlsla.SnapshotSchedule{
+		Hourly: &gqlsla.HourlySnapshotSchedule{BasicSchedule: gqlsla.BasicSnapshotSchedule{
+			Frequency: 4, Retention: 7, RetentionUnit: gqlsla.Days,
+		}},
+		Daily: &gqlsla.DailySnapshotSchedule{BasicSchedule: gqlsla.BasicSnapshotSchedule{
+			Frequency: 1, Retention: 30, RetentionUnit: gqlsla.Days,
+		}},
+		Monthly: &gqlsla.MonthlySnapshotSchedule{BasicSchedule: gqlsla.BasicSnapshotSchedule{
+			Frequency: 1, Retention: 12, RetentionUnit: gqlsla.Months,
+		}},
+	}
+	archivalSpecs := []gqlsla.ArchivalSpec{{
+		GroupID:       uuid.MustParse("5d1f7b52-1e4e-4d1e-9d0a-3c6f0a0b6e61"),
+		Threshold:     30,
+		ThresholdUnit: gqlsla.Days,
+		ArchivalTieringSpec: &gqlsla.ArchivalTieringSpec{
+			MinAccessibleDurationInSeconds: 90 * 24 * 60 * 60,
+			ColdStorageClass:               "AZURE_ARCHIVE",
+		},
+	}, {
+		GroupID:             uuid.MustParse("0f2b8d1c-7a3e-4f5b-8c9d-1e2f3a4b5c6d"),
+		Frequencies:         []gqlsla.RetentionUnit{gqlsla.Months},
+		ArchivalTieringSpec: &gqlsla.ArchivalTieringSpec{InstantTiering: true},
+	}}
+	replicationSpecs := []gqlsla.ReplicationSpec{{
+		RetentionDuration: &gqlsla.RetentionDuration{Duration: 14, Unit: gqlsla.Days},
+	}}
+
+	estimate := slaEstimateSnapshots(schedule, &gqlsla.RetentionDuration{Duration: 7, Unit: gqlsla.Days},
+		archivalSpecs, replicationSpecs)
+
+	// 42 hourly snapshots over 7 days, 23 daily snapshots over the following
+	// 23 days and 11 monthly snapshots over the rest of the year.
+	if estimate.Snapshots != 76 {
+		t.Errorf("expected 76 snapshots, got %d", estimate.Snapshots)
+	}
+	if estimate.LocalSnapshots != 42 {
+		t.Errorf("expected 42 local snapshots, got %d", estimate.LocalSnapshots)
+	}
+	if estimate.ReplicatedSnapshots != 49 {
+		t.Errorf("expected 49 replicated snapshots, got %d", estimate.ReplicatedSnapshots)
+	}
+	if len(estimate.Archival) != 2 {
+		t.Fatalf("expected 2 archival estimates, got %d", len(estimate.Archival))
+	}
+	if archival := estimate.Archival[0]; archival.Snapshots != 11 || archival.ColdSnapshots != 8 ||
+		archival.ColdStorageClass != "AZURE_ARCHIVE" {
+		t.Errorf("unexpected archival estimate: %+v", archival)
+	}
+	if archival := estimate.Archival[1]; archival.Snapshots != 11 || archival.ColdSnapshots != 11 {
+		t.Errorf("unexpected archival estimate: %+v", archival)
+	}
+}
+
+func TestSLADomainModelFromConfiguration(t *testing.T) {
+	scheduleType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		keyFrequency:     tftypes.Number,
+		keyRetention:     tftypes.Number,
+		keyRetentionUnit: tftypes.String,
+	}}
+	configType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		keyDailySchedule: scheduleType,
+		keyObjectTypes:   tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+	}}
+	config := tftypes.NewValue(configType, map[string]tftypes.Value{
+		keyDailySchedule: tftypes.NewValue(scheduleType, map[string]tftypes.Value{
+			keyFrequency:     tftypes.NewValue(tftypes.Number, big.NewFloat(1)),
+			keyRetention:     tftypes.NewValue(tftypes.Number, big.NewFloat(7)),
+			keyRetentionUnit: tftypes.NewValue(tftypes.String, "DAYS"),
+		}),
+		keyObjectTypes: tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String}},
+			[]tftypes.Value{tftypes.NewValue(tftypes.String, "VSPHERE_OBJECT_TYPE")}),
+	})
+
+	model, err := slaDomainModelFromConfiguration(context.Background(), config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.DailySchedule == nil || model.DailySchedule.Retention.ValueInt64() != 7 {
+		t.Errorf("unexpected daily schedule: %+v", model.DailySchedule)
+	}
+	if objectTypes := model.objectTypes(); len(objectTypes) != 1 || objectTypes[0] != "VSPHERE_OBJECT_TYPE" {
+		t.Errorf("unexpected object types: %v", objectTypes)
+	}
+	if model.HourlySchedule != nil {
+		t.Errorf("expected no hourly schedule, got %+v", model.HourlySchedule)
+	}
+
+	config = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{keyName: tftypes.String}},
+		map[string]tftypes.Value{keyName: tftypes.NewValue(tftypes.String, "gold")})
+	if _, err := slaDomainModelFromConfiguration(context.Background(), config); err == nil {
+		t.Error("expected error for unsupported field")
+	}
+
+	config = tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{keyDailySchedule: tftypes.String}},
+		map[string]tftypes.Value{keyDailySchedule: tftypes.NewValue(tftypes.String, "daily")})
+	if _, err := slaDomainModelFromConfiguration(context.Background(), config); err == nil {
+		t.Error("expected error for invalid field type")
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// slaPauseStatusQuery is the GraphQL query used to read the Rubrik clusters of
+// an SLA domain and the clusters where the SLA domain is paused.
+const slaPauseStatusQuery = `query SdkGolangSlaPauseStatus($id: UUID!) {
+    result: slaDomain(id: $id) {
+        ... on GlobalSlaReply {
+            clusterToSyncStatusMap {
+                clusterUuid
+            }
+            pausedClustersInfo {
+                pausedClustersCount
+                clusterUuids
+            }
+        }
+    }
+}`
+
+// pauseSLAQuery is the GraphQL mutation used to pause and resume an SLA domain
+// on Rubrik clusters.
+const pauseSLAQuery = `mutation SdkGolangPauseSla($input: PauseSlaInput!) {
+    result: pauseSla(input: $input) {
+        success
+    }
+}`
+
+// clusterPauseStatusQuery is the GraphQL query used to read the pause status
+// of a Rubrik cluster.
+const clusterPauseStatusQuery = `query SdkGolangClusterPauseStatus($clusterUuid: UUID!) {
+    result: cluster(clusterUuid: $clusterUuid) {
+        pauseStatus
+    }
+}`
+
+// updateClusterPauseStatusQuery is the GraphQL mutation used to pause and
+// resume all protection on Rubrik clusters.
+const updateClusterPauseStatusQuery = `mutation SdkGolangUpdateClusterPauseStatus($input: UpdateClusterPauseStatusInput!) {
+    result: updateClusterPauseStatus(input: $input) {
+        pauseStatuses {
+            clusterUuid
+            success
+        }
+    }
+}`
+
+// errSLADomainNotSynced is returned when pausing an SLA domain which hasn't
+// been synced to any Rubrik cluster.
+var errSLADomainNotSynced = errors.New("SLA domain hasn't been synced to any Rubrik cluster, the SLA domain is " +
+	"paused once it has been synced and the resource is applied again")
+
+// pauseSLAInput is the input of the pauseSla mutation.
+type pauseSLAInput struct {
+	SLAID      uuid.UUID   `json:"slaId"`
+	ClusterIDs []uuid.UUID `json:"clusterUuids"`
+	PauseSLA   bool        `json:"pauseSla"`
+}
+
+// updateClusterPauseStatusInput is the input of the updateClusterPauseStatus
+// mutation.
+type updateClusterPauseStatusInput struct {
+	ClusterIDs        []uuid.UUID `json:"clusterUuids"`
+	TogglePauseStatus bool        `json:"togglePauseStatus"`
+}
+
+// clusterPauseStatusPaused is the pause status of a paused Rubrik cluster.
+const clusterPauseStatusPaused = "PAUSED"
+
+// slaPauseStatus holds the pause status of an SLA domain.
+type slaPauseStatus struct {
+	// ClusterIDs holds the IDs of the Rubrik clusters the SLA domain has been
+	// synced to.
+	ClusterIDs []uuid.UUID
+
+	// PausedClusterIDs holds the IDs of the Rubrik clusters where the SLA
+	// domain is paused.
+	PausedClusterIDs []uuid.UUID
+}
+
+// paused returns true if the SLA domain is paused on all the specified
+// clusters. If no clusters are specified, all clusters of the SLA domain are
+// used. An SLA domain without clusters is never paused.
+func (s slaPauseStatus) paused(clusterIDs []uuid.UUID) bool {
+	if len(clusterIDs) == 0 {
+		clusterIDs = s.ClusterIDs
+	}
+	if len(clusterIDs) == 0 {
+		return false
+	}
+
+	paused := make(map[uuid.UUID]struct{}, len(s.PausedClusterIDs))
+	for _, id := range s.PausedClusterIDs {
+		paused[id] = struct{}{}
+	}
+	for _, id := range clusterIDs {
+		if _, ok := paused[id]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// slaDomainPauseStatus returns the pause status of the SLA domain with the
+// specified ID.
+func slaDomainPauseStatus(ctx context.Context, gql *graphql.Client, slaID uuid.UUID) (slaPauseStatus, error) {
+	var result struct {
+		ClusterToSyncStatusMap []struct {
+			ClusterID uuid.UUID `json:"clusterUuid"`
+		} `json:"clusterToSyncStatusMap"`
+		PausedClustersInfo struct {
+			PausedClustersCount int         `json:"pausedClustersCount"`
+			ClusterIDs          []uuid.UUID `json:"clusterUuids"`
+		} `json:"pausedClustersInfo"`
+	}
+	err := gqlRequest(ctx, gql, slaPauseStatusQuery, struct {
+		ID uuid.UUID `json:"id"`
+	}{ID: slaID}, &result)
+	if err != nil {
+		return slaPauseStatus{}, fmt.Errorf("failed to get pause status of SLA domain %s: %w", slaID, err)
+	}
+
+	var status slaPauseStatus
+	for _, cluster := range result.ClusterToSyncStatusMap {
+		status.ClusterIDs = append(status.ClusterIDs, cluster.ClusterID)
+	}
+	status.PausedClusterIDs = result.PausedClustersInfo.ClusterIDs
+
+	return status, nil
+}
+
+// setSLADomainPaused pauses or resumes the SLA domain with the specified ID on
+// the specified Rubrik clusters. If no clusters are specified, the SLA domain
+// is paused or resumed on all clusters it has been synced to.
+func setSLADomainPaused(ctx context.Context, gql *graphql.Client, slaID uuid.UUID, clusterIDs []uuid.UUID, paused bool) error {
+	if len(clusterIDs) == 0 {
+		status, err := slaDomainPauseStatus(ctx, gql, slaID)
+		if err != nil {
+			return err
+		}
+		clusterIDs = status.ClusterIDs
+	}
+	if len(clusterIDs) == 0 {
+		return fmt.Errorf("SLA domain %s hasn't been synced to any Rubrik cluster, pausing only applies to SLA "+
+			"domains protecting objects on Rubrik clusters", slaID)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	err := gqlRequest(ctx, gql, pauseSLAQuery, struct {
+		Input pauseSLAInput `json:"input"`
+	}{Input: pauseSLAInput{SLAID: slaID, ClusterIDs: clusterIDs, PauseSLA: paused}}, &result)
+	if err == nil && !result.Success {
+		err = errors.New("operation not successful")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s SLA domain %s: %w", pauseAction(paused), slaID, err)
+	}
+
+	return nil
+}
+
+// clusterPaused returns true if all protection on the Rubrik cluster with the
+// specified ID is paused.
+func clusterPaused(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID) (bool, error) {
+	var result struct {
+		PauseStatus string `json:"pauseStatus"`
+	}
+	err := gqlRequest(ctx, gql, clusterPauseStatusQuery, struct {
+		ClusterID uuid.UUID `json:"clusterUuid"`
+	}{ClusterID: clusterID}, &result)
+	if err != nil {
+		return false, fmt.Errorf("failed to get pause status of cluster %s: %w", clusterID, err)
+	}
+
+	return result.PauseStatus == clusterPauseStatusPaused, nil
+}
+
+// setClusterPaused pauses or resumes all protection on the Rubrik cluster with
+// the specified ID.
+func setClusterPaused(ctx context.Context, gql *graphql.Client, clusterID uuid.UUID, paused bool) error {
+	var result struct {
+		PauseStatuses []struct {
+			ClusterID uuid.UUID `json:"clusterUuid"`
+			Success   bool      `json:"success"`
+		} `json:"pauseStatuses"`
+	}
+	err := gqlRequest(ctx, gql, updateClusterPauseStatusQuery, struct {
+		Input updateClusterPauseStatusInput `json:"input"`
+	}{Input: updateClusterPauseStatusInput{ClusterIDs: []uuid.UUID{clusterID}, TogglePauseStatus: paused}}, &result)
+	if err == nil {
+		for _, status := range result.PauseStatuses {
+			if status.ClusterID == clusterID && !status.Success {
+				err = errors.New("operation not successful")
+			}
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("failed to %s cluster %s: %w", pauseAction(paused), clusterID, err)
+	}
+
+	return nil
+}
+
+func pauseAction(paused bool) string {
+	if paused {
+		return "pause"
+	}
+	return "resume"
+}
@@ -0,0 +1,288 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSLAPauseStatusPaused(t *testing.T) {
+	cluster1 := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+	cluster2 := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a02")
+
+	tests := []struct {
+		name       string
+		status     slaPauseStatus
+		clusterIDs []uuid.UUID
+		want       bool
+	}{
+		{
+			name:   "NoClusters",
+			status: slaPauseStatus{},
+			want:   false,
+		},
+		{
+			name:   "PausedOnAllClusters",
+			status: slaPauseStatus{ClusterIDs: []uuid.UUID{cluster1, cluster2}, PausedClusterIDs: []uuid.UUID{cluster1, cluster2}},
+			want:   true,
+		},
+		{
+			name:   "PausedOnSomeClusters",
+			status: slaPauseStatus{ClusterIDs: []uuid.UUID{cluster1, cluster2}, PausedClusterIDs: []uuid.UUID{cluster1}},
+			want:   false,
+		},
+		{
+			name:       "PausedOnSpecifiedCluster",
+			status:     slaPauseStatus{ClusterIDs: []uuid.UUID{cluster1, cluster2}, PausedClusterIDs: []uuid.UUID{cluster1}},
+			clusterIDs: []uuid.UUID{cluster1},
+			want:       true,
+		},
+		{
+			name:       "NotPausedOnSpecifiedCluster",
+			status:     slaPauseStatus{ClusterIDs: []uuid.UUID{cluster1, cluster2}, PausedClusterIDs: []uuid.UUID{cluster1}},
+			clusterIDs: []uuid.UUID{cluster2},
+			want:       false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.status.paused(tc.clusterIDs); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSLAPausePlannedState(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		resumeAt   types.String
+		wantPaused bool
+		wantKnown  bool
+	}{
+		{
+			name:       "NoResumeAt",
+			resumeAt:   types.StringNull(),
+			wantPaused: true,
+			wantKnown:  true,
+		},
+		{
+			name:      "UnknownResumeAt",
+			resumeAt:  types.StringUnknown(),
+			wantKnown: false,
+		},
+		{
+			name:       "FutureResumeAt",
+			resumeAt:   types.StringValue("2026-10-18T13:00:00Z"),
+			wantPaused: true,
+			wantKnown:  true,
+		},
+		{
+			name:       "PastResumeAt",
+			resumeAt:   types.StringValue("2026-10-18T11:00:00Z"),
+			wantPaused: false,
+			wantKnown:  true,
+		},
+		{
+			name:       "ResumeAtNow",
+			resumeAt:   types.StringValue("2026-10-18T12:00:00Z"),
+			wantPaused: false,
+			wantKnown:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			paused, known := slaPausePlannedState(tc.resumeAt, now)
+			if known != tc.wantKnown {
+				t.Fatalf("expected known %t, got %t", tc.wantKnown, known)
+			}
+			if paused != tc.wantPaused {
+				t.Errorf("expected paused %t, got %t", tc.wantPaused, paused)
+			}
+		})
+	}
+}
+
+// TestSyncSLADomainPaused verifies that the pause state is only read and
+// changed when managed, and that the planned pause state is kept when the SLA
+// domain hasn't been synced to any Rubrik cluster.
+func TestSyncSLADomainPaused(t *testing.T) {
+	slaID := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a03")
+	cluster := uuid.MustParse("3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01")
+
+	tests := []struct {
+		name           string
+		paused         types.Bool
+		clusterIDs     []uuid.UUID
+		pausedIDs      []uuid.UUID
+		wantErr        error
+		wantOperations []string
+	}{{
+		name:   "NotManaged",
+		paused: types.BoolNull(),
+	}, {
+		name:           "NotSynced",
+		paused:         types.BoolValue(true),
+		wantErr:        errSLADomainNotSynced,
+		wantOperations: []string{"SdkGolangSlaPauseStatus"},
+	}, {
+		name:           "NotSyncedResume",
+		paused:         types.BoolValue(false),
+		wantOperations: []string{"SdkGolangSlaPauseStatus"},
+	}, {
+		name:           "Pause",
+		paused:         types.BoolValue(true),
+		clusterIDs:     []uuid.UUID{cluster},
+		wantOperations: []string{"SdkGolangSlaPauseStatus", "SdkGolangPauseSla"},
+	}, {
+		name:           "AlreadyPaused",
+		paused:         types.BoolValue(true),
+		clusterIDs:     []uuid.UUID{cluster},
+		pausedIDs:      []uuid.UUID{cluster},
+		wantOperations: []string{"SdkGolangSlaPauseStatus"},
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, srv := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				if req.Operation == "SdkGolangPauseSla" {
+					return map[string]any{"success": true}, nil
+				}
+				var clusters []map[string]any
+				for _, id := range tc.clusterIDs {
+					clusters = append(clusters, map[string]any{"clusterUuid": id})
+				}
+				return map[string]any{
+					"clusterToSyncStatusMap": clusters,
+					"pausedClustersInfo":     map[string]any{"clusterUuids": tc.pausedIDs},
+				}, nil
+			})
+
+			plan := slaDomainModel{Paused: tc.paused}
+			err := syncSLADomainPaused(context.Background(), c.polarisClient.GQL, slaID, &plan)
+			if !errors.Is(err, tc.wantErr) {
+				t.Fatalf("expected error %v, got %v", tc.wantErr, err)
+			}
+			if !plan.Paused.Equal(tc.paused) {
+				t.Errorf("expected paused %v, got %v", tc.paused, plan.Paused)
+			}
+
+			reqs := srv.Requests()
+			if len(reqs) != len(tc.wantOperations) {
+				t.Fatalf("expected %d requests, got %d", len(tc.wantOperations), len(reqs))
+			}
+			for i, req := range reqs {
+				if req.Operation != tc.wantOperations[i] {
+					t.Errorf("expected operation %q, got %q", tc.wantOperations[i], req.Operation)
+				}
+			}
+		})
+	}
+}
+
+// TestSLAPauseResourceReadNotFound verifies that the resource is removed from
+// the state when the SLA domain or the cluster no longer exists, and that
+// other errors are reported.
+func TestSLAPauseResourceReadNotFound(t *testing.T) {
+	slaID := "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a03"
+	clusterID := "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01"
+	forbidden := gqlTestError{Message: "forbidden", Code: http.StatusForbidden}
+
+	tests := []struct {
+		name        string
+		model       slaPauseModel
+		domains     []any
+		clusters    []any
+		wantRemoved bool
+		wantErr     bool
+	}{{
+		name:        "SLADomainDeleted",
+		model:       slaPauseModel{SLADomainIDs: setFromStrings([]string{slaID})},
+		wantRemoved: true,
+	}, {
+		name:    "SLADomainExists",
+		model:   slaPauseModel{SLADomainIDs: setFromStrings([]string{slaID})},
+		domains: []any{map[string]any{"id": slaID, "name": "gold"}},
+		wantErr: true,
+	}, {
+		name:        "ClusterDeleted",
+		model:       slaPauseModel{ClusterID: types.StringValue(clusterID), SLADomainIDs: types.SetNull(types.StringType)},
+		wantRemoved: true,
+	}, {
+		name:     "ClusterExists",
+		model:    slaPauseModel{ClusterID: types.StringValue(clusterID), SLADomainIDs: types.SetNull(types.StringType)},
+		clusters: []any{map[string]any{"node": map[string]any{"id": clusterID}}},
+		wantErr:  true,
+	}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				switch req.Operation {
+				case "SdkGolangSlaDomain":
+					return nil, forbidden
+				case "SdkGolangSlaDomains":
+					return map[string]any{"nodes": tc.domains}, nil
+				case "SdkGolangAllClustersConnection":
+					return map[string]any{"edges": tc.clusters}, nil
+				}
+				return nil, forbidden
+			})
+
+			r := &slaPauseResource{client: c}
+			var schemaRes resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaRes)
+			tc.model.ID = types.StringValue(uuid.NewString())
+			tc.model.Paused = types.BoolValue(true)
+			tc.model.ResumeAt = types.StringNull()
+			if tc.model.ClusterID.ValueString() == "" {
+				tc.model.ClusterID = types.StringNull()
+			}
+			state := tfsdk.State{Schema: schemaRes.Schema}
+			if diags := state.Set(ctx, &tc.model); diags.HasError() {
+				t.Fatalf("failed to set state: %v", diags)
+			}
+
+			res := resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, &res)
+			if got := res.Diagnostics.HasError(); got != tc.wantErr {
+				t.Fatalf("expected error %t, got: %v", tc.wantErr, res.Diagnostics)
+			}
+			if removed := res.State.Raw.IsNull(); removed != tc.wantRemoved {
+				t.Errorf("expected removed %t, got %t", tc.wantRemoved, removed)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+// slaRetentionKeys holds the fields of the SLA domain with a retention which
+// can't be reduced for a retention locked SLA domain.
+var slaRetentionKeys = []string{
+	keyMinuteSchedule,
+	keyHourlySchedule,
+	keyDailySchedule,
+	keyWeeklySchedule,
+	keyMonthlySchedule,
+	keyQuarterlySchedule,
+	keyYearlySchedule,
+	keyLocalRetention,
+}
+
+// stateRetentionLockMode returns the retention lock mode of the SLA domain in
+// the state. State written before the retention lock mode was read falls back
+// to the configured retention lock.
+func stateRetentionLockMode(ctx context.Context, state tfsdk.State) (gqlsla.RetentionLockMode, diag.Diagnostics) {
+	var mode types.String
+	diags := state.GetAttribute(ctx, path.Root(keyRetentionLockMode), &mode)
+	if diags.HasError() {
+		return "", diags
+	}
+	if !mode.IsNull() {
+		return gqlsla.RetentionLockMode(mode.ValueString()), diags
+	}
+
+	var lock types.Object
+	diags.Append(state.GetAttribute(ctx, path.Root(keyRetentionLock), &lock)...)
+	if diags.HasError() || lock.IsNull() {
+		return gqlsla.NoLock, diags
+	}
+	if mode, ok := lock.Attributes()[keyMode].(types.String); ok && !mode.IsNull() {
+		return gqlsla.RetentionLockMode(mode.ValueString()), diags
+	}
+
+	return gqlsla.NoLock, diags
+}
+
+// validateRetentionLockDestroy rejects deleting an SLA domain with a
+// COMPLIANCE mode retention lock and warns about deleting an SLA domain with a
+// GOVERNANCE mode retention lock.
+func validateRetentionLockDestroy(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	mode, diags := stateRetentionLockMode(ctx, req.State)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	switch mode {
+	case gqlsla.Compliance:
+		res.Diagnostics.AddError("Retention locked SLA Domain",
+			"the SLA Domain has a COMPLIANCE mode retention lock and cannot be deleted. To stop managing the SLA "+
+				"Domain with Terraform, remove it from the state using a removed block or terraform state rm.")
+	case gqlsla.Protection:
+		res.Diagnostics.AddWarning("Retention locked SLA Domain",
+			"the SLA Domain has a GOVERNANCE mode retention lock, deleting it requires the privilege to modify "+
+				"retention locked SLA Domains.")
+	}
+}
+
+// planRetentionLock plans the retention lock mode and the time the SLA domain
+// was locked, and checks the planned changes against the retention lock of
+// the SLA domain in the state. Changes not allowed by a COMPLIANCE mode
+// retention lock are errors, changes requiring privileges for a GOVERNANCE
+// mode retention lock are warnings.
+func planRetentionLock(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
+	var lock types.Object
+	res.Diagnostics.Append(res.Plan.GetAttribute(ctx, path.Root(keyRetentionLock), &lock)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	planMode := types.StringValue(string(gqlsla.NoLock))
+	switch {
+	case lock.IsUnknown():
+		planMode = types.StringUnknown()
+	case !lock.IsNull():
+		if mode, ok := lock.Attributes()[keyMode].(types.String); ok {
+			planMode = mode
+		}
+	}
+
+	stateMode := gqlsla.NoLock
+	stateLockedAt := types.StringNull()
+	if !req.State.Raw.IsNull() {
+		var diags diag.Diagnostics
+		stateMode, diags = stateRetentionLockMode(ctx, req.State)
+		res.Diagnostics.Append(diags...)
+		res.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(keyLockedAt), &stateLockedAt)...)
+		if res.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The lock time is kept while the SLA domain stays locked.
+	lockedAt := types.StringUnknown()
+	switch {
+	case planMode.IsUnknown():
+	case gqlsla.RetentionLockMode(planMode.ValueString()) == gqlsla.NoLock:
+		lockedAt = types.StringNull()
+	case stateMode != gqlsla.NoLock && !stateLockedAt.IsNull():
+		lockedAt = stateLockedAt
+	}
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root(keyRetentionLockMode), planMode)...)
+	res.Diagnostics.Append(res.Plan.SetAttribute(ctx, path.Root(keyLockedAt), lockedAt)...)
+	if res.Diagnostics.HasError() || stateMode == gqlsla.NoLock {
+		return
+	}
+
+	changes, diags := slaRetentionLockChanges(ctx, req.State, res.Plan)
+	res.Diagnostics.Append(diags...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	if !planMode.IsUnknown() && gqlsla.RetentionLockMode(planMode.ValueString()) != stateMode {
+		changes = append(changes, fmt.Sprintf("retention lock mode changed from %s to %s", stateMode,
+			planMode.ValueString()))
+	}
+	if len(changes) == 0 {
+		return
+	}
+
+	detail := fmt.Sprintf("the SLA Domain has a %s mode retention lock", stateMode)
+	switch stateMode {
+	case gqlsla.Compliance:
+		res.Diagnostics.AddError("Retention locked SLA Domain",
+			fmt.Sprintf("%s, which doesn't allow the following changes:\n  - %s", detail,
+				strings.Join(changes, "\n  - ")))
+	case gqlsla.Protection:
+		res.Diagnostics.AddWarning("Retention locked SLA Domain",
+			fmt.Sprintf("%s, the following changes require the privilege to modify retention locked SLA "+
+				"Domains:\n  - %s", detail, strings.Join(changes, "\n  - ")))
+	}
+}
+
+// slaRetentionLockChanges returns the planned changes which reduce the
+// retention of the SLA domain in the state, i.e. reduced or removed snapshot
+// schedule and local retentions, removed archival locations and changes
+// replacing the SLA domain. Changes to values not yet known aren't returned.
+func slaRetentionLockChanges(ctx context.Context, state tfsdk.State, plan tfsdk.Plan) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var changes []string
+	for _, key := range slaRetentionKeys {
+		var stateValue, planValue types.Object
+		diags.Append(state.GetAttribute(ctx, path.Root(key), &stateValue)...)
+		diags.Append(plan.GetAttribute(ctx, path.Root(key), &planValue)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if planValue.IsUnknown() {
+			continue
+		}
+
+		// Changing the yearly schedule requires the SLA domain to be replaced.
+		if key == keyYearlySchedule && !planValue.Equal(stateValue) {
+			changes = append(changes, fmt.Sprintf("%s changed, which replaces the SLA Domain", key))
+			continue
+		}
+		if stateValue.IsNull() {
+			continue
+		}
+		if planValue.IsNull() {
+			changes = append(changes, fmt.Sprintf("%s removed", key))
+			continue
+		}
+
+		stateRetention, stateUnit, ok := objectRetention(stateValue)
+		if !ok {
+			continue
+		}
+		planRetention, planUnit, ok := objectRetention(planValue)
+		if !ok {
+			continue
+		}
+		if slaDurationMinutes(planRetention, planUnit) < slaDurationMinutes(stateRetention, stateUnit) {
+			changes = append(changes, fmt.Sprintf("%s retention reduced from %d %s to %d %s", key, stateRetention,
+				stateUnit, planRetention, planUnit))
+		}
+	}
+
+	var stateArchival, planArchival types.List
+	diags.Append(state.GetAttribute(ctx, path.Root(keyArchival), &stateArchival)...)
+	diags.Append(plan.GetAttribute(ctx, path.Root(keyArchival), &planArchival)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	if planLocationIDs, ok := archivalLocationIDs(planArchival); ok {
+		stateLocationIDs, _ := archivalLocationIDs(stateArchival)
+		for _, id := range stateLocationIDs {
+			if !slices.Contains(planLocationIDs, id) {
+				changes = append(changes, fmt.Sprintf("archival location %s removed", id))
+			}
+		}
+	}
+
+	return changes, diags
+}
+
+// objectRetention returns the retention and retention unit of the object.
+// Returns false if the retention isn't known.
+func objectRetention(object types.Object) (int, gqlsla.RetentionUnit, bool) {
+	retention, ok := object.Attributes()[keyRetention].(types.Int64)
+	if !ok || retention.IsNull() || retention.IsUnknown() {
+		return 0, "", false
+	}
+	unit, ok := object.Attributes()[keyRetentionUnit].(types.String)
+	if !ok || unit.IsNull() || unit.IsUnknown() {
+		return 0, "", false
+	}
+
+	return int(retention.ValueInt64()), gqlsla.RetentionUnit(unit.ValueString()), true
+}
+
+// archivalLocationIDs returns the archival location IDs of the archival list.
+// Returns false if any archival location ID isn't known.
+func archivalLocationIDs(archival types.List) ([]string, bool) {
+	if archival.IsUnknown() {
+		return nil, false
+	}
+
+	var ids []string
+	for _, elem := range archival.Elements() {
+		object, ok := elem.(types.Object)
+		if !ok || object.IsUnknown() {
+			return nil, false
+		}
+		id, ok := object.Attributes()[keyArchivalLocationID].(types.String)
+		if !ok || id.IsUnknown() {
+			return nil, false
+		}
+		if !id.IsNull() {
+			ids = append(ids, id.ValueString())
+		}
+	}
+
+	return ids, true
+}
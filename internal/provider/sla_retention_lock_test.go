@@ -0,0 +1,207 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	gqlsla "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/sla"
+)
+
+func testLockedSLADomainModel(mode gqlsla.RetentionLockMode) slaDomainModel {
+	model := testSLADomainModel()
+	model.DailySchedule.Retention = types.Int64Value(30)
+	model.Archival = []slaArchivalModel{{
+		ArchivalLocationID: types.StringValue("5d1f7b52-1e4e-4d1e-9d0a-3c6f0a0b6e61"),
+		Threshold:          types.Int64Value(1),
+		ThresholdUnit:      types.StringValue(string(gqlsla.Days)),
+		Frequency:          types.SetNull(types.StringType),
+	}}
+	model.RetentionLock = &slaRetentionLockModel{
+		Mode:                         types.StringValue(string(mode)),
+		ComplianceModeAcknowledgment: types.BoolValue(mode == gqlsla.Compliance),
+	}
+	model.RetentionLockMode = types.StringValue(string(mode))
+	model.LockedAt = types.StringValue("2026-10-18T12:00:00Z")
+	return model
+}
+
+func TestRetentionLockedAt(t *testing.T) {
+	now := time.Date(2026, 10, 18, 14, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+
+	if got := retentionLockedAt(gqlsla.NoLock, types.StringValue("2026-10-01T00:00:00Z"), now); !got.IsNull() {
+		t.Errorf("expected null, got %v", got)
+	}
+	if got := retentionLockedAt(gqlsla.Compliance, types.StringNull(), now); got.ValueString() != "2026-10-18T12:00:00Z" {
+		t.Errorf("expected 2026-10-18T12:00:00Z, got %v", got)
+	}
+	if got := retentionLockedAt(gqlsla.Protection, types.StringValue("2026-10-01T00:00:00Z"), now); got.ValueString() != "2026-10-01T00:00:00Z" {
+		t.Errorf("expected 2026-10-01T00:00:00Z, got %v", got)
+	}
+}
+
+func TestDomainRetentionLockMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		domain gqlsla.Domain
+		want   gqlsla.RetentionLockMode
+	}{
+		{name: "NotLocked", domain: gqlsla.Domain{RetentionLockMode: gqlsla.Compliance}, want: gqlsla.NoLock},
+		{name: "Compliance", domain: gqlsla.Domain{RetentionLock: true, RetentionLockMode: gqlsla.Compliance}, want: gqlsla.Compliance},
+		{name: "Governance", domain: gqlsla.Domain{RetentionLock: true, RetentionLockMode: gqlsla.Protection}, want: gqlsla.Protection},
+		{name: "Unknown", domain: gqlsla.Domain{RetentionLock: true, RetentionLockMode: "UNKNOWN"}, want: gqlsla.NoLock},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := domainRetentionLockMode(tc.domain); got != tc.want {
+				t.Errorf("expected %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSLARetentionLockChanges(t *testing.T) {
+	ctx := context.Background()
+	s := slaDomainSchema()
+
+	state := testLockedSLADomainModel(gqlsla.Compliance)
+	plan := testLockedSLADomainModel(gqlsla.Compliance)
+	plan.DailySchedule.Retention = types.Int64Value(4)
+	plan.DailySchedule.RetentionUnit = types.StringValue(string(gqlsla.Weeks))
+	plan.Archival = nil
+	plan.YearlySchedule = &slaYearlyScheduleModel{
+		DayOfYear:      types.StringValue(gqlsla.LastDay),
+		Frequency:      types.Int64Value(1),
+		Retention:      types.Int64Value(2),
+		RetentionUnit:  types.StringValue(string(gqlsla.Years)),
+		YearStartMonth: types.StringValue(string(gqlsla.January)),
+	}
+
+	changes, diags := slaRetentionLockChanges(ctx, tfsdk.State{Schema: s, Raw: slaDomainRawValue(t, state)},
+		tfsdk.Plan{Schema: s, Raw: slaDomainRawValue(t, plan)})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	want := []string{
+		"daily_schedule retention reduced from 30 DAYS to 4 WEEKS",
+		"yearly_schedule changed, which replaces the SLA Domain",
+		"archival location 5d1f7b52-1e4e-4d1e-9d0a-3c6f0a0b6e61 removed",
+	}
+	if strings.Join(changes, "\n") != strings.Join(want, "\n") {
+		t.Errorf("expected %q, got %q", want, changes)
+	}
+
+	// Increasing the retention is allowed.
+	plan = testLockedSLADomainModel(gqlsla.Compliance)
+	plan.DailySchedule.Retention = types.Int64Value(60)
+	changes, diags = slaRetentionLockChanges(ctx, tfsdk.State{Schema: s, Raw: slaDomainRawValue(t, state)},
+		tfsdk.Plan{Schema: s, Raw: slaDomainRawValue(t, plan)})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(changes) != 0 {
+		t.Errorf("expected no changes, got %q", changes)
+	}
+}
+
+func TestPlanRetentionLock(t *testing.T) {
+	ctx := context.Background()
+	s := slaDomainSchema()
+
+	tests := []struct {
+		name        string
+		mode        gqlsla.RetentionLockMode
+		wantError   bool
+		wantWarning bool
+	}{
+		{name: "Compliance", mode: gqlsla.Compliance, wantError: true},
+		{name: "Governance", mode: gqlsla.Protection, wantWarning: true},
+		{name: "NoLock", mode: gqlsla.NoLock},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := testLockedSLADomainModel(tc.mode)
+			if tc.mode == gqlsla.NoLock {
+				state.RetentionLock = nil
+				state.LockedAt = types.StringNull()
+			}
+			plan := state
+			plan.DailySchedule = &slaBasicScheduleModel{
+				Frequency:     types.Int64Value(1),
+				Retention:     types.Int64Value(7),
+				RetentionUnit: types.StringValue(string(gqlsla.Days)),
+			}
+			plan.RetentionLockMode = types.StringUnknown()
+			plan.LockedAt = types.StringUnknown()
+
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Schema: s, Raw: slaDomainRawValue(t, plan)},
+				State: tfsdk.State{Schema: s, Raw: slaDomainRawValue(t, state)},
+			}
+			res := resource.ModifyPlanResponse{Plan: req.Plan}
+			planRetentionLock(ctx, req, &res)
+			if got := res.Diagnostics.ErrorsCount() > 0; got != tc.wantError {
+				t.Fatalf("expected error %t, got %v", tc.wantError, res.Diagnostics)
+			}
+			if got := res.Diagnostics.WarningsCount() > 0; got != tc.wantWarning {
+				t.Fatalf("expected warning %t, got %v", tc.wantWarning, res.Diagnostics)
+			}
+			if tc.wantError {
+				return
+			}
+
+			var planned slaDomainModel
+			if diags := res.Plan.Get(ctx, &planned); diags.HasError() {
+				t.Fatalf("failed to get plan: %v", diags)
+			}
+			if planned.RetentionLockMode.ValueString() != string(tc.mode) {
+				t.Errorf("expected retention lock mode %q, got %v", tc.mode, planned.RetentionLockMode)
+			}
+			if !planned.LockedAt.Equal(state.LockedAt) {
+				t.Errorf("expected locked at %v, got %v", state.LockedAt, planned.LockedAt)
+			}
+		})
+	}
+}
+
+func TestValidateRetentionLockDestroy(t *testing.T) {
+	ctx := context.Background()
+	s := slaDomainSchema()
+
+	state := testLockedSLADomainModel(gqlsla.Compliance)
+	// State written before the retention lock mode was read.
+	state.RetentionLockMode = types.StringNull()
+
+	req := resource.ModifyPlanRequest{
+		State: tfsdk.State{Schema: s, Raw: slaDomainRawValue(t, state)},
+	}
+	res := resource.ModifyPlanResponse{}
+	validateRetentionLockDestroy(ctx, req, &res)
+	if !res.Diagnostics.HasError() {
+		t.Error("expected destroying a compliance locked SLA domain to fail")
+	}
+}
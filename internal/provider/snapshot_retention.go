@@ -0,0 +1,219 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+)
+
+// assignSnapshotRetentionQuery is the GraphQL mutation used to change the
+// retention of snapshots.
+const assignSnapshotRetentionQuery = `mutation SdkGolangAssignSnapshotRetention($snapshotFids: [UUID!]!, $globalSlaAssignType: SlaAssignTypeEnum!, $globalSlaOptionalFid: UUID) {
+    result: assignRetentionSLAToSnapshots(snapshotFids: $snapshotFids, globalSlaAssignType: $globalSlaAssignType, globalSlaOptionalFid: $globalSlaOptionalFid) {
+        success
+    }
+}`
+
+// deleteUnmanagedSnapshotsQuery is the GraphQL mutation used to delete
+// snapshots which aren't retained by an SLA domain.
+const deleteUnmanagedSnapshotsQuery = `mutation SdkGolangDeleteUnmanagedSnapshots($snapshotIds: [String!]!) {
+    result: deleteUnmanagedSnapshots(input: {snapshotIds: $snapshotIds}) {
+        success
+    }
+}`
+
+// workloadSnapshotCountQuery is the GraphQL query used to read the number of
+// snapshots of a workload.
+const workloadSnapshotCountQuery = `query SdkGolangWorkloadSnapshotCount($filter: SnappableFilterInput) {
+    result: snappableConnection(filter: $filter) {
+        edges {
+            node {
+                fid
+                totalSnapshots
+            }
+        }
+    }
+}`
+
+// Snapshot retentions.
+const (
+	snapshotRetentionSLA         = "SLA"
+	snapshotRetentionKeepForever = "KEEP_FOREVER"
+	snapshotRetentionExpireNow   = "EXPIRE_NOW"
+)
+
+// SLA assign types used when changing the retention of snapshots.
+const (
+	slaAssignTypeDoNotProtect     = "doNotProtect"
+	slaAssignTypeProtectWithSLAID = "protectWithSlaId"
+)
+
+// assignSnapshotRetention changes the retention of the snapshots. When slaID
+// is nil, the snapshots are retained forever, otherwise the snapshots are
+// retained by the SLA domain with the ID.
+func assignSnapshotRetention(ctx context.Context, gql *graphql.Client, snapshotIDs []uuid.UUID, slaID *uuid.UUID) error {
+	params := struct {
+		SnapshotIDs []uuid.UUID `json:"snapshotFids"`
+		AssignType  string      `json:"globalSlaAssignType"`
+		SLAID       *uuid.UUID  `json:"globalSlaOptionalFid,omitempty"`
+	}{SnapshotIDs: snapshotIDs, AssignType: slaAssignTypeDoNotProtect, SLAID: slaID}
+	if slaID != nil {
+		params.AssignType = slaAssignTypeProtectWithSLAID
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	err := gqlRequest(ctx, gql, assignSnapshotRetentionQuery, params, &result)
+	if err == nil && !result.Success {
+		err = errors.New("operation not successful")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to change snapshot retention: %w", err)
+	}
+
+	return nil
+}
+
+// deleteUnmanagedSnapshots deletes the snapshots. The snapshots must not be
+// retained by an SLA domain.
+func deleteUnmanagedSnapshots(ctx context.Context, gql *graphql.Client, snapshotIDs []uuid.UUID) error {
+	ids := make([]string, 0, len(snapshotIDs))
+	for _, id := range snapshotIDs {
+		ids = append(ids, id.String())
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	err := gqlRequest(ctx, gql, deleteUnmanagedSnapshotsQuery, struct {
+		SnapshotIDs []string `json:"snapshotIds"`
+	}{SnapshotIDs: ids}, &result)
+	if err == nil && !result.Success {
+		err = errors.New("operation not successful")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to expire snapshots: %w", err)
+	}
+
+	return nil
+}
+
+// workloadSnapshotCount returns the number of snapshots of the workload with
+// the specified ID.
+func workloadSnapshotCount(ctx context.Context, gql *graphql.Client, workloadID uuid.UUID) (int, error) {
+	type filter struct {
+		ObjectIDs []uuid.UUID `json:"objectFid"`
+	}
+
+	var result struct {
+		Edges []struct {
+			Node struct {
+				ID             uuid.UUID `json:"fid"`
+				TotalSnapshots int       `json:"totalSnapshots"`
+			} `json:"node"`
+		} `json:"edges"`
+	}
+	err := gqlRequest(ctx, gql, workloadSnapshotCountQuery, struct {
+		Filter filter `json:"filter"`
+	}{Filter: filter{ObjectIDs: []uuid.UUID{workloadID}}}, &result)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get snapshot count of workload %s: %w", workloadID, err)
+	}
+	for _, edge := range result.Edges {
+		if edge.Node.ID == workloadID {
+			return edge.Node.TotalSnapshots, nil
+		}
+	}
+
+	return 0, fmt.Errorf("workload %s %w", workloadID, graphql.ErrNotFound)
+}
+
+// snapshotExpirations tracks the snapshots planned to be expired for each
+// workload, across all snapshot retention resources planned by the provider,
+// so that the resources together can't expire the last snapshot of a
+// workload.
+type snapshotExpirations struct {
+	mu      sync.Mutex
+	planned map[uuid.UUID]map[uuid.UUID]struct{}
+
+	// expireMu serializes expiring snapshots, so that the number of snapshots
+	// of a workload doesn't change between the check and the expiration.
+	expireMu sync.Mutex
+}
+
+// plan adds the snapshots planned to be expired for the workload. Returns the
+// number of distinct snapshots planned to be expired for the workload.
+func (e *snapshotExpirations) plan(workloadID uuid.UUID, snapshotIDs []uuid.UUID) int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.planned == nil {
+		e.planned = make(map[uuid.UUID]map[uuid.UUID]struct{})
+	}
+	planned, ok := e.planned[workloadID]
+	if !ok {
+		planned = make(map[uuid.UUID]struct{})
+		e.planned[workloadID] = planned
+	}
+	for _, id := range snapshotIDs {
+		planned[id] = struct{}{}
+	}
+
+	return len(planned)
+}
+
+// expire expires the snapshots of the workload. The number of snapshots of the
+// workload is checked before the snapshots are expired.
+func (e *snapshotExpirations) expire(ctx context.Context, gql *graphql.Client, workloadID uuid.UUID, snapshotIDs []uuid.UUID) error {
+	e.expireMu.Lock()
+	defer e.expireMu.Unlock()
+
+	total, err := workloadSnapshotCount(ctx, gql, workloadID)
+	if err != nil {
+		return err
+	}
+	if err := validateSnapshotExpiration(workloadID, len(snapshotIDs), total); err != nil {
+		return err
+	}
+	if err := assignSnapshotRetention(ctx, gql, snapshotIDs, nil); err != nil {
+		return err
+	}
+
+	return deleteUnmanagedSnapshots(ctx, gql, snapshotIDs)
+}
+
+// validateSnapshotExpiration returns an error if expiring the specified number
+// of snapshots would expire the last snapshot of the workload.
+func validateSnapshotExpiration(workloadID uuid.UUID, expire, total int) error {
+	if expire >= total {
+		return fmt.Errorf("expiring %d snapshot(s) would expire the last snapshot of workload %s, which has %d "+
+			"snapshot(s)", expire, workloadID, total)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestValidateSnapshotExpiration(t *testing.T) {
+	workloadID := uuid.MustParse("1f8d2c5e-3b4a-4e6f-8a9b-0c1d2e3f4a5b")
+
+	testCases := []struct {
+		name   string
+		expire int
+		total  int
+		valid  bool
+	}{
+		{name: "SomeSnapshots", expire: 2, total: 5, valid: true},
+		{name: "AllButOneSnapshot", expire: 4, total: 5, valid: true},
+		{name: "AllSnapshots", expire: 5, total: 5, valid: false},
+		{name: "MoreSnapshotsThanExist", expire: 3, total: 2, valid: false},
+		{name: "NoSnapshots", expire: 1, total: 0, valid: false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validateSnapshotExpiration(workloadID, testCase.expire, testCase.total)
+			if valid := err == nil; valid != testCase.valid {
+				t.Fatalf("expected valid to be %t, got error: %v", testCase.valid, err)
+			}
+		})
+	}
+}
+
+// TestSnapshotRetentionPlanSameWorkload verifies that the snapshots expired by
+// two resources targeting the same workload are counted together when
+// planning.
+func TestSnapshotRetentionPlanSameWorkload(t *testing.T) {
+	ctx := context.Background()
+	workloadID := "1f8d2c5e-3b4a-4e6f-8a9b-0c1d2e3f4a5b"
+	snapshot1 := "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a01"
+	snapshot2 := "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a02"
+	snapshot3 := "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a03"
+
+	tests := []struct {
+		name    string
+		first   []string
+		second  []string
+		wantErr bool
+	}{
+		{name: "DistinctSnapshots", first: []string{snapshot1, snapshot2}, second: []string{snapshot3}, wantErr: true},
+		{name: "OverlappingSnapshots", first: []string{snapshot1, snapshot2}, second: []string{snapshot2}},
+		{name: "SomeSnapshots", first: []string{snapshot1}, second: []string{snapshot2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				if req.Operation != "SdkGolangWorkloadSnapshotCount" {
+					return nil, errors.New("unexpected operation: " + req.Operation)
+				}
+				return map[string]any{"edges": []any{map[string]any{"node": map[string]any{
+					"fid":            workloadID,
+					"totalSnapshots": 3,
+				}}}}, nil
+			})
+
+			var schemaRes resource.SchemaResponse
+			(&snapshotRetentionResource{}).Schema(ctx, resource.SchemaRequest{}, &schemaRes)
+			modifyPlan := func(snapshotIDs []string) diag.Diagnostics {
+				plan := tfsdk.Plan{Schema: schemaRes.Schema}
+				diags := plan.Set(ctx, &snapshotRetentionModel{
+					ID:          types.StringUnknown(),
+					Retention:   types.StringValue(snapshotRetentionExpireNow),
+					SLADomainID: types.StringNull(),
+					SnapshotIDs: setFromStrings(snapshotIDs),
+					WorkloadID:  types.StringValue(workloadID),
+				})
+				if diags.HasError() {
+					return diags
+				}
+				state := tfsdk.State{Schema: schemaRes.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}
+				res := resource.ModifyPlanResponse{Plan: plan}
+				r := &snapshotRetentionResource{client: c}
+				r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &res)
+				return res.Diagnostics
+			}
+
+			if diags := modifyPlan(tc.first); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if diags := modifyPlan(tc.second); diags.HasError() != tc.wantErr {
+				t.Fatalf("expected error %t, got: %v", tc.wantErr, diags)
+			}
+		})
+	}
+}
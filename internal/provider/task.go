@@ -0,0 +1,289 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	gqlevent "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/event"
+)
+
+// Types of the RSC asynchronous tasks which can be waited for.
+const (
+	taskTypeActivitySeries  = "ACTIVITY_SERIES"
+	taskTypeAWSCloudAccount = "AWS_CLOUD_ACCOUNT"
+	taskTypeTaskChain       = "TASK_CHAIN"
+)
+
+// taskWaitTime is the time to wait between polls of an RSC asynchronous task.
+const taskWaitTime = 30 * time.Second
+
+// taskState holds the state of an RSC asynchronous task.
+type taskState struct {
+	Status  string
+	Message string
+	Done    bool
+	Failed  bool
+}
+
+// taskStatus returns the current state of the RSC asynchronous task with the
+// specified type and ID. Activity series are scoped to a cluster, clusterID
+// is ignored for other types of tasks. Returns graphql.ErrNotFound if the task
+// doesn't exist, e.g., because RSC has expired the task.
+func taskStatus(ctx context.Context, gql *graphql.Client, taskType, taskID, clusterID string) (taskState, error) {
+	switch taskType {
+	case taskTypeActivitySeries:
+		series, err := gqlevent.Wrap(gql).ActivitySeries(ctx, taskID, clusterID)
+		if err != nil {
+			return taskState{}, taskError(taskType, taskID, err)
+		}
+		if series.ActivitySeriesID == "" {
+			return taskState{}, fmt.Errorf("%s task %q %w", taskType, taskID, graphql.ErrNotFound)
+		}
+		return activitySeriesState(series), nil
+	case taskTypeAWSCloudAccount:
+		accountID, err := uuid.Parse(taskID)
+		if err != nil {
+			return taskState{}, fmt.Errorf("invalid cloud account ID %q: %s", taskID, err)
+		}
+		account, err := aws.WrapGQL(gql).AccountByID(ctx, accountID)
+		if err != nil {
+			return taskState{}, taskError(taskType, taskID, err)
+		}
+		return awsCloudAccountState(account), nil
+	case taskTypeTaskChain:
+		taskChainID, err := uuid.Parse(taskID)
+		if err != nil {
+			return taskState{}, fmt.Errorf("invalid task chain ID %q: %s", taskID, err)
+		}
+		taskChain, err := core.Wrap(gql).KorgTaskChainStatus(ctx, taskChainID)
+		if err != nil {
+			return taskState{}, taskError(taskType, taskID, err)
+		}
+		return taskChainState(taskChain.State), nil
+	default:
+		return taskState{}, fmt.Errorf("unsupported task type %q", taskType)
+	}
+}
+
+// taskError returns err as a graphql.ErrNotFound error if RSC responded with a
+// not found GraphQL error.
+func taskError(taskType, taskID string, err error) error {
+	var gqlErr graphql.GQLError
+	if errors.As(err, &gqlErr) && gqlErr.Code() == http.StatusNotFound {
+		return fmt.Errorf("%s task %q %w: %s", taskType, taskID, graphql.ErrNotFound, err)
+	}
+
+	return err
+}
+
+// waitForTask blocks until the RSC asynchronous task with the specified type
+// and ID is done. The final state of the task is returned, an error is only
+// returned if the state of the task cannot be read.
+func waitForTask(ctx context.Context, gql *graphql.Client, taskType, taskID, clusterID string) (taskState, error) {
+	for {
+		state, err := taskStatus(ctx, gql, taskType, taskID, clusterID)
+		if err != nil {
+			return taskState{}, err
+		}
+		if state.Done {
+			return state, nil
+		}
+
+		tflog.Info(ctx, "task in progress", map[string]any{
+			"task_type": taskType,
+			"task_id":   taskID,
+			"status":    state.Status,
+			"message":   state.Message,
+		})
+		select {
+		case <-ctx.Done():
+			return state, fmt.Errorf("wait for %s task %q: %w", taskType, taskID, ctx.Err())
+		case <-time.After(taskWaitTime):
+		}
+	}
+}
+
+// activitySeriesState returns the task state of the activity series.
+func activitySeriesState(series gqlevent.EventSeries) taskState {
+	state := taskState{Status: string(series.LastActivityStatus)}
+	if len(series.Activities.Nodes) > 0 {
+		state.Message = series.Activities.Nodes[0].Message
+	}
+	switch series.LastActivityStatus {
+	case gqlevent.ActivityStatusSuccess, gqlevent.ActivityStatusPartialSuccess:
+		state.Done = true
+	case gqlevent.ActivityStatusFailure, gqlevent.ActivityStatusCanceled:
+		state.Done = true
+		state.Failed = true
+	}
+
+	return state
+}
+
+// awsCloudAccountState returns the task state of the AWS cloud account. The
+// task is done when all features of the account are connected. The message
+// lists the features which are not yet connected.
+func awsCloudAccountState(account aws.CloudAccount) taskState {
+	state := taskState{Status: string(core.StatusConnected), Done: true}
+	var pending []string
+	for _, feature := range account.Features {
+		if feature.Status == core.StatusConnected {
+			continue
+		}
+		if state.Done {
+			state.Status = string(feature.Status)
+			state.Done = false
+		}
+		pending = append(pending, feature.Name)
+	}
+	if len(pending) > 0 {
+		state.Message = fmt.Sprintf("waiting for features to connect: %s", strings.Join(pending, ", "))
+	}
+
+	return state
+}
+
+// taskChainState returns the task state of the task chain state.
+func taskChainState(chainState core.TaskChainState) taskState {
+	state := taskState{Status: string(chainState)}
+	switch chainState {
+	case core.TaskChainSucceeded:
+		state.Done = true
+	case core.TaskChainFailed, core.TaskChainCanceled:
+		state.Done = true
+		state.Failed = true
+	}
+
+	return state
+}
+
+// cloudClusterCreateActivity returns the ID of the cloud cluster with the
+// specified name and the ID of the activity series of the in-progress create
+// job of the cluster. Returns graphql.ErrNotFound if there is no in-progress
+// create job for a cloud cluster with the name.
+func cloudClusterCreateActivity(ctx context.Context, gql *graphql.Client, clusterName string) (uuid.UUID, string, error) {
+	filter := gqlevent.EventSeriesFilter{
+		ObjectName:        clusterName,
+		ObjectType:        []gqlevent.EventObjectType{gqlevent.EventObjectTypeCluster},
+		LastUpdatedTimeGt: core.FormatTimestamp(time.Now().Add(-cloudClusterJobStartTimeout)),
+	}
+	series, err := gqlevent.Wrap(gql).EventSeries(ctx, "", filter, 100, gqlevent.EventSeriesSortFieldLastUpdated,
+		core.SortOrderDesc)
+	if err != nil {
+		return uuid.Nil, "", err
+	}
+	for _, series := range series {
+		if series.ObjectName != clusterName || series.ClusterUUID == "" || activitySeriesState(series).Done {
+			continue
+		}
+		clusterID, err := uuid.Parse(series.ClusterUUID)
+		if err != nil {
+			return uuid.Nil, "", fmt.Errorf("invalid cluster ID %q: %s", series.ClusterUUID, err)
+		}
+		return clusterID, series.ActivitySeriesID, nil
+	}
+
+	return uuid.Nil, "", fmt.Errorf("create job for cloud cluster %q %w", clusterName, graphql.ErrNotFound)
+}
+
+// startCloudClusterCreate runs create, which creates a cloud cluster and
+// blocks until the cluster has been created, and returns as soon as the create
+// job of the cluster has started. Returns the ID of the cluster and the ID of
+// the activity series of the create job. If create finishes before the create
+// job is observed, the returned activity series ID is empty.
+//
+// The SDK doesn't expose the create job, so create runs in the background
+// until the create job shows up in the event series of the cluster. create is
+// then canceled, which only stops the monitoring of the create job.
+func startCloudClusterCreate(ctx context.Context, gql *graphql.Client, clusterName string, create func(context.Context) (uuid.UUID, error)) (uuid.UUID, string, error) {
+	type result struct {
+		clusterID uuid.UUID
+		err       error
+	}
+
+	createCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	done := make(chan result, 1)
+	go func() {
+		clusterID, err := create(createCtx)
+		done <- result{clusterID: clusterID, err: err}
+	}()
+
+	start := time.Now()
+	for {
+		select {
+		case res := <-done:
+			return res.clusterID, "", res.err
+		case <-ctx.Done():
+			return uuid.Nil, "", fmt.Errorf("wait for cloud cluster %q create job to start: %w", clusterName, ctx.Err())
+		case <-time.After(taskWaitTime):
+		}
+
+		clusterID, taskID, err := cloudClusterCreateActivity(ctx, gql, clusterName)
+		if err == nil {
+			return clusterID, taskID, nil
+		}
+		if !errors.Is(err, graphql.ErrNotFound) || time.Since(start) > cloudClusterJobStartTimeout {
+			return uuid.Nil, "", err
+		}
+	}
+}
+
+// cloudClusterCreatePending returns true if the cloud cluster resource was
+// created without waiting for completion and the create job of the cluster is
+// still running. While the create job is running, the cluster might not yet be
+// visible in RSC, so the cluster must not be removed from the state.
+func cloudClusterCreatePending(ctx context.Context, gql *graphql.Client, d *schema.ResourceData) (bool, error) {
+	taskID := d.Get(keyTaskID).(string)
+	if taskID == "" {
+		return false, nil
+	}
+
+	state, err := taskStatus(ctx, gql, taskTypeActivitySeries, taskID, d.Id())
+	if errors.Is(err, graphql.ErrNotFound) {
+		// RSC has expired the create job, so the job has finished.
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !state.Done {
+		tflog.Info(ctx, "cloud cluster create job in progress", map[string]any{
+			"cluster_id": d.Id(),
+			"task_id":    taskID,
+			"status":     state.Status,
+		})
+	}
+
+	return !state.Done, nil
+}
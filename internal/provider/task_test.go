@@ -0,0 +1,218 @@
+// Copyright 2026 Rubrik, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to
+// deal in the Software without restriction, including without limitation the
+// rights to use, copy, modify, merge, publish, distribute, sublicense, and/or
+// sell copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+// FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER
+// DEALINGS IN THE SOFTWARE.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/aws"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql"
+	"github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/core"
+	gqlevent "github.com/rubrikinc/rubrik-polaris-sdk-for-go/pkg/polaris/graphql/event"
+)
+
+const (
+	testActivitySeriesID = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a07"
+	testTaskClusterID    = "3b4c4a4e-2b7e-4a0a-8b4e-0c6f9e4b1a08"
+)
+
+func TestActivitySeriesState(t *testing.T) {
+	testCases := []struct {
+		status gqlevent.ActivityStatus
+		done   bool
+		failed bool
+	}{
+		{status: gqlevent.ActivityStatusQueued},
+		{status: gqlevent.ActivityStatusRunning},
+		{status: gqlevent.ActivityStatusTaskSuccess},
+		{status: gqlevent.ActivityStatusSuccess, done: true},
+		{status: gqlevent.ActivityStatusPartialSuccess, done: true},
+		{status: gqlevent.ActivityStatusFailure, done: true, failed: true},
+		{status: gqlevent.ActivityStatusCanceled, done: true, failed: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.status), func(t *testing.T) {
+			state := activitySeriesState(gqlevent.EventSeries{LastActivityStatus: testCase.status})
+			if state.Status != string(testCase.status) {
+				t.Fatalf("expected status %q, got %q", testCase.status, state.Status)
+			}
+			if state.Done != testCase.done || state.Failed != testCase.failed {
+				t.Fatalf("expected done=%t failed=%t, got done=%t failed=%t", testCase.done, testCase.failed,
+					state.Done, state.Failed)
+			}
+		})
+	}
+}
+
+func TestTaskChainState(t *testing.T) {
+	testCases := []struct {
+		state  core.TaskChainState
+		done   bool
+		failed bool
+	}{
+		{state: core.TaskChainReady},
+		{state: core.TaskChainRunning},
+		{state: core.TaskChainCanceling},
+		{state: core.TaskChainSucceeded, done: true},
+		{state: core.TaskChainFailed, done: true, failed: true},
+		{state: core.TaskChainCanceled, done: true, failed: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.state), func(t *testing.T) {
+			state := taskChainState(testCase.state)
+			if state.Done != testCase.done || state.Failed != testCase.failed {
+				t.Fatalf("expected done=%t failed=%t, got done=%t failed=%t", testCase.done, testCase.failed,
+					state.Done, state.Failed)
+			}
+		})
+	}
+}
+
+func TestAWSCloudAccountState(t *testing.T) {
+	feature := func(name string, status core.Status) aws.Feature {
+		return aws.Feature{Feature: core.Feature{Name: name}, Status: status}
+	}
+
+	testCases := []struct {
+		name        string
+		features    []aws.Feature
+		wantStatus  string
+		wantDone    bool
+		wantMessage string
+	}{{
+		name:       "Connected",
+		features:   []aws.Feature{feature("CLOUD_DISCOVERY", core.StatusConnected), feature("EC2", core.StatusConnected)},
+		wantStatus: "CONNECTED",
+		wantDone:   true,
+	}, {
+		name: "Connecting",
+		features: []aws.Feature{
+			feature("CLOUD_DISCOVERY", core.StatusConnected),
+			feature("EC2", core.StatusConnecting),
+			feature("RDS", core.StatusMissingPermissions),
+		},
+		wantStatus:  "CONNECTING",
+		wantMessage: "waiting for features to connect: EC2, RDS",
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			state := awsCloudAccountState(aws.CloudAccount{Features: testCase.features})
+			if state.Status != testCase.wantStatus {
+				t.Errorf("expected status %q, got %q", testCase.wantStatus, state.Status)
+			}
+			if state.Done != testCase.wantDone || state.Failed {
+				t.Errorf("expected done=%t failed=false, got done=%t failed=%t", testCase.wantDone, state.Done,
+					state.Failed)
+			}
+			if state.Message != testCase.wantMessage {
+				t.Errorf("expected message %q, got %q", testCase.wantMessage, state.Message)
+			}
+		})
+	}
+}
+
+func TestTaskStatusNotFound(t *testing.T) {
+	testCases := []struct {
+		name         string
+		result       any
+		err          error
+		wantErr      bool
+		wantNotFound bool
+	}{{
+		name: "Found",
+		result: map[string]any{
+			"activitySeriesId":   testActivitySeriesID,
+			"lastActivityStatus": "Running",
+		},
+	}, {
+		name:         "EmptyResult",
+		result:       map[string]any{},
+		wantErr:      true,
+		wantNotFound: true,
+	}, {
+		name:         "NotFoundError",
+		err:          gqlTestError{Message: "activity series not found", Code: http.StatusNotFound},
+		wantErr:      true,
+		wantNotFound: true,
+	}, {
+		name:    "OtherError",
+		err:     gqlTestError{Message: "internal error", Code: http.StatusInternalServerError},
+		wantErr: true,
+	}}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+				return testCase.result, testCase.err
+			})
+
+			state, err := taskStatus(context.Background(), c.polarisClient.GQL, taskTypeActivitySeries,
+				testActivitySeriesID, testTaskClusterID)
+			if testCase.wantErr != (err != nil) {
+				t.Fatalf("expected error=%t, got %v", testCase.wantErr, err)
+			}
+			if notFound := errors.Is(err, graphql.ErrNotFound); notFound != testCase.wantNotFound {
+				t.Fatalf("expected not found=%t, got %v", testCase.wantNotFound, err)
+			}
+			if err == nil && state.Status != "Running" {
+				t.Errorf("expected status %q, got %q", "Running", state.Status)
+			}
+		})
+	}
+}
+
+func TestReadTaskWaitNotFound(t *testing.T) {
+	c, _ := newGQLTestClient(t, func(req gqlTestRequest) (any, error) {
+		return nil, gqlTestError{Message: "activity series not found", Code: http.StatusNotFound}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceTaskWait().Schema, map[string]any{
+		keyClusterID: testTaskClusterID,
+		keyTaskID:    testActivitySeriesID,
+		keyTaskType:  taskTypeActivitySeries,
+	})
+	d.SetId(testActivitySeriesID)
+	if err := d.Set(keyStatus, "Success"); err != nil {
+		t.Fatal(err)
+	}
+
+	diags := readTaskWait(context.Background(), d, c)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if d.Id() != testActivitySeriesID {
+		t.Errorf("expected the resource to be kept, got ID %q", d.Id())
+	}
+	if status := d.Get(keyStatus).(string); status != "Success" {
+		t.Errorf("expected the status to be kept, got %q", status)
+	}
+}